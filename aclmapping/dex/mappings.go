@@ -24,6 +24,8 @@ func GetDexDependencyGenerators() aclkeeper.DependencyGeneratorMap {
 	cancelOrdersKey := acltypes.GenerateMessageKey(&dextypes.MsgCancelOrders{})
 	dependencyGeneratorMap[placeOrdersKey] = DexPlaceOrdersDependencyGenerator
 	dependencyGeneratorMap[cancelOrdersKey] = DexCancelOrdersDependencyGenerator
+	amendOrdersKey := acltypes.GenerateMessageKey(&dextypes.MsgAmendOrders{})
+	dependencyGeneratorMap[amendOrdersKey] = DexAmendOrdersDependencyGenerator
 
 	return dependencyGeneratorMap
 }
@@ -44,6 +46,21 @@ func GetLongShortOrderBookOps(contractAddr string, priceDenom string, assetDenom
 	}
 }
 
+func GetTriggerBookOps(contractAddr string, priceDenom string, assetDenom string) []sdkacltypes.AccessOperation {
+	return []sdkacltypes.AccessOperation{
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+			IdentifierTemplate: hex.EncodeToString(dextypes.TriggerBookPrefix(contractAddr, priceDenom, assetDenom)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+			IdentifierTemplate: hex.EncodeToString(dextypes.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom)),
+		},
+	}
+}
+
 func DexPlaceOrdersDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
 	placeOrdersMsg, ok := msg.(*dextypes.MsgPlaceOrders)
 	if !ok {
@@ -189,6 +206,95 @@ func DexCancelOrdersDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg s
 		priceDenom := order.GetPriceDenom()
 		assetDenom := order.GetAssetDenom()
 		aclOps = append(aclOps, GetLongShortOrderBookOps(contractAddr, priceDenom, assetDenom)...)
+		aclOps = append(aclOps, GetTriggerBookOps(contractAddr, priceDenom, assetDenom)...)
+	}
+
+	// Last Operation should always be a commit
+	aclOps = append(aclOps, *acltypes.CommitAccessOp())
+	return aclOps, nil
+}
+
+func DexAmendOrdersDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	amendOrdersMsg, ok := msg.(*dextypes.MsgAmendOrders)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrPlaceOrdersGenerator
+	}
+	contractAddr := amendOrdersMsg.ContractAddr
+
+	aclOps := []sdkacltypes.AccessOperation{
+		// in-place quantity reductions
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_DexMem,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemAmendPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_DexMem,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemAmendPrefix(contractAddr)),
+		},
+
+		// price changes are a cancellation plus a new order
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_MEM_CANCEL,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemCancelPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_MEM_CANCEL,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemCancelPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_MEM_ORDER,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemOrderPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_MEM_ORDER,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemOrderPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_NEXT_ORDER_ID,
+			IdentifierTemplate: hex.EncodeToString(dextypes.NextOrderIDPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_NEXT_ORDER_ID,
+			IdentifierTemplate: hex.EncodeToString(dextypes.NextOrderIDPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_REGISTERED_PAIR,
+			IdentifierTemplate: hex.EncodeToString(dextypes.RegisteredPairPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_LONG_ORDER_COUNT,
+			IdentifierTemplate: hex.EncodeToString([]byte(dextypes.LongOrderCountKey)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_SHORT_ORDER_COUNT,
+			IdentifierTemplate: hex.EncodeToString([]byte(dextypes.ShortOrderCountKey)),
+		},
+		// the replacement order inherits the indexed order's fields
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+			IdentifierTemplate: hex.EncodeToString(dextypes.AccountOrderPrefix(contractAddr, amendOrdersMsg.Creator)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_CONTRACT,
+			IdentifierTemplate: hex.EncodeToString([]byte(dexkeeper.ContractPrefixKey)),
+		},
+	}
+
+	for _, amendment := range amendOrdersMsg.GetAmendments() {
+		aclOps = append(aclOps, GetLongShortOrderBookOps(contractAddr, amendment.GetPriceDenom(), amendment.GetAssetDenom())...)
 	}
 
 	// Last Operation should always be a commit
@@ -39,6 +39,7 @@ type KeeperTestSuite struct {
 
 	msgPlaceOrders  *dextypes.MsgPlaceOrders
 	msgCancelOrders *dextypes.MsgCancelOrders
+	msgAmendOrders  *dextypes.MsgAmendOrders
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -122,6 +123,31 @@ func (suite *KeeperTestSuite) PrepareTest() {
 			},
 		},
 	}
+
+	suite.msgAmendOrders = &types.MsgAmendOrders{
+		Creator:      suite.creator,
+		ContractAddr: suite.contract,
+		Amendments: []*types.Amendment{
+			{
+				Id:                1,
+				Price:             sdk.MustNewDecFromStr("10"),
+				NewPrice:          sdk.MustNewDecFromStr("10"),
+				NewQuantity:       sdk.MustNewDecFromStr("5"),
+				PositionDirection: types.PositionDirection_LONG,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+			},
+			{
+				Id:                2,
+				Price:             sdk.MustNewDecFromStr("20"),
+				NewPrice:          sdk.MustNewDecFromStr("21"),
+				NewQuantity:       sdk.MustNewDecFromStr("5"),
+				PositionDirection: types.PositionDirection_SHORT,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+			},
+		},
+	}
 }
 
 func (suite *KeeperTestSuite) TestMsgPlaceOrder() {
@@ -239,6 +265,81 @@ func (suite *KeeperTestSuite) TestMsgCancelOrder() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgAmendOrder() {
+	suite.PrepareTest()
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *dextypes.MsgAmendOrders
+		dynamicDep    bool
+	}{
+		{
+			name:          "default amend order",
+			msg:           suite.msgAmendOrders,
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "dont check synchronous",
+			msg:           suite.msgAmendOrders,
+			expectedError: nil,
+			dynamicDep:    false,
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
+			goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+			suite.Ctx = suite.Ctx.WithContext(goCtx)
+
+			suite.App.DexKeeper.SetLongBook(suite.Ctx, suite.contract, dextypes.LongBook{
+				Price: sdk.MustNewDecFromStr("10"),
+				Entry: &dextypes.OrderEntry{
+					Price:       sdk.MustNewDecFromStr("10"),
+					Quantity:    sdk.MustNewDecFromStr("10"),
+					Allocations: []*dextypes.Allocation{{OrderId: 1, Account: suite.creator, Quantity: sdk.MustNewDecFromStr("10")}},
+					PriceDenom:  keepertest.TestPriceDenom,
+					AssetDenom:  keepertest.TestAssetDenom,
+				},
+			})
+			suite.App.DexKeeper.SetShortBook(suite.Ctx, suite.contract, dextypes.ShortBook{
+				Price: sdk.MustNewDecFromStr("20"),
+				Entry: &dextypes.OrderEntry{
+					Price:       sdk.MustNewDecFromStr("20"),
+					Quantity:    sdk.MustNewDecFromStr("10"),
+					Allocations: []*dextypes.Allocation{{OrderId: 2, Account: suite.creator, Quantity: sdk.MustNewDecFromStr("10")}},
+					PriceDenom:  keepertest.TestPriceDenom,
+					AssetDenom:  keepertest.TestAssetDenom,
+				},
+			})
+
+			handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx)
+			_, err := suite.msgServer.AmendOrders(
+				sdk.WrapSDKContext(handlerCtx),
+				tc.msg,
+			)
+
+			depdenencies, _ := dexacl.DexAmendOrdersDependencyGenerator(
+				suite.App.AccessControlKeeper,
+				handlerCtx,
+				tc.msg,
+			)
+
+			if !tc.dynamicDep {
+				depdenencies = sdkacltypes.SynchronousAccessOps()
+			}
+
+			if tc.expectedError != nil {
+				suite.Require().EqualError(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+			suite.Require().Empty(missing)
+		})
+	}
+}
+
 func TestGeneratorInvalidMessageTypes(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -263,6 +364,13 @@ func TestGeneratorInvalidMessageTypes(t *testing.T) {
 		&oracleVote,
 	)
 	require.Error(t, err)
+
+	_, err = dexacl.DexAmendOrdersDependencyGenerator(
+		testWrapper.App.AccessControlKeeper,
+		testWrapper.Ctx,
+		&oracleVote,
+	)
+	require.Error(t, err)
 }
 
 func (suite *KeeperTestSuite) TestMsgPlaceOrderGenerator() {
@@ -289,3 +397,15 @@ func (suite *KeeperTestSuite) TestMsgCancelOrderGenerator() {
 	err = acltypes.ValidateAccessOps(accessOps)
 	require.NoError(suite.T(), err)
 }
+
+func (suite *KeeperTestSuite) TestMsgAmendOrderGenerator() {
+	suite.PrepareTest()
+	accessOps, err := dexacl.DexAmendOrdersDependencyGenerator(
+		suite.App.AccessControlKeeper,
+		suite.Ctx,
+		suite.msgAmendOrders,
+	)
+	require.NoError(suite.T(), err)
+	err = acltypes.ValidateAccessOps(accessOps)
+	require.NoError(suite.T(), err)
+}
@@ -125,6 +125,66 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 		})
 	}
 }
+func (suite *KeeperTestSuite) TestMsgPrevoteDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.CommitRevealEnabled = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	exchangeRates, err := oracletypes.ParseExchangeRateTuples(suite.defaultExchangeRate)
+	suite.Require().NoError(err)
+	hash := oracletypes.GetAggregateVoteHash("salt", exchangeRates, suite.validator)
+	msg := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, suite.TestAccs[0], suite.validator)
+
+	handlerCtx, cms := utils.CacheTxContext(suite.Ctx)
+	_, err = suite.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(handlerCtx), msg)
+	suite.Require().NoError(err)
+	dependencies, err := oracleacl.MsgPrevoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, msg)
+	suite.Require().NoError(err)
+
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(dependencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
+func (suite *KeeperTestSuite) TestMsgRevealVoteDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.CommitRevealEnabled = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	// the vote reveals the prevote submitted in the previous vote period
+	exchangeRates, err := oracletypes.ParseExchangeRateTuples(suite.defaultExchangeRate)
+	suite.Require().NoError(err)
+	hash := oracletypes.GetAggregateVoteHash("salt", exchangeRates, suite.validator)
+	suite.App.OracleKeeper.SetAggregateExchangeRatePrevote(suite.Ctx, suite.validator, oracletypes.NewAggregateExchangeRatePrevote(hash, suite.validator, 1))
+	ctx := suite.Ctx.WithBlockHeight(int64(params.VotePeriod) + 1)
+	msg := oracletypes.NewMsgAggregateExchangeRateVote(suite.defaultExchangeRate, suite.TestAccs[0], suite.validator)
+	msg.Salt = "salt"
+
+	handlerCtx, cms := utils.CacheTxContext(ctx)
+	_, err = suite.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(handlerCtx), msg)
+	suite.Require().NoError(err)
+	dependencies, err := oracleacl.MsgVoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, msg)
+	suite.Require().NoError(err)
+
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(dependencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
+func TestMsgPrevoteDependencyGenerator(t *testing.T) {
+	tm := time.Now().UTC()
+	valPub := secp256k1.GenPrivKey().PubKey()
+
+	testWrapper := app.NewTestWrapper(t, tm, valPub)
+	valAddr := sdk.ValAddress(valPub.Address())
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, sdk.AccAddress(valPub.Address()), valAddr)
+
+	accessOps, err := oracleacl.MsgPrevoteDependencyGenerator(testWrapper.App.AccessControlKeeper, testWrapper.Ctx, prevote)
+	require.NoError(t, err)
+	err = acltypes.ValidateAccessOps(accessOps)
+	require.NoError(t, err)
+}
+
 func TestMsgVoteDependencyGenerator(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -154,9 +214,11 @@ func TestMsgVoteDependencyGeneratorInvalidMsgType(t *testing.T) {
 
 func TestOracleDependencyGenerator(t *testing.T) {
 	oracleDependencyGenerator := oracleacl.GetOracleDependencyGenerator()
-	// verify that there's one entry, for oracle aggregate vote
-	require.Equal(t, 1, len(oracleDependencyGenerator))
-	// check that oracle vote dep generator is in the map
-	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
+	// verify that there are two entries, for oracle aggregate prevote and vote
+	require.Equal(t, 2, len(oracleDependencyGenerator))
+	// check that oracle prevote and vote dep generators are in the map
+	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})]
+	require.True(t, ok)
+	_, ok = oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
 	require.True(t, ok)
 }
@@ -59,6 +59,7 @@ var StoreKeyToResourceTypePrefixMap = aclsdktypes.StoreKeyToResourceTypePrefixMa
 		aclsdktypes.ResourceType_KV_DEX_MEM_ORDER:   dextypes.KeyPrefix(dextypes.MemOrderKey),
 		aclsdktypes.ResourceType_KV_DEX_MEM_CANCEL:  dextypes.KeyPrefix(dextypes.MemCancelKey),
 		aclsdktypes.ResourceType_KV_DEX_MEM_DEPOSIT: dextypes.KeyPrefix(dextypes.MemDepositKey),
+		aclsdktypes.ResourceType_DexMem:             aclsdktypes.EmptyPrefix,
 	},
 	banktypes.StoreKey: {
 		aclsdktypes.ResourceType_KV_BANK:          aclsdktypes.EmptyPrefix,
@@ -71,3 +71,25 @@ func TestPriorityAnteDecoratorOracleMsg(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(math.MaxInt64-100), newCtx.Priority())
 }
+
+func TestPriorityAnteDecoratorOraclePrevoteMsg(t *testing.T) {
+	output = ""
+	anteDecorators := []sdk.AnteFullDecorator{
+		sdk.DefaultWrappedAnteDecorator(antedecorators.NewPriorityDecorator()),
+	}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	chainedHandler, _ := sdk.ChainAnteDecorators(anteDecorators...)
+	// test with zero priority, should be bumped up to oracle priority
+	newCtx, err := chainedHandler(
+		ctx.WithPriority(0),
+		FakeTx{
+			FakeMsgs: []sdk.Msg{
+				&oracletypes.MsgAggregateExchangeRatePrevote{},
+				&oracletypes.MsgAggregateExchangeRateVote{},
+			},
+		},
+		false,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64-100), newCtx.Priority())
+}
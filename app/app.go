@@ -125,6 +125,7 @@ import (
 	dexmodule "github.com/sei-protocol/sei-chain/x/dex"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	dexmodulekeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexkeeperstream "github.com/sei-protocol/sei-chain/x/dex/keeper/stream"
 	dexmoduletypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 
@@ -571,6 +572,7 @@ func New(
 		wasmOpts...,
 	)
 	app.DexKeeper.SetWasmKeeper(&app.WasmKeeper)
+	app.DexKeeper.SetOracleKeeper(&app.OracleKeeper)
 	dexModule := dexmodule.NewAppModule(appCodec, app.DexKeeper, app.AccountKeeper, app.BankKeeper, app.WasmKeeper, app.GetBaseApp().TracingInfo)
 	epochModule := epochmodule.NewAppModule(appCodec, app.EpochKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -742,6 +744,7 @@ func New(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	dexmoduletypes.RegisterStreamServer(app.GRPCQueryRouter(), dexkeeperstream.NewServer(&app.DexKeeper, app.LastBlockHeight))
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
@@ -1262,6 +1265,8 @@ func (app *App) PartitionPrioritizedTxs(ctx sdk.Context, txs [][]byte) (prioriti
 	msgLoop:
 		for _, msg := range decodedTx.GetMsgs() {
 			switch msg.(type) {
+			case *oracletypes.MsgAggregateExchangeRatePrevote:
+				prioritized = true
 			case *oracletypes.MsgAggregateExchangeRateVote:
 				prioritized = true
 			case *dexmoduletypes.MsgRegisterContract:
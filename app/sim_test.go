@@ -0,0 +1,140 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/x/dex"
+	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// Returns the weighted operations of the dex module. Operations of the other
+// modules are not included since they deliver txs without a dex memstate on the
+// context.
+func dexSimulationOperations(seiApp *app.App) []simtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       seiApp.AppCodec(),
+	}
+	for _, simModule := range seiApp.SimulationManager().Modules {
+		if dexModule, ok := simModule.(dex.AppModule); ok {
+			return dexModule.WeightedOperations(simState)
+		}
+	}
+	return nil
+}
+
+// The randomized wasm genesis never lets everybody upload code, which the dex
+// operations need to store the order-matching contract.
+func allowWasmUploads(t *testing.T, cdc codec.JSONCodec, appState json.RawMessage) json.RawMessage {
+	genesis := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(appState, &genesis))
+	var wasmGenesis wasmtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[wasm.ModuleName], &wasmGenesis)
+	wasmGenesis.Params = wasmtypes.DefaultParams()
+	genesis[wasm.ModuleName] = cdc.MustMarshalJSON(&wasmGenesis)
+	bz, err := json.Marshal(genesis)
+	require.NoError(t, err)
+	return bz
+}
+
+func selectOperation(r *rand.Rand, ops []simtypes.WeightedOperation) simtypes.Operation {
+	totalWeight := 0
+	for _, op := range ops {
+		totalWeight += op.Weight()
+	}
+	x := r.Intn(totalWeight)
+	for _, op := range ops {
+		if x < op.Weight() {
+			return op.Op()
+		}
+		x -= op.Weight()
+	}
+	return ops[0].Op()
+}
+
+// TestDexSimulation runs randomized dex operations against a simulated genesis
+// state. The SDK simulator drives blocks through FinalizeBlock without txs, so
+// blocks are driven manually here the same way ProcessBlock does, with the dex
+// memstate set on the block context. Blocks are not committed.
+func TestDexSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping dex simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	seiApp := app.New(logger, db, nil, true, map[int64]bool{}, dir, simapp.FlagPeriodValue, nil, app.MakeEncodingConfig(), wasm.EnableAllProposals, app.TestAppOpts{}, app.EmptyWasmOpts, app.EmptyACLOpts)
+
+	r := rand.New(rand.NewSource(config.Seed))
+	accs := simtypes.RandomAccounts(r, simtypes.RandIntBetween(r, 10, 30))
+	if simapp.FlagGenesisTimeValue == 0 {
+		// wasm rejects contract calls in blocks timestamped before the unix epoch
+		simapp.FlagGenesisTimeValue = int64(simtypes.RandIntBetween(r, 1, math.MaxInt32))
+	}
+	appState, accs, chainID, genesisTime := simapp.AppStateFn(seiApp.AppCodec(), seiApp.SimulationManager())(r, accs, config)
+	appState = allowWasmUploads(t, seiApp.AppCodec(), appState)
+	_, err = seiApp.InitChain(context.Background(), &abci.RequestInitChain{
+		AppStateBytes:   appState,
+		ChainId:         chainID,
+		ConsensusParams: simapp.DefaultConsensusParams,
+		Time:            genesisTime,
+	})
+	require.NoError(t, err)
+
+	ops := dexSimulationOperations(seiApp)
+	require.NotEmpty(t, ops)
+
+	header := tmproto.Header{ChainID: chainID, Time: genesisTime}
+	ctx := seiApp.NewContext(false, header).WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, seiApp.MemState))
+
+	delivered := 0
+	for height := int64(1); height <= int64(config.NumBlocks); height++ {
+		header.Height = height
+		header.Time = header.Time.Add(time.Duration(simtypes.RandIntBetween(r, 1, 10)) * time.Second)
+		ctx = ctx.WithBlockHeader(header)
+
+		seiApp.BeginBlocker(ctx, abci.RequestBeginBlock{Header: header})
+		for i := 0; i < config.BlockSize; i++ {
+			opMsg, _, err := selectOperation(r, ops)(r, seiApp.BaseApp, ctx, accs, chainID)
+			require.NoError(t, err, "height %d: %s", height, opMsg.Route)
+			if opMsg.OK {
+				delivered++
+			}
+		}
+		seiApp.BankKeeper.WriteDeferredBalances(ctx)
+		seiApp.MidBlocker(ctx, height)
+		seiApp.EndBlocker(ctx, abci.RequestEndBlock{Height: height})
+
+		msg, broken := dexkeeper.AllInvariants(seiApp.DexKeeper)(ctx)
+		require.False(t, broken, "height %d: %s", height, msg)
+	}
+	t.Logf("delivered %d dex messages over %d blocks", delivered, config.NumBlocks)
+}
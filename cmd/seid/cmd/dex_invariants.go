@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/sei-protocol/sei-chain/app"
+	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	FlagGenesis = "genesis"
+	FlagHeight  = "height"
+)
+
+func DexInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dex-invariants",
+		Short: "Run the dex module invariants against an exported genesis or a local application DB",
+		Long: fmt.Sprintf(`Run the dex module invariants against an exported genesis or a local application DB.
+If no genesis file is specified, the application DB of the node's home directory is used.
+
+Example:
+$ %s debug dex-invariants --genesis exported.json
+$ %s debug dex-invariants --db-path ~/.sei/data/application.db --height 12345 --chain-id pacific-1
+			`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: dexInvariantsCmdHandler,
+	}
+
+	cmd.Flags().String(FlagGenesis, "", "The exported genesis file to check")
+	cmd.Flags().StringP(FlagDBPath, "d", "", "The path to the db, default is $HOME/.sei/data/application.db")
+	cmd.Flags().Int64(FlagHeight, 0, "The height to check the db at, if none specified, the latest height will be used")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID of the db, not needed with --genesis")
+
+	return cmd
+}
+
+func dexInvariantsCmdHandler(cmd *cobra.Command, _ []string) error {
+	genesisPath, err := cmd.Flags().GetString(FlagGenesis)
+	if err != nil {
+		return err
+	}
+	var ctx sdk.Context
+	var seiApp *app.App
+	if genesisPath != "" {
+		seiApp, ctx, err = loadAppFromGenesis(cmd, genesisPath)
+	} else {
+		seiApp, ctx, err = loadAppFromDB(cmd)
+	}
+	if err != nil {
+		return err
+	}
+
+	registry := &invariantCollector{}
+	dexkeeper.RegisterInvariants(registry, seiApp.DexKeeper)
+	brokenCount := 0
+	for _, route := range registry.routes {
+		res, broken := route.invariant(ctx)
+		if broken {
+			brokenCount++
+			fmt.Printf("BROKEN %s", res)
+		} else {
+			fmt.Printf("OK %s/%s\n", route.module, route.route)
+		}
+	}
+	if brokenCount > 0 {
+		return fmt.Errorf("%d of %d dex invariants are broken", brokenCount, len(registry.routes))
+	}
+	return nil
+}
+
+// Imports the genesis into an in-memory app. Invariants of other modules are not
+// asserted on import.
+func loadAppFromGenesis(cmd *cobra.Command, genesisPath string) (*app.App, sdk.Context, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisPath)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	homeDir, err := os.MkdirTemp("", "dex-invariants")
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	defer os.RemoveAll(homeDir)
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	serverCtx.Viper.Set(crisis.FlagSkipGenesisInvariants, true)
+	serverCtx.Viper.Set(flags.FlagChainID, genDoc.ChainID)
+	seiApp := app.New(
+		serverCtx.Logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, homeDir, 0, nil,
+		app.MakeEncodingConfig(), app.GetWasmEnabledProposals(), serverCtx.Viper, app.EmptyWasmOpts, app.EmptyACLOpts,
+	)
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	if _, err := seiApp.InitChain(context.Background(), &abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		InitialHeight:   genDoc.InitialHeight,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   genDoc.AppState,
+	}); err != nil {
+		return nil, sdk.Context{}, err
+	}
+	header := tmproto.Header{ChainID: genDoc.ChainID, Height: genDoc.InitialHeight, Time: genDoc.GenesisTime}
+	return seiApp, seiApp.BaseApp.NewContext(false, header), nil
+}
+
+// Loads the app from the application DB. State is read through a cache so that
+// nothing is written back to the DB.
+func loadAppFromDB(cmd *cobra.Command) (*app.App, sdk.Context, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	dbPath, err := cmd.Flags().GetString(FlagDBPath)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	if dbPath == "" {
+		dbPath = filepath.Join(serverCtx.Config.RootDir, "data", "application.db")
+	}
+	height, err := cmd.Flags().GetInt64(FlagHeight)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+
+	db, err := OpenDB(dbPath)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	seiApp := app.New(
+		serverCtx.Logger, db, nil, true, map[int64]bool{}, serverCtx.Config.RootDir, 0, nil,
+		app.MakeEncodingConfig(), app.GetWasmEnabledProposals(), serverCtx.Viper, app.EmptyWasmOpts, app.EmptyACLOpts,
+	)
+	if height > 0 {
+		if err := seiApp.LoadHeight(height); err != nil {
+			return nil, sdk.Context{}, err
+		}
+	}
+	header := tmproto.Header{Height: seiApp.LastBlockHeight()}
+	return seiApp, sdk.NewContext(seiApp.CommitMultiStore().CacheMultiStore(), header, false, serverCtx.Logger), nil
+}
+
+type invariantRoute struct {
+	module    string
+	route     string
+	invariant sdk.Invariant
+}
+
+// Collects registered invariants so that they can be run outside of x/crisis.
+type invariantCollector struct {
+	routes []invariantRoute
+}
+
+func (c *invariantCollector) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	c.routes = append(c.routes, invariantRoute{module: moduleName, route: route, invariant: invar})
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/sei-protocol/sei-chain/app"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	FlagOutput = "output"
+)
+
+type replayedTx struct {
+	Index   int    `json:"index"`
+	Code    uint32 `json:"code"`
+	Log     string `json:"log,omitempty"`
+	GasUsed int64  `json:"gas_used"`
+}
+
+type dexBlockReplay struct {
+	ChainID  string                    `json:"chain_id"`
+	Height   int64                     `json:"height"`
+	Txs      []replayedTx              `json:"txs"`
+	Attempts []*dexutils.ReplayAttempt `json:"attempts"`
+}
+
+func ReplayDexBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-dex-block [height]",
+		Short: "Replay a block against the local application DB and dump a detailed trace of the dex EndBlocker",
+		Long: fmt.Sprintf(`Replay a block against the local application DB and dump a detailed trace of the dex EndBlocker.
+The application state is loaded at height - 1 and the block's transactions are fetched from the node.
+Transactions are delivered one at a time and contracts are run sequentially, and every sudo payload,
+response, gas usage, settlement and error stack is written out as JSON. Nothing is written back to the DB.
+Votes of the last commit are not replayed, so only state that doesn't depend on them is accurate.
+
+Example:
+$ %s debug replay-dex-block 12345 --node tcp://localhost:26657 --db-path ~/.sei/data/application.db --output replay.json
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: replayDexBlockCmdHandler,
+	}
+
+	cmd.Flags().StringP(FlagDBPath, "d", "", "The path to the db, default is $HOME/.sei/data/application.db")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface to fetch the block from")
+	cmd.Flags().String(FlagOutput, "", "The file to write the trace to, if none specified, it is written to stdout")
+
+	return cmd
+}
+
+func replayDexBlockCmdHandler(cmd *cobra.Command, args []string) error {
+	height, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	if height <= 1 {
+		return fmt.Errorf("height must be greater than 1, got %d", height)
+	}
+	nodeURI, err := cmd.Flags().GetString(flags.FlagNode)
+	if err != nil {
+		return err
+	}
+	dbPath, err := cmd.Flags().GetString(FlagDBPath)
+	if err != nil {
+		return err
+	}
+	outputPath, err := cmd.Flags().GetString(FlagOutput)
+	if err != nil {
+		return err
+	}
+
+	rpcClient, err := client.NewClientFromNode(nodeURI)
+	if err != nil {
+		return err
+	}
+	block, err := rpcClient.Block(context.Background(), &height)
+	if err != nil {
+		return err
+	}
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	if dbPath == "" {
+		dbPath = filepath.Join(serverCtx.Config.RootDir, "data", "application.db")
+	}
+	db, err := OpenDB(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	seiApp := app.New(
+		serverCtx.Logger, db, nil, true, map[int64]bool{}, serverCtx.Config.RootDir, 0, nil,
+		app.MakeEncodingConfig(), app.GetWasmEnabledProposals(), serverCtx.Viper, app.EmptyWasmOpts, app.EmptyACLOpts,
+	)
+	if err := seiApp.LoadHeight(height - 1); err != nil {
+		return err
+	}
+
+	header := tmproto.Header{
+		ChainID:         block.Block.ChainID,
+		Height:          height,
+		Time:            block.Block.Time,
+		ProposerAddress: block.Block.ProposerAddress,
+	}
+	recorder := dexutils.NewReplayRecorder()
+	ctx := sdk.NewContext(seiApp.CommitMultiStore().CacheMultiStore(), header, false, serverCtx.Logger).
+		WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	ctx = ctx.WithConsensusParams(seiApp.GetConsensusParams(ctx))
+	goCtx := context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, seiApp.MemState)
+	ctx = ctx.WithContext(context.WithValue(goCtx, dexutils.DexReplayRecorderContextKey, recorder))
+
+	txs := make([][]byte, len(block.Block.Txs))
+	for i, tx := range block.Block.Txs {
+		txs[i] = tx
+	}
+	txResults := replayBlock(ctx, seiApp, header, txs)
+
+	replay := dexBlockReplay{
+		ChainID:  header.ChainID,
+		Height:   height,
+		Txs:      make([]replayedTx, len(txResults)),
+		Attempts: recorder.Attempts(),
+	}
+	for i, res := range txResults {
+		replay.Txs[i] = replayedTx{Index: i, Code: res.Code, Log: res.Log, GasUsed: res.GasUsed}
+	}
+	bz, err := json.MarshalIndent(replay, "", "  ")
+	if err != nil {
+		return err
+	}
+	if outputPath == "" {
+		fmt.Println(string(bz))
+		return nil
+	}
+	return os.WriteFile(outputPath, bz, 0600)
+}
+
+// Mirrors `App.ProcessBlock`, except that transactions are delivered one at a
+// time instead of concurrently based on their dependencies.
+func replayBlock(ctx sdk.Context, seiApp *app.App, header tmproto.Header, txs [][]byte) []*abci.ExecTxResult {
+	seiApp.BeginBlocker(ctx, abci.RequestBeginBlock{Header: header})
+
+	txResults := make([]*abci.ExecTxResult, len(txs))
+	prioritizedTxs, otherTxs, prioritizedIndices, otherIndices := seiApp.PartitionPrioritizedTxs(ctx, txs)
+	for i, res := range seiApp.ProcessBlockSynchronous(ctx, prioritizedTxs) {
+		txResults[prioritizedIndices[i]] = res
+	}
+	seiApp.BankKeeper.WriteDeferredBalances(ctx)
+	seiApp.MidBlocker(ctx, header.Height)
+	for i, res := range seiApp.ProcessBlockSynchronous(ctx, otherTxs) {
+		txResults[otherIndices[i]] = res
+	}
+	seiApp.BankKeeper.WriteDeferredBalances(ctx)
+	seiApp.EndBlocker(ctx, abci.RequestEndBlock{Height: header.Height})
+	return txResults
+}
@@ -115,6 +115,8 @@ func initRootCmd(
 	// extend debug command
 	debugCmd := debug.Cmd()
 	debugCmd.AddCommand(DumpIavlCmd())
+	debugCmd.AddCommand(DexInvariantsCmd())
+	debugCmd.AddCommand(ReplayDexBlockCmd())
 
 	rootCmd.AddCommand(
 		InitCmd(app.ModuleBasics, app.DefaultNodeHome),
@@ -46,3 +46,8 @@ type CancelOrders struct {
 	Cancellations []*types.Cancellation `json:"cancellations"`
 	ContractAddr  string                `json:"contract_address"`
 }
+
+type AmendOrders struct {
+	Amendments   []*types.Amendment `json:"amendments"`
+	ContractAddr string             `json:"contract_address"`
+}
@@ -13,6 +13,7 @@ import (
 type SeiWasmMessage struct {
 	PlaceOrders  json.RawMessage `json:"place_orders,omitempty"`
 	CancelOrders json.RawMessage `json:"cancel_orders,omitempty"`
+	AmendOrders  json.RawMessage `json:"amend_orders,omitempty"`
 	CreateDenom  json.RawMessage `json:"create_denom,omitempty"`
 	MintTokens   json.RawMessage `json:"mint_tokens,omitempty"`
 	BurnTokens   json.RawMessage `json:"burn_tokens,omitempty"`
@@ -30,6 +31,8 @@ func CustomEncoder(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error
 		return dexwasm.EncodeDexPlaceOrders(parsedMessage.PlaceOrders, sender)
 	case parsedMessage.CancelOrders != nil:
 		return dexwasm.EncodeDexCancelOrders(parsedMessage.CancelOrders, sender)
+	case parsedMessage.AmendOrders != nil:
+		return dexwasm.EncodeDexAmendOrders(parsedMessage.AmendOrders, sender)
 	case parsedMessage.CreateDenom != nil:
 		return tokenfactorywasm.EncodeTokenFactoryCreateDenom(parsedMessage.CreateDenom, sender)
 	case parsedMessage.MintTokens != nil:
@@ -62,6 +62,28 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 			return nil, oracletypes.ErrEncodingOracleTwaps
 		}
 
+		return bz, nil
+	case parsedQuery.OraclePriceStats != nil:
+		res, err := qp.oracleHandler.GetOraclePriceStats(ctx, parsedQuery.OraclePriceStats)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingPriceStats
+		}
+
+		return bz, nil
+	case parsedQuery.CircuitBreakerStatus != nil:
+		res, err := qp.oracleHandler.GetCircuitBreakerStatus(ctx, parsedQuery.CircuitBreakerStatus)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingCircuitBreakerStatus
+		}
+
 		return bz, nil
 	default:
 		return nil, oracletypes.ErrUnknownSeiOracleQuery
@@ -128,6 +150,28 @@ func (qp QueryPlugin) HandleDexQuery(ctx sdk.Context, queryData json.RawMessage)
 			return nil, dextypes.ErrEncodingLatestPrice
 		}
 
+		return bz, nil
+	case parsedQuery.GetOrdersByAccount != nil:
+		res, err := qp.dexHandler.GetOrdersByAccount(ctx, parsedQuery.GetOrdersByAccount)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingOrders
+		}
+
+		return bz, nil
+	case parsedQuery.GetOrderBookDepth != nil:
+		res, err := qp.dexHandler.GetOrderBookDepth(ctx, parsedQuery.GetOrderBookDepth)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingOrderBookDepth
+		}
+
 		return bz, nil
 	default:
 		return nil, dextypes.ErrUnknownSeiDexQuery
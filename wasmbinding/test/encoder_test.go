@@ -75,12 +75,41 @@ func TestDecodeOrderCancellation(t *testing.T) {
 	expectedMsg := dextypes.MsgCancelOrders{
 		Creator: TEST_CREATOR,
 		Cancellations: []*types.Cancellation{
-			{Id: 1, Price: sdk.ZeroDec()},
+			{Id: 1, Price: sdk.ZeroDec(), Quantity: sdk.ZeroDec()},
 		},
 		ContractAddr: TEST_TARGET_CONTRACT,
 	}
 	require.Equal(t, expectedMsg.Creator, typedDecodedMsg.Creator)
 	require.Equal(t, *expectedMsg.Cancellations[0], *typedDecodedMsg.Cancellations[0])
+	require.False(t, typedDecodedMsg.Cancellations[0].IsPartial())
+	require.Equal(t, expectedMsg.ContractAddr, typedDecodedMsg.ContractAddr)
+}
+
+func TestDecodeOrderAmendment(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32("sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw")
+	require.NoError(t, err)
+	msg := bindings.AmendOrders{
+		Amendments: []*types.Amendment{
+			{Id: 1, Price: sdk.NewDec(10), NewPrice: sdk.NewDec(11), NewQuantity: sdk.NewDec(5)},
+		},
+		ContractAddr: TEST_TARGET_CONTRACT,
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := dexwasm.EncodeDexAmendOrders(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*dextypes.MsgAmendOrders)
+	require.True(t, ok)
+	expectedMsg := dextypes.MsgAmendOrders{
+		Creator: TEST_CREATOR,
+		Amendments: []*types.Amendment{
+			{Id: 1, Price: sdk.NewDec(10), NewPrice: sdk.NewDec(11), NewQuantity: sdk.NewDec(5)},
+		},
+		ContractAddr: TEST_TARGET_CONTRACT,
+	}
+	require.Equal(t, expectedMsg.Creator, typedDecodedMsg.Creator)
+	require.Equal(t, *expectedMsg.Amendments[0], *typedDecodedMsg.Amendments[0])
 	require.Equal(t, expectedMsg.ContractAddr, typedDecodedMsg.ContractAddr)
 }
 
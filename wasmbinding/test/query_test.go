@@ -152,6 +152,80 @@ func TestWasmGetOracleTwaps(t *testing.T) {
 	}}, parsedRes2)
 }
 
+func TestWasmGetOraclePriceStats(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{OraclePriceStats: &oracletypes.QueryPriceStatsRequest{LookbackSeconds: 200, HalfLifeSeconds: 100}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	// this should error because there is no snapshots to build the stats from
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.Error(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
+	priceSnapshot := oracletypes.PriceSnapshot{SnapshotTimestamp: 3600, PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(10)}),
+	}}
+	testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, priceSnapshot)
+	testWrapper.App.OracleKeeper.SetVoteTarget(testWrapper.Ctx, oracleutils.MicroAtomDenom)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3700, 0))
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryPriceStatsResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.QueryPriceStatsResponse{PriceStats: oracletypes.OraclePriceStatsList{
+		oracletypes.OraclePriceStats{
+			Denom:              oracleutils.MicroAtomDenom,
+			Ema:                sdk.NewDec(20),
+			RealizedVolatility: sdk.ZeroDec(),
+			High:               sdk.NewDec(20),
+			Low:                sdk.NewDec(20),
+			SampleCount:        1,
+			LookbackSeconds:    100,
+		},
+	}}, parsedRes)
+}
+
+func TestWasmGetCircuitBreakerStatus(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{CircuitBreakerStatus: &oracletypes.QueryCircuitBreakerStatusRequest{Denom: oracleutils.MicroAtomDenom}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryCircuitBreakerStatusResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.False(t, parsedRes.CircuitBreakerStatus.Halted)
+
+	halted := oracletypes.CircuitBreakerStatus{Denom: oracleutils.MicroAtomDenom, Halted: true, HeldExchangeRate: sdk.NewDec(12)}
+	testWrapper.App.OracleKeeper.SetCircuitBreakerStatus(testWrapper.Ctx, halted)
+
+	res, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes2 oracletypes.QueryCircuitBreakerStatusResponse
+	err = json.Unmarshal(res, &parsedRes2)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.QueryCircuitBreakerStatusResponse{CircuitBreakerStatus: halted}, parsedRes2)
+}
+
 func TestWasmGetOracleTwapsErrorHandling(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -240,6 +314,84 @@ func TestWasmDexGetOrderByIdErrorHandling(t *testing.T) {
 	require.IsType(t, dextypes.ErrInvalidOrderID, err)
 }
 
+func TestWasmGetOrdersByAccount(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetOrdersByAccount: &dextypes.QueryGetOrdersByAccountRequest{
+		ContractAddr: app.TestContract,
+		Account:      keepertest.TestAccount,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.App.DexKeeper.SetAccountOrder(testWrapper.Ctx, app.TestContract, dextypes.Order{
+		Id:                1,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      app.TestContract,
+		Price:             sdk.NewDec(10),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        "sei",
+		AssetDenom:        "atom",
+		PositionDirection: dextypes.PositionDirection_LONG,
+		Nominal:           sdk.ZeroDec(),
+		TriggerPrice:      sdk.ZeroDec(),
+	})
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetOrdersByAccountResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Orders))
+	require.Equal(t, uint64(1), parsedRes.Orders[0].Id)
+}
+
+func TestWasmGetOrderBookDepth(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetOrderBookDepth: &dextypes.QueryGetOrderBookDepthRequest{
+		ContractAddr: app.TestContract,
+		PriceDenom:   "sei",
+		AssetDenom:   "atom",
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	pair := dextypes.Pair{PriceDenom: "sei", AssetDenom: "atom"}
+	testWrapper.App.DexKeeper.AddRegisteredPair(testWrapper.Ctx, app.TestContract, pair)
+	testWrapper.App.DexKeeper.SetPriceTickSizeForPair(testWrapper.Ctx, app.TestContract, pair, sdk.OneDec())
+	testWrapper.App.DexKeeper.SetLongBook(testWrapper.Ctx, app.TestContract, dextypes.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &dextypes.OrderEntry{
+			Price:       sdk.NewDec(10),
+			Quantity:    sdk.NewDec(3),
+			Allocations: []*dextypes.Allocation{{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(3)}},
+			PriceDenom:  "sei",
+			AssetDenom:  "atom",
+		},
+	})
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetOrderBookDepthResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Longs))
+	require.Equal(t, sdk.NewDec(10), parsedRes.Longs[0].Price)
+	require.Equal(t, sdk.NewDec(3), parsedRes.Longs[0].Quantity)
+	require.Equal(t, 0, len(parsedRes.Shorts))
+}
+
 func TestWasmGetOrderSimulation(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
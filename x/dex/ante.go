@@ -8,6 +8,7 @@ import (
 	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/sei-protocol/sei-chain/x/dex/utils"
@@ -60,10 +61,13 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 				}
 				if !IsDecimalMultipleOf(order.Price, priceTickSize) {
 					// Allow Market Orders with Price 0
-					if !(IsMarketOrder(order) && order.Price.IsZero()) {
+					if !((IsMarketOrder(order) || order.OrderType == types.OrderType_STOPLOSS) && order.Price.IsZero()) {
 						return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
 					}
 				}
+				if exchange.IsTriggerOrder(order) && !IsDecimalMultipleOf(order.TriggerPrice, priceTickSize) {
+					return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "trigger price needs to be non-zero and multiple of price tick size")
+				}
 				quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr,
 					types.Pair{
 						PriceDenom: order.PriceDenom,
@@ -77,6 +81,47 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 				}
 			}
 			continue
+		case *types.MsgAmendOrders:
+			msgAmendOrders := msg.(*types.MsgAmendOrders) //nolint:gosimple // the linter is telling us we can make this faster, and this should be addressed later.
+			contractAddr := msgAmendOrders.ContractAddr
+			for _, amendment := range msgAmendOrders.Amendments {
+				pair := types.Pair{
+					PriceDenom: amendment.PriceDenom,
+					AssetDenom: amendment.AssetDenom,
+				}
+				priceTickSize, found := tsmd.dexKeeper.GetPriceTickSizeForPair(ctx, contractAddr, pair)
+				if !found {
+					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", amendment.PriceDenom, amendment.AssetDenom)
+				}
+				if !IsDecimalMultipleOf(amendment.NewPrice, priceTickSize) {
+					return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "new price needs to be non-zero and multiple of price tick size")
+				}
+				quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr, pair)
+				if !found {
+					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no quantity ticksize configured", amendment.PriceDenom, amendment.AssetDenom)
+				}
+				if !IsDecimalMultipleOf(amendment.NewQuantity, quantityTickSize) {
+					return sdkerrors.Wrapf(errors.New("ErrQuantityNotMultipleOfTickSize"), "new quantity needs to be non-zero and multiple of quantity tick size")
+				}
+			}
+			continue
+		case *types.MsgPlaceRoutedOrder:
+			msgPlaceRoutedOrder := msg.(*types.MsgPlaceRoutedOrder) //nolint:gosimple // the linter is telling us we can make this faster, and this should be addressed later.
+			for _, hop := range msgPlaceRoutedOrder.Hops {
+				priceTickSize, found := tsmd.dexKeeper.GetPriceTickSizeForPair(ctx, hop.ContractAddr,
+					types.Pair{
+						PriceDenom: hop.PriceDenom,
+						AssetDenom: hop.AssetDenom,
+					})
+				if !found {
+					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", hop.PriceDenom, hop.AssetDenom)
+				}
+				// hops are market orders, which are allowed to have no worst price
+				if !hop.Price.IsZero() && !IsDecimalMultipleOf(hop.Price, priceTickSize) {
+					return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
+				}
+			}
+			continue
 		default:
 			// e.g. liquidation order don't come with price so always pass this check
 			return nil
@@ -86,6 +131,58 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 	return nil
 }
 
+// HaltedPairDecorator rejects place order, amend order and routed order txs for pairs
+// that are halted. Cancellations are still allowed for halted pairs.
+type HaltedPairDecorator struct {
+	dexKeeper keeper.Keeper
+}
+
+// NewHaltedPairDecorator returns new halted pair check decorator instance
+func NewHaltedPairDecorator(dexKeeper keeper.Keeper) HaltedPairDecorator {
+	return HaltedPairDecorator{
+		dexKeeper: dexKeeper,
+	}
+}
+
+func (hpd HaltedPairDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	if !simulate && ctx.IsCheckTx() {
+		if err := hpd.CheckHaltedPairs(ctx, tx.GetMsgs()); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// CheckHaltedPairs checks that none of the msgs place, amend or route orders on a halted pair
+func (hpd HaltedPairDecorator) CheckHaltedPairs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *types.MsgPlaceOrders:
+			for _, order := range m.Orders {
+				if hpd.dexKeeper.IsPairHalted(ctx, m.ContractAddr, order.PriceDenom, order.AssetDenom) {
+					return sdkerrors.Wrapf(types.ErrPairHalted, "{price:%s,asset:%s}", order.PriceDenom, order.AssetDenom)
+				}
+			}
+		case *types.MsgAmendOrders:
+			for _, amendment := range m.Amendments {
+				if hpd.dexKeeper.IsPairHalted(ctx, m.ContractAddr, amendment.PriceDenom, amendment.AssetDenom) {
+					return sdkerrors.Wrapf(types.ErrPairHalted, "{price:%s,asset:%s}", amendment.PriceDenom, amendment.AssetDenom)
+				}
+			}
+		case *types.MsgPlaceRoutedOrder:
+			for _, hop := range m.Hops {
+				if hpd.dexKeeper.IsPairHalted(ctx, hop.ContractAddr, hop.PriceDenom, hop.AssetDenom) {
+					return sdkerrors.Wrapf(types.ErrPairHalted, "{price:%s,asset:%s}", hop.PriceDenom, hop.AssetDenom)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Check whether order is market order type
 func IsMarketOrder(order *types.Order) bool {
 	return order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE
@@ -138,6 +235,23 @@ func (d CheckDexGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		case *types.MsgCancelOrders:
 			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
 			dexGasRequired += params.DefaultGasPerCancel * uint64(len(m.Cancellations)*numDependencies)
+		case *types.MsgAmendOrders:
+			// in-place reductions are applied like cancellations while price changes
+			// cancel the order and place its replacement
+			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+			for _, amendment := range m.Amendments {
+				dexGasRequired += params.DefaultGasPerCancel * uint64(numDependencies)
+				if !amendment.NewPrice.Equal(amendment.Price) {
+					dexGasRequired += params.DefaultGasPerOrder * uint64(numDependencies)
+				}
+			}
+		case *types.MsgPlaceRoutedOrder:
+			// every hop places an order on its contract
+			for _, hop := range m.Hops {
+				numDependencies := len(memState.GetContractToDependencies(ctx, hop.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+				dexGasRequired += params.DefaultGasPerOrder * uint64(numDependencies)
+				dexGasRequired += params.DefaultGasPerOrderDataByte * uint64(len(hop.Data))
+			}
 		}
 	}
 	if dexGasRequired == 0 {
@@ -161,7 +275,7 @@ func (d CheckDexGasDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
 		switch msg.(type) {
-		case *types.MsgPlaceOrders, *types.MsgCancelOrders:
+		case *types.MsgPlaceOrders, *types.MsgCancelOrders, *types.MsgAmendOrders, *types.MsgPlaceRoutedOrder:
 			deps = append(deps, []sdkacltypes.AccessOperation{
 				// read the dex contract info
 				{
@@ -123,6 +123,24 @@ func TestCheckDexGasDecorator(t *testing.T) {
 	}
 	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
 	require.Nil(t, err)
+
+	// an in-place reduction costs a cancel and a price change a cancel and an order
+	amendments := []*types.Amendment{
+		{Price: sdk.NewDec(10), NewPrice: sdk.NewDec(10), NewQuantity: sdk.NewDec(1)},
+		{Price: sdk.NewDec(10), NewPrice: sdk.NewDec(11), NewQuantity: sdk.NewDec(1)},
+	}
+	tx = TestTx{
+		msgs: []sdk.Msg{types.NewMsgAmendOrders("someone", amendments, keepertest.TestContract)},
+		fee:  sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(16099))),
+	}
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.NotNil(t, err)
+	tx = TestTx{
+		msgs: []sdk.Msg{types.NewMsgAmendOrders("someone", amendments, keepertest.TestContract)},
+		fee:  sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(16100))),
+	}
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.Nil(t, err)
 }
 
 func TestTickSizeMultipleDecorator(t *testing.T) {
@@ -280,4 +298,71 @@ func TestTickSizeMultipleDecorator(t *testing.T) {
 	}
 	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
 	require.NotNil(t, err)
+
+	// Amendments need a new price and quantity that are multiples of the tick sizes
+	newAmendment := func(newPrice sdk.Dec, newQuantity sdk.Dec) *types.Amendment {
+		return &types.Amendment{
+			Id:          1,
+			PriceDenom:  keepertest.TestPair.PriceDenom,
+			AssetDenom:  keepertest.TestPair.AssetDenom,
+			Price:       price,
+			NewPrice:    newPrice,
+			NewQuantity: newQuantity,
+		}
+	}
+	for _, tc := range []struct {
+		amendment *types.Amendment
+		valid     bool
+	}{
+		{newAmendment(price.Add(sdk.OneDec()), quantity), true},
+		{newAmendment(price.Add(smallerVal), quantity), false},
+		{newAmendment(price, smallerVal), false},
+	} {
+		tx = TestTx{
+			msgs: []sdk.Msg{types.NewMsgAmendOrders("someone", []*types.Amendment{tc.amendment}, "contract")},
+		}
+		_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+		require.Equal(t, tc.valid, err == nil)
+	}
+}
+
+func TestHaltedPairDecorator(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithIsCheckTx(true)
+	decorator := dex.NewHaltedPairDecorator(*keeper)
+	terminator := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+	keeper.AddRegisteredPair(ctx, "contract", keepertest.TestPair)
+	order := &types.Order{
+		ContractAddr: "contract",
+		PriceDenom:   keepertest.TestPair.PriceDenom,
+		AssetDenom:   keepertest.TestPair.AssetDenom,
+		Price:        sdk.NewDec(25),
+		Quantity:     sdk.NewDec(5),
+		OrderType:    types.OrderType_LIMIT,
+	}
+	placeTx := TestTx{msgs: []sdk.Msg{types.NewMsgPlaceOrders("someone", []*types.Order{order}, "contract", sdk.NewCoins())}}
+	amendTx := TestTx{msgs: []sdk.Msg{types.NewMsgAmendOrders("someone", []*types.Amendment{{
+		PriceDenom: keepertest.TestPair.PriceDenom,
+		AssetDenom: keepertest.TestPair.AssetDenom,
+	}}, "contract")}}
+	cancelTx := TestTx{msgs: []sdk.Msg{types.NewMsgCancelOrders("someone", []*types.Cancellation{{
+		PriceDenom: keepertest.TestPair.PriceDenom,
+		AssetDenom: keepertest.TestPair.AssetDenom,
+	}}, "contract")}}
+
+	_, err := decorator.AnteHandle(ctx, placeTx, false, terminator)
+	require.Nil(t, err)
+
+	require.Nil(t, keeper.SetPairHalted(ctx, "contract", keepertest.TestPair.PriceDenom, keepertest.TestPair.AssetDenom, true))
+	_, err = decorator.AnteHandle(ctx, placeTx, false, terminator)
+	require.ErrorIs(t, err, types.ErrPairHalted)
+	_, err = decorator.AnteHandle(ctx, amendTx, false, terminator)
+	require.ErrorIs(t, err, types.ErrPairHalted)
+	_, err = decorator.AnteHandle(ctx, cancelTx, false, terminator)
+	require.Nil(t, err)
+
+	// only checked in CheckTx
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), placeTx, false, terminator)
+	require.Nil(t, err)
 }
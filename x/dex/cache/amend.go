@@ -0,0 +1,49 @@
+package dex
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// BlockAmendments holds amendments of resting orders requested in the current
+// block. Quantity reductions are applied in place. Amendments that change the
+// price are turned into a cancellation and a new order instead, and are only
+// kept (with NewOrderId set) so that the original order can be restored if the
+// new one fails to be placed.
+type BlockAmendments struct {
+	amendStore *prefix.Store
+}
+
+func NewAmendments(amendStore prefix.Store) *BlockAmendments {
+	return &BlockAmendments{amendStore: &amendStore}
+}
+
+func (o *BlockAmendments) Get() (list []*types.Amendment) {
+	iterator := sdk.KVStorePrefixIterator(o.amendStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Amendment
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		list = append(list, &val)
+	}
+
+	return
+}
+
+// Add overwrites any earlier amendment of the same order in the block.
+func (o *BlockAmendments) Add(newItem *types.Amendment) {
+	keybz := make([]byte, 8)
+	binary.BigEndian.PutUint64(keybz, newItem.Id)
+	valbz, err := newItem.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	o.amendStore.Set(keybz, valbz)
+}
@@ -0,0 +1,42 @@
+package dex
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// BlockUpdates holds the order book changes and fills of each pair of a contract
+// in the current block until they are handed over to the stream buffer.
+type BlockUpdates struct {
+	updateStore *prefix.Store
+}
+
+func NewBlockUpdates(updateStore prefix.Store) *BlockUpdates {
+	return &BlockUpdates{updateStore: &updateStore}
+}
+
+func (o *BlockUpdates) Get() (list []*types.PairBlockUpdate) {
+	iterator := sdk.KVStorePrefixIterator(o.updateStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairBlockUpdate
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		list = append(list, &val)
+	}
+
+	return
+}
+
+// Add overwrites any earlier update of the same pair in the block.
+func (o *BlockUpdates) Add(newItem *types.PairBlockUpdate) {
+	valbz, err := newItem.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	o.updateStore.Set(types.PairPrefix(newItem.PriceDenom, newItem.AssetDenom), valbz)
+}
@@ -78,6 +78,28 @@ func (s *MemState) GetBlockCancels(ctx sdk.Context, contractAddr types.ContractA
 	)
 }
 
+func (s *MemState) GetBlockAmendments(ctx sdk.Context, contractAddr types.ContractAddress, pair types.Pair) *BlockAmendments {
+	s.SynchronizeAccess(ctx, contractAddr)
+	return NewAmendments(
+		prefix.NewStore(
+			ctx.KVStore(s.storeKey),
+			types.MemAmendPrefixForPair(
+				string(contractAddr), pair.PriceDenom, pair.AssetDenom,
+			),
+		),
+	)
+}
+
+func (s *MemState) GetBlockUpdates(ctx sdk.Context, contractAddr types.ContractAddress) *BlockUpdates {
+	s.SynchronizeAccess(ctx, contractAddr)
+	return NewBlockUpdates(
+		prefix.NewStore(
+			ctx.KVStore(s.storeKey),
+			types.MemBlockUpdatePrefix(string(contractAddr)),
+		),
+	)
+}
+
 func (s *MemState) GetDepositInfo(ctx sdk.Context, contractAddr types.ContractAddress) *DepositInfo {
 	s.SynchronizeAccess(ctx, contractAddr)
 	return NewDepositInfo(
@@ -117,12 +139,17 @@ func (s *MemState) GetContractToProcess() *datastructures.SyncSet[string] {
 func (s *MemState) Clear(ctx sdk.Context) {
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemOrderKey), func(_ []byte) bool { return true })
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelKey), func(_ []byte) bool { return true })
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemAmendKey), func(_ []byte) bool { return true })
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemDepositKey), func(_ []byte) bool { return true })
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemRoutedOrderKey), func(_ []byte) bool { return true })
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemBlockUpdateKey), func(_ []byte) bool { return true })
 
 	newContractToDependencies := datastructures.NewSyncSet([]string{})
 	s.contractsToProcess = &newContractToDependencies
 }
 
+// Removes the pair's full cancellations. Partial cancellations of orders amended in
+// place are kept so that the contract is still notified of the reductions.
 func (s *MemState) ClearCancellationForPair(ctx sdk.Context, contractAddr types.ContractAddress, pair types.Pair) {
 	s.SynchronizeAccess(ctx, contractAddr)
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelKey), func(v []byte) bool {
@@ -130,7 +157,7 @@ func (s *MemState) ClearCancellationForPair(ctx sdk.Context, contractAddr types.
 		if err := c.Unmarshal(v); err != nil {
 			panic(err)
 		}
-		return c.ContractAddr == string(contractAddr) && c.PriceDenom == pair.PriceDenom && c.AssetDenom == pair.AssetDenom
+		return c.ContractAddr == string(contractAddr) && c.PriceDenom == pair.PriceDenom && c.AssetDenom == pair.AssetDenom && !c.IsPartial()
 	})
 }
 
@@ -158,6 +185,13 @@ func (s *MemState) DeepFilterAccount(ctx sdk.Context, account string) {
 		}
 		return c.Creator == account
 	})
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemAmendKey), func(v []byte) bool {
+		var a types.Amendment
+		if err := a.Unmarshal(v); err != nil {
+			panic(err)
+		}
+		return a.Creator == account
+	})
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemDepositKey), func(v []byte) bool {
 		var d types.DepositInfoEntry
 		if err := d.Unmarshal(v); err != nil {
@@ -165,6 +199,13 @@ func (s *MemState) DeepFilterAccount(ctx sdk.Context, account string) {
 		}
 		return d.Creator == account
 	})
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemRoutedOrderKey), func(v []byte) bool {
+		var r types.RoutedOrder
+		if err := r.Unmarshal(v); err != nil {
+			panic(err)
+		}
+		return r.Creator == account
+	})
 }
 
 func (s *MemState) SynchronizeAccess(ctx sdk.Context, contractAddr types.ContractAddress) {
@@ -22,6 +22,20 @@ func (o *BlockCancellations) Has(cancel *types.Cancellation) bool {
 	return o.cancelStore.Has(keybz)
 }
 
+func (o *BlockCancellations) GetByID(id uint64) (*types.Cancellation, bool) {
+	keybz := make([]byte, 8)
+	binary.BigEndian.PutUint64(keybz, id)
+	valbz := o.cancelStore.Get(keybz)
+	if valbz == nil {
+		return nil, false
+	}
+	var val types.Cancellation
+	if err := val.Unmarshal(valbz); err != nil {
+		panic(err)
+	}
+	return &val, true
+}
+
 func (o *BlockCancellations) Get() (list []*types.Cancellation) {
 	iterator := sdk.KVStorePrefixIterator(o.cancelStore, []byte{})
 
@@ -54,6 +68,12 @@ func (o *BlockCancellations) GetIdsToCancel() (list []uint64) {
 	return
 }
 
+func (o *BlockCancellations) Delete(id uint64) {
+	keybz := make([]byte, 8)
+	binary.BigEndian.PutUint64(keybz, id)
+	o.cancelStore.Delete(keybz)
+}
+
 func (o *BlockCancellations) Add(newItem *types.Cancellation) {
 	keybz := make([]byte, 8)
 	binary.BigEndian.PutUint64(keybz, newItem.Id)
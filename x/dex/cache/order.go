@@ -95,6 +95,8 @@ func (o *BlockOrders) GetSortedMarketOrders(direction types.PositionDirection) [
 	res := o.getOrdersByCriteria(types.OrderType_MARKET, direction)
 	res = append(res, o.getOrdersByCriteria(types.OrderType_FOKMARKET, direction)...)
 	res = append(res, o.getOrdersByCriteria(types.OrderType_FOKMARKETBYVALUE, direction)...)
+	// IOC orders are matched as market orders whose worst price is their limit price
+	res = append(res, o.getOrdersByCriteria(types.OrderType_IOC, direction)...)
 	sort.SliceStable(res, func(i, j int) bool {
 		// a price of 0 indicates that there is no worst price for the order, so it should
 		// always be ranked at the top.
@@ -119,6 +121,11 @@ func (o *BlockOrders) GetLimitOrders(direction types.PositionDirection) []*types
 	return o.getOrdersByCriteria(types.OrderType_LIMIT, direction)
 }
 
+func (o *BlockOrders) GetPostOnlyOrders(direction types.PositionDirection) []*types.Order {
+	res := o.getOrdersByCriteria(types.OrderType_POSTONLY, direction)
+	return append(res, o.getOrdersByCriteria(types.OrderType_POSTONLYREPRICE, direction)...)
+}
+
 func (o *BlockOrders) getOrdersByCriteria(orderType types.OrderType, direction types.PositionDirection) []*types.Order {
 	res := []*types.Order{}
 	iterator := sdk.KVStorePrefixIterator(o.orderStore, []byte{})
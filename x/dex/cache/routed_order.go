@@ -0,0 +1,54 @@
+package dex
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Routed orders span multiple contracts, so they are only accessed outside of
+// contract executions and are not synchronized.
+func (s *MemState) routedOrderStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemRoutedOrderKey))
+}
+
+// AddRoutedOrder assigns the next index in the block to the routed order and stores it.
+func (s *MemState) AddRoutedOrder(ctx sdk.Context, routedOrder *types.RoutedOrder) {
+	routedOrder.Id = uint64(len(s.GetAllRoutedOrders(ctx)))
+	valbz, err := routedOrder.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := s.routedOrderStore(ctx)
+	store.Set(routedOrderKey(routedOrder.Id), valbz)
+}
+
+// GetAllRoutedOrders returns the routed orders of the block, ordered by ID.
+func (s *MemState) GetAllRoutedOrders(ctx sdk.Context) (list []*types.RoutedOrder) {
+	store := s.routedOrderStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(&store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoutedOrder
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		list = append(list, &val)
+	}
+	return
+}
+
+func (s *MemState) DeleteRoutedOrder(ctx sdk.Context, id uint64) {
+	store := s.routedOrderStore(ctx)
+	store.Delete(routedOrderKey(id))
+}
+
+func routedOrderKey(id uint64) []byte {
+	keybz := make([]byte, 8)
+	binary.BigEndian.PutUint64(keybz, id)
+	return keybz
+}
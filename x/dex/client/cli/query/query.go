@@ -41,6 +41,14 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetOrdersByID())
 	cmd.AddCommand(CmdGetMatchResult())
 	cmd.AddCommand(CmdGetOrderCount())
+	cmd.AddCommand(CmdGetUntriggeredOrders())
+	cmd.AddCommand(CmdGetTriggeredOrders())
+	cmd.AddCommand(CmdGetOrdersByAccount())
+	cmd.AddCommand(CmdGetTradesByPair())
+	cmd.AddCommand(CmdGetTradesByAccount())
+	cmd.AddCommand(CmdGetOrderBookDepth())
+	cmd.AddCommand(CmdGetHaltedPairs())
+	cmd.AddCommand(CmdGetContractExecutionStats())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagCodeID = "code-id"
+
+func CmdGetContractExecutionStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contract-execution-stats [contract address] --code-id [code id,optional]",
+		Short: "Query Contract Execution Stats",
+		Long: strings.TrimSpace(`
+			Get the gas used by each type of sudo call, the number of orders processed and the number of
+			failures of a contract, summed over the retained blocks as well as for each block. If a code
+			ID is specified, only blocks executed with that code ID are included.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := cmd.Flags().GetUint64(FlagCodeID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetContractExecutionStats(cmd.Context(), &types.QueryGetContractExecutionStatsRequest{
+				ContractAddr: args[0],
+				CodeId:       codeID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCodeID, 0, "only include blocks executed with this code ID")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
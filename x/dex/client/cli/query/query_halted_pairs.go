@@ -0,0 +1,43 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetHaltedPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-halted-pairs [contract address]",
+		Short: "Query Halted Pairs",
+		Long: strings.TrimSpace(`
+			List the registered pairs of an orderbook specified by contract address that are halted
+			and only accept cancellations.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetHaltedPairs(cmd.Context(), &types.QueryGetHaltedPairsRequest{
+				ContractAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagLevels         = "levels"
+	FlagTickMultiplier = "tick-multiplier"
+)
+
+func CmdGetOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-order-book-depth [contract] [price denom] [asset denom]",
+		Short: "get the order book aggregated into price levels",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			levels, err := cmd.Flags().GetUint64(FlagLevels)
+			if err != nil {
+				return err
+			}
+			tickMultiplier, err := cmd.Flags().GetUint64(FlagTickMultiplier)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.GetOrderBookDepth(context.Background(), &types.QueryGetOrderBookDepthRequest{
+				ContractAddr:   args[0],
+				PriceDenom:     args[1],
+				AssetDenom:     args[2],
+				Levels:         levels,
+				TickMultiplier: tickMultiplier,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagLevels, 0, "number of levels per side (defaults to 10)")
+	cmd.Flags().Uint64(FlagTickMultiplier, 1, "size of a level in multiples of the pair's price tick size")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -94,3 +95,42 @@ func CmdGetOrdersByID() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetOrdersByAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-orders-by-account [contract-address] [account]",
+		Short: "Query open orders for account",
+		Long: strings.TrimSpace(`
+			Get open orders for an account and orderbook specified by contract address, using the per-account order index.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetOrdersByAccountRequest{
+				Pagination:   pageReq,
+				ContractAddr: args[0],
+				Account:      args[1],
+			}
+
+			res, err := queryClient.GetOrdersByAccount(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
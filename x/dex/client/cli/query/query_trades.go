@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+)
+
+func CmdGetTradesByPair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-trades-by-pair [contract-address] [price-denom] [asset-denom]",
+		Short: "Query trades of a pair",
+		Long: strings.TrimSpace(`
+			Get fills recorded in the trade log for the orderbook specified by contract address and pair,
+			optionally bounded by --start-height and --end-height.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			startHeight, endHeight, err := readHeightRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetTradesByPairRequest{
+				Pagination:   pageReq,
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+				StartHeight:  startHeight,
+				EndHeight:    endHeight,
+			}
+
+			res, err := queryClient.GetTradesByPair(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addHeightRangeFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetTradesByAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-trades-by-account [contract-address] [account]",
+		Short: "Query trades of an account",
+		Long: strings.TrimSpace(`
+			Get fills recorded in the trade log in which the account was either the taker or the maker,
+			optionally bounded by --start-height and --end-height.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			startHeight, endHeight, err := readHeightRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetTradesByAccountRequest{
+				Pagination:   pageReq,
+				ContractAddr: args[0],
+				Account:      args[1],
+				StartHeight:  startHeight,
+				EndHeight:    endHeight,
+			}
+
+			res, err := queryClient.GetTradesByAccount(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addHeightRangeFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addHeightRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagStartHeight, 0, "Only return trades at or after this height")
+	cmd.Flags().Uint64(flagEndHeight, 0, "Only return trades at or before this height (0 for no upper bound)")
+}
+
+func readHeightRange(cmd *cobra.Command) (uint64, uint64, error) {
+	startHeight, err := cmd.Flags().GetUint64(flagStartHeight)
+	if err != nil {
+		return 0, 0, err
+	}
+	endHeight, err := cmd.Flags().GetUint64(flagEndHeight)
+	if err != nil {
+		return 0, 0, err
+	}
+	return startHeight, endHeight, nil
+}
@@ -0,0 +1,91 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetUntriggeredOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-untriggered-orders [contract address] [price denom] [asset denom]",
+		Short: "list all untriggered stop orders",
+		Long: strings.TrimSpace(`
+			Lists all stop loss/limit orders for a given contract address and pair that are still waiting for their trigger price to be crossed.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetUntriggeredOrdersRequest{
+				Pagination:   pageReq,
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+			}
+
+			res, err := queryClient.GetUntriggeredOrders(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetTriggeredOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-triggered-orders [contract address] [price denom] [asset denom]",
+		Short: "list all triggered stop orders",
+		Long: strings.TrimSpace(`
+			Lists all stop loss/limit orders for a given contract address and pair that have been triggered and will be matched in the next block.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetTriggeredOrdersRequest{
+				Pagination:   pageReq,
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+			}
+
+			res, err := queryClient.GetTriggeredOrders(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
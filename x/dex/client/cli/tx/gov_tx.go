@@ -58,3 +58,164 @@ func NewAddAssetProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewUpdatePairMatchingAlgorithmProposalTxCmd returns a CLI command handler for creating
+// a governance transaction that changes the matching algorithm of a registered pair.
+func NewUpdatePairMatchingAlgorithmProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pair-matching-algorithm-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the matching algorithm of a pair",
+		Long: strings.TrimSpace(`
+			Submit a proposal to change how a registered pair is matched, e.g. from continuous matching to
+			a uniform-price batch auction, and how fills are allocated across resting orders, e.g. from
+			price-time priority to pro-rata with a minimum allocation and remainder rule.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdatePairMatchingAlgorithmProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+			pair, err := proposal.ToPair()
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdatePairMatchingAlgorithmProposal{
+				Title:        proposal.Title,
+				Description:  proposal.Description,
+				ContractAddr: proposal.ContractAddr,
+				Pair:         pair,
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewHaltPairProposalTxCmd returns a CLI command handler for creating a governance
+// transaction that halts or resumes a registered pair.
+func NewHaltPairProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-pair-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to halt or resume a pair",
+		Long: strings.TrimSpace(`
+			Submit a proposal to halt a registered pair so that it only accepts cancellations and is skipped
+			during matching, or to resume a halted pair when "resume" is set in the proposal file.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseHaltPairProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.HaltPairProposal{
+				Title:        proposal.Title,
+				Description:  proposal.Description,
+				ContractAddr: proposal.ContractAddr,
+				PriceDenom:   proposal.PriceDenom,
+				AssetDenom:   proposal.AssetDenom,
+				Resume:       proposal.Resume,
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdatePairMatchingModeProposalTxCmd returns a CLI command handler for creating a
+// governance transaction that switches a registered pair between continuous matching
+// and batch auctions.
+func NewUpdatePairMatchingModeProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pair-matching-mode-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the matching mode of a pair",
+		Long: strings.TrimSpace(`
+			Submit a proposal to switch a registered pair between continuous matching (CONTINUOUS) and
+			batch auctions (BATCH_AUCTION). The matching algorithm of the pair is left untouched.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdatePairMatchingModeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+			matchingMode, err := types.GetMatchingModeFromStr(proposal.MatchingMode)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdatePairMatchingModeProposal{
+				Title:        proposal.Title,
+				Description:  proposal.Description,
+				ContractAddr: proposal.ContractAddr,
+				PriceDenom:   proposal.PriceDenom,
+				AssetDenom:   proposal.AssetDenom,
+				MatchingMode: matchingMode,
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,7 @@ func GetTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(CmdPlaceOrders())
 	cmd.AddCommand(CmdCancelOrders())
+	cmd.AddCommand(CmdAmendOrders())
 	cmd.AddCommand(CmdRegisterContract())
 	cmd.AddCommand(CmdRegisterPairs())
 	cmd.AddCommand(CmdUnregisterContract())
@@ -33,7 +34,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdatePriceTickSize())
 	cmd.AddCommand(CmdUpdateQuantityTickSize())
 	cmd.AddCommand(NewAddAssetProposalTxCmd())
+	cmd.AddCommand(NewUpdatePairMatchingAlgorithmProposalTxCmd())
 	cmd.AddCommand(CmdUnsuspendContract())
+	cmd.AddCommand(CmdHaltPair())
+	cmd.AddCommand(CmdResumePair())
+	cmd.AddCommand(NewHaltPairProposalTxCmd())
+	cmd.AddCommand(NewUpdatePairMatchingModeProposalTxCmd())
+	cmd.AddCommand(CmdSetRentTopUp())
+	cmd.AddCommand(CmdRevokeRentTopUp())
+	cmd.AddCommand(CmdPlaceRoutedOrder())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,88 @@
+package tx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAmendOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-orders [contract address] [amendments...]",
+		Short: "Bulk amend orders",
+		Long: strings.TrimSpace(`
+			Amend the price and/or quantity of orders resting on an orderbook specified by contract-address. Amendments are represented as strings with the amendment details separated by "?". Amendment details format is OrderID?PositionDirection?Price?PriceDenom?AssetDenom?NewPrice?NewQuantity.
+
+			Reducing the quantity at the same price keeps the order's position in the queue. Changing the price cancels the order and places a new one.
+
+			Example: "1234?LONG?1.01?USDC?ATOM?1.02?5"
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractAddr := args[0]
+			amendments := []*types.Amendment{}
+			for _, amendment := range args[1:] {
+				newAmend := types.Amendment{}
+				amendDetails := strings.Split(amendment, "?")
+				if len(amendDetails) != 7 {
+					return errors.New("amendment details must have the format OrderID?PositionDirection?Price?PriceDenom?AssetDenom?NewPrice?NewQuantity")
+				}
+				newAmend.Id, err = strconv.ParseUint(amendDetails[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				argPositionDir, err := types.GetPositionDirectionFromStr(amendDetails[1])
+				if err != nil {
+					return err
+				}
+				newAmend.PositionDirection = argPositionDir
+				argPrice, err := sdk.NewDecFromStr(amendDetails[2])
+				if err != nil {
+					return err
+				}
+				newAmend.Price = argPrice
+				newAmend.PriceDenom = amendDetails[3]
+				newAmend.AssetDenom = amendDetails[4]
+				argNewPrice, err := sdk.NewDecFromStr(amendDetails[5])
+				if err != nil {
+					return err
+				}
+				newAmend.NewPrice = argNewPrice
+				argNewQuantity, err := sdk.NewDecFromStr(amendDetails[6])
+				if err != nil {
+					return err
+				}
+				newAmend.NewQuantity = argNewQuantity
+				amendments = append(amendments, &newAmend)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAmendOrders(
+				clientCtx.GetFromAddress().String(),
+				amendments,
+				argContractAddr,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdHaltPair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-pair [contract address] [price denom] [asset denom]",
+		Short: "Halt a registered pair",
+		Long: strings.TrimSpace(`
+			Halt a registered pair of an exchange contract. A halted pair rejects new orders and amendments,
+			accepts cancellations and is skipped during matching. Only the contract creator can halt its pairs.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgHaltPair(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+package tx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPlaceRoutedOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-routed-order [input denom] [input quantity] [min output] [hops...] --amount [coins,optional]",
+		Short: "Swap through a path of pairs",
+		Long: strings.TrimSpace(`
+			Swap the input through a path of pairs, possibly registered by different contracts, reverting all hops if the route yields less than the min output.
+			Hops are represented as strings with the hop details separated by "?". Hop details format is ContractAddress?PriceDenom?AssetDenom?WorstPrice?OrderData.
+			The contract of each hop needs to be a dependency of the contract of the previous hop. Funds are sent to the contract of the first hop.
+		`),
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argInputDenom := args[0]
+			argInputQuantity, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			argMinOutput, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+			hops := []*types.RouteHop{}
+			for _, hop := range args[3:] {
+				hopDetails := strings.Split(hop, "?")
+				if len(hopDetails) != 5 {
+					return fmt.Errorf("invalid hop %s", hop)
+				}
+				argPrice, err := sdk.NewDecFromStr(hopDetails[3])
+				if err != nil {
+					return err
+				}
+				hops = append(hops, &types.RouteHop{
+					ContractAddr: hopDetails[0],
+					PriceDenom:   hopDetails[1],
+					AssetDenom:   hopDetails[2],
+					Price:        argPrice,
+					Data:         hopDetails[4],
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceRoutedOrder(
+				clientCtx.GetFromAddress().String(),
+				hops,
+				argInputDenom,
+				argInputQuantity,
+				argMinOutput,
+				amount,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract of the first hop along with command")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
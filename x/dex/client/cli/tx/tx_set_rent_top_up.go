@@ -0,0 +1,71 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagExpiration = "expiration"
+)
+
+func CmdSetRentTopUp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rent-top-up [contract address] [source address] [threshold] [amount] [spend limit] --expiration [unix seconds,optional]",
+		Short: "Set up automatic rent top-up for a contract",
+		Long: strings.TrimSpace(`
+			Let a contract draw rent from a source account whenever its rent balance would fall below the threshold.
+			At least the given amount is drawn per top-up, and no more than the spend limit in total. The transaction
+			needs to be signed by both the contract creator and the source account if they differ.
+		`),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argThreshold, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			argAmount, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+			argSpendLimit, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+			expiration, err := cmd.Flags().GetUint64(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRentTopUp(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				argThreshold,
+				argAmount,
+				argSpendLimit,
+				expiration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagExpiration, 0, "Unix time in seconds after which the top-up can no longer be drawn from")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
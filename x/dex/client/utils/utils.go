@@ -15,6 +15,12 @@ type (
 		AssetDenom       string `json:"asset_denom" yaml:"asset_denom"`
 		PriceTickSize    string `json:"price_tick_size" yaml:"tick_size"`
 		QuantityTickSize string `json:"quantity_tick_size" yaml:"tick_size"`
+		// optional, defaults to continuous matching
+		MatchingMode string `json:"matching_mode,omitempty" yaml:"matching_mode"`
+		// optional, defaults to price-time priority
+		MatchingAlgorithm    string `json:"matching_algorithm,omitempty" yaml:"matching_algorithm"`
+		ProRataMinAllocation string `json:"pro_rata_min_allocation,omitempty" yaml:"pro_rata_min_allocation"`
+		ProRataRemainderRule string `json:"pro_rata_remainder_rule,omitempty" yaml:"pro_rata_remainder_rule"`
 	}
 
 	TickSizeJSON struct {
@@ -52,6 +58,34 @@ type (
 		AssetList   AssetListJSON `json:"asset_list" yaml:"asset_list"`
 		Deposit     string        `json:"deposit" yaml:"deposit"`
 	}
+
+	UpdatePairMatchingAlgorithmProposalJSON struct {
+		Title        string   `json:"title" yaml:"title"`
+		Description  string   `json:"description" yaml:"description"`
+		ContractAddr string   `json:"contract_addr" yaml:"contract_addr"`
+		Pair         PairJSON `json:"pair" yaml:"pair"`
+		Deposit      string   `json:"deposit" yaml:"deposit"`
+	}
+
+	HaltPairProposalJSON struct {
+		Title        string `json:"title" yaml:"title"`
+		Description  string `json:"description" yaml:"description"`
+		ContractAddr string `json:"contract_addr" yaml:"contract_addr"`
+		PriceDenom   string `json:"price_denom" yaml:"price_denom"`
+		AssetDenom   string `json:"asset_denom" yaml:"asset_denom"`
+		Resume       bool   `json:"resume" yaml:"resume"`
+		Deposit      string `json:"deposit" yaml:"deposit"`
+	}
+
+	UpdatePairMatchingModeProposalJSON struct {
+		Title        string `json:"title" yaml:"title"`
+		Description  string `json:"description" yaml:"description"`
+		ContractAddr string `json:"contract_addr" yaml:"contract_addr"`
+		PriceDenom   string `json:"price_denom" yaml:"price_denom"`
+		AssetDenom   string `json:"asset_denom" yaml:"asset_denom"`
+		MatchingMode string `json:"matching_mode" yaml:"matching_mode"`
+		Deposit      string `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // TODO: ADD utils to convert Each type to dex/type (string to denom)
@@ -72,7 +106,43 @@ func NewPair(pair PairJSON) (dextypes.Pair, error) {
 	if quantityTicksize.LTE(sdk.ZeroDec()) {
 		return dextypes.Pair{}, errors.New("quantity ticksize: value cannot be zero or negative")
 	}
-	return dextypes.Pair{PriceDenom: PriceDenom, AssetDenom: AssetDenom, PriceTicksize: &priceTicksize, QuantityTicksize: &quantityTicksize}, nil
+	newPair := dextypes.Pair{PriceDenom: PriceDenom, AssetDenom: AssetDenom, PriceTicksize: &priceTicksize, QuantityTicksize: &quantityTicksize}
+	if err := setMatchingAlgorithm(&newPair, pair); err != nil {
+		return dextypes.Pair{}, err
+	}
+	return newPair, nil
+}
+
+func setMatchingAlgorithm(newPair *dextypes.Pair, pair PairJSON) error {
+	if pair.MatchingMode != "" {
+		mode, err := dextypes.GetMatchingModeFromStr(pair.MatchingMode)
+		if err != nil {
+			return err
+		}
+		newPair.MatchingMode = mode
+	}
+	if pair.MatchingAlgorithm != "" {
+		algorithm, err := dextypes.GetMatchingAlgorithmFromStr(pair.MatchingAlgorithm)
+		if err != nil {
+			return err
+		}
+		newPair.MatchingAlgorithm = algorithm
+	}
+	if pair.ProRataMinAllocation != "" {
+		minAllocation, err := sdk.NewDecFromStr(pair.ProRataMinAllocation)
+		if err != nil {
+			return errors.New("pro-rata min allocation: str to decimal conversion err")
+		}
+		newPair.ProRataMinAllocation = &minAllocation
+	}
+	if pair.ProRataRemainderRule != "" {
+		rule, err := dextypes.GetProRataRemainderRuleFromStr(pair.ProRataRemainderRule)
+		if err != nil {
+			return err
+		}
+		newPair.ProRataRemainderRule = rule
+	}
+	return nil
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
@@ -201,3 +271,63 @@ func ParseAddAssetMetadataProposalJSON(cdc *codec.LegacyAmino, proposalFile stri
 
 	return proposal, nil
 }
+
+// ParseUpdatePairMatchingAlgorithmProposalJSON reads and parses an
+// UpdatePairMatchingAlgorithmProposalJSON from a file.
+func ParseUpdatePairMatchingAlgorithmProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdatePairMatchingAlgorithmProposalJSON, error) {
+	proposal := UpdatePairMatchingAlgorithmProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ToPair converts the pair of the proposal, which only needs to identify the pair
+// and carry the matching algorithm settings.
+func (p UpdatePairMatchingAlgorithmProposalJSON) ToPair() (dextypes.Pair, error) {
+	pair := dextypes.Pair{PriceDenom: p.Pair.PriceDenom, AssetDenom: p.Pair.AssetDenom}
+	if err := setMatchingAlgorithm(&pair, p.Pair); err != nil {
+		return dextypes.Pair{}, err
+	}
+	return pair, nil
+}
+
+// ParseHaltPairProposalJSON reads and parses a HaltPairProposalJSON from a file.
+func ParseHaltPairProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (HaltPairProposalJSON, error) {
+	proposal := HaltPairProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdatePairMatchingModeProposalJSON reads and parses an UpdatePairMatchingModeProposalJSON
+// from a file.
+func ParseUpdatePairMatchingModeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdatePairMatchingModeProposalJSON, error) {
+	proposal := UpdatePairMatchingModeProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
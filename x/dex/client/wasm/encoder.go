@@ -34,3 +34,16 @@ func EncodeDexCancelOrders(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sdk
 	}
 	return []sdk.Msg{&cancelOrdersMsg}, nil
 }
+
+func EncodeDexAmendOrders(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sdk.Msg, error) {
+	encodedAmendOrdersMsg := bindings.AmendOrders{}
+	if err := json.Unmarshal(rawMsg, &encodedAmendOrdersMsg); err != nil {
+		return []sdk.Msg{}, types.ErrEncodeDexAmendOrders
+	}
+	amendOrdersMsg := types.MsgAmendOrders{
+		Creator:      sender.String(),
+		Amendments:   encodedAmendOrdersMsg.Amendments,
+		ContractAddr: encodedAmendOrdersMsg.ContractAddr,
+	}
+	return []sdk.Msg{&amendOrdersMsg}, nil
+}
@@ -46,3 +46,15 @@ func (handler DexWasmQueryHandler) GetLatestPrice(ctx sdk.Context, req *types.Qu
 	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
 	return wrapper.GetLatestPrice(c, req)
 }
+
+func (handler DexWasmQueryHandler) GetOrdersByAccount(ctx sdk.Context, req *types.QueryGetOrdersByAccountRequest) (*types.QueryGetOrdersByAccountResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
+	return wrapper.GetOrdersByAccount(c, req)
+}
+
+func (handler DexWasmQueryHandler) GetOrderBookDepth(ctx sdk.Context, req *types.QueryGetOrderBookDepthRequest) (*types.QueryGetOrderBookDepthResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
+	return wrapper.GetOrderBookDepth(c, req)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime/debug"
 	"sync"
 	"time"
 
@@ -38,6 +39,8 @@ type environment struct {
 	executionTerminationSignals     *datastructures.TypedSyncMap[string, chan struct{}]
 	registeredPairs                 *datastructures.TypedSyncMap[string, []types.Pair]
 	orderBooks                      *datastructures.TypedNestedSyncMap[string, types.PairString, *types.OrderBook]
+	routedOrders                    []*routedOrderProgress
+	routedHopsByContract            map[string][]routedHop
 
 	finalizeMsgMutex  *sync.Mutex
 	eventManagerMutex *sync.Mutex
@@ -49,8 +52,15 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 	defer span.End()
 	defer telemetry.MeasureSince(time.Now(), "dex", "end_blocker_atomic")
 
+	recorder := dexutils.GetReplayRecorder(ctx.Context())
+	if recorder != nil {
+		recorder.StartAttempt()
+	}
 	env := newEnv(ctx, validContractsInfo, keeper)
 	cachedCtx, msCached := cacheContext(ctx, env)
+	statsCollector := dexutils.NewExecutionStatsCollector()
+	cachedCtx = cachedCtx.WithContext(context.WithValue(cachedCtx.Context(), dexutils.DexExecutionStatsContextKey, statsCollector))
+	handleExpiredOrders(cachedCtx, env, keeper)
 	memStateCopy := dexutils.GetMemState(cachedCtx.Context()).DeepCopy()
 	contractsToProcess := memStateCopy.GetContractToProcess().ToOrderedSlice(datastructures.StringComparator)
 	preRunRents := keeper.GetRentsForContracts(cachedCtx, contractsToProcess)
@@ -62,7 +72,11 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 	}, validContractsInfo, cachedCtx)
 
 	_, err := logging.LogIfNotDoneAfter(ctx.Logger(), func() (struct{}, error) {
-		runner.Run()
+		if recorder != nil {
+			runner.RunSequentially()
+		} else {
+			runner.Run()
+		}
 		return struct{}{}, nil
 	}, LogRunnerRunAfter, "runner run")
 	if err != nil {
@@ -70,18 +84,43 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 		panic(err)
 	}
 
+	failedRoutedOrders := getFailedRoutedOrders(env, transferRoutedHopDeposits(cachedCtx, env, keeper))
 	handleSettlements(spanCtx, cachedCtx, env, keeper, tracer)
 	handleUnfulfilledMarketOrders(spanCtx, cachedCtx, env, keeper, tracer)
 
 	telemetry.IncrCounter(float32(env.failedContractAddressesToErrors.Len()), "dex", "total_failed_contracts")
 	// No error is thrown for any contract. This should happen most of the time.
-	if env.failedContractAddressesToErrors.Len() == 0 {
+	if env.failedContractAddressesToErrors.Len() == 0 && len(failedRoutedOrders) == 0 {
+		collectRentTopUps(cachedCtx, keeper, validContractsInfo, preRunRents)
 		postRunRents := keeper.GetRentsForContracts(cachedCtx, contractsToProcess)
 		TransferRentFromDexToCollector(cachedCtx, keeper.BankKeeper, preRunRents, postRunRents)
+		for _, contract := range getOutOfRentContracts(env) {
+			statsCollector.RecordFailure(contract.ContractAddr)
+		}
+		persistExecutionStats(cachedCtx, keeper, statsCollector, validContractsInfo)
 		msCached.Write()
 		return env.validContractsInfo, []types.ContractInfoV2{}, map[string]string{}, ctx, true
 	}
 
+	// All hops of a routed order that didn't meet its minimum output are reverted by
+	// discarding everything and running again without the routed order, which its
+	// creator is charged for. If some contract failed as well, routed orders are only
+	// checked again in the next attempt.
+	if env.failedContractAddressesToErrors.Len() == 0 {
+		telemetry.IncrCounter(float32(len(failedRoutedOrders)), "dex", "total_reverted_routed_orders")
+		chargeRevertedRoutedOrders(ctx, keeper, statsCollector, failedRoutedOrders)
+		revertRoutedOrders(ctx, failedRoutedOrders)
+		newGoContext := context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, memStateCopy)
+		return env.validContractsInfo, []types.ContractInfoV2{}, map[string]string{}, ctx.WithContext(newGoContext), false
+	}
+
+	if recorder != nil {
+		env.failedContractAddressesToErrors.Range(func(failedContractAddress string, failedReason error) bool {
+			recorder.RecordError(failedContractAddress, fmt.Sprintf("%+v", failedReason))
+			return true
+		})
+	}
+
 	failedContractsToReasons := map[string]string{}
 	failedContractsPreRents := map[string]uint64{}
 	failedContractsPostRents := map[string]uint64{}
@@ -98,14 +137,27 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 			return true
 		}
 		contract.RentBalance = cachedContract.RentBalance
-		failedContractsPreRents[failedContractAddress] = preRunRents[failedContractAddress]
-		failedContractsPostRents[failedContractAddress] = contract.RentBalance
 		err = keeper.SetContract(ctx, &contract)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("error %s when persisting contract %s's rent balance", err, failedContractAddress))
 			return true
 		}
+		// rent drawn from the contract's top-up is part of the persisted rent balance
+		// and needs to be collected as well
+		failedContractsPreRents[failedContractAddress] = preRunRents[failedContractAddress]
+		if topUp, found := keeper.GetRentTopUp(cachedCtx, failedContractAddress); found {
+			keeper.SetRentTopUp(ctx, topUp)
+			failedContractsPreRents[failedContractAddress] += keeper.CollectRentTopUp(ctx, failedContractAddress)
+			if collectedContract, err := keeper.GetContract(ctx, failedContractAddress); err == nil {
+				contract = collectedContract
+			}
+		}
+		failedContractsPostRents[failedContractAddress] = contract.RentBalance
 		failedContractsToReasons[failedContractAddress] = dexutils.GetTruncatedErrors(failedReason)
+		statsCollector.RecordFailure(failedContractAddress)
+		if stats, ok := statsCollector.Get(failedContractAddress); ok {
+			keeper.AddContractExecutionStats(ctx, stats)
+		}
 		return true
 	})
 	TransferRentFromDexToCollector(ctx, keeper.BankKeeper, failedContractsPreRents, failedContractsPostRents)
@@ -129,6 +181,7 @@ func newEnv(ctx sdk.Context, validContractsInfo []types.ContractInfoV2, keeper *
 	}
 	// Parallelize populating orderbooks for performance improvements
 	orderBooks := dexkeeperutils.PopulateAllOrderbooks(ctx, keeper, allContractAndPairs)
+	routedOrders, routedHopsByContract := newRoutedOrderProgresses(dexutils.GetMemState(ctx.Context()).GetAllRoutedOrders(ctx))
 	return &environment{
 		validContractsInfo:              validContractsInfo,
 		failedContractAddressesToErrors: datastructures.NewTypedSyncMap[string, error](),
@@ -137,6 +190,8 @@ func newEnv(ctx sdk.Context, validContractsInfo []types.ContractInfoV2, keeper *
 		executionTerminationSignals:     executionTerminationSignals,
 		registeredPairs:                 registeredPairs,
 		orderBooks:                      orderBooks,
+		routedOrders:                    routedOrders,
+		routedHopsByContract:            routedHopsByContract,
 		finalizeMsgMutex:                &sync.Mutex{},
 		eventManagerMutex:               &sync.Mutex{},
 	}
@@ -166,6 +221,22 @@ func decorateContextForContract(ctx sdk.Context, contractInfo types.ContractInfo
 	)
 }
 
+func handleExpiredOrders(ctx sdk.Context, env *environment, keeper *keeper.Keeper) {
+	defer telemetry.MeasureSince(time.Now(), "dex", "handle_expired_orders")
+	for _, contract := range env.validContractsInfo {
+		if !contract.NeedOrderMatching {
+			continue
+		}
+		registeredPairs, found := env.registeredPairs.Load(contract.ContractAddr)
+		if !found {
+			continue
+		}
+		if PrepareCancelExpiredOrders(ctx, keeper, contract.ContractAddr, registeredPairs) {
+			dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contract.ContractAddr, keeper.GetContractWithoutGasCharge)
+		}
+	}
+}
+
 func handleDeposits(spanCtx context.Context, ctx sdk.Context, env *environment, keeper *keeper.Keeper, tracer *otrace.Tracer) {
 	// Handle deposit sequentially since they mutate `bank` state which is shared by all contracts
 	_, span := (*tracer).Start(spanCtx, "handleDeposits")
@@ -195,7 +266,7 @@ func handleSettlements(ctx context.Context, sdkCtx sdk.Context, env *environment
 			contractsNeedOrderMatching.Add(contract.ContractAddr)
 		}
 	}
-	env.settlementsByContract.Range(func(contractAddr string, settlements []*types.SettlementEntry) bool {
+	handle := func(contractAddr string, settlements []*types.SettlementEntry) bool {
 		if !contractsNeedOrderMatching.Contains(contractAddr) {
 			return true
 		}
@@ -204,7 +275,17 @@ func handleSettlements(ctx context.Context, sdkCtx sdk.Context, env *environment
 			env.addError(contractAddr, err)
 		}
 		return true
-	})
+	}
+	if dexutils.GetReplayRecorder(sdkCtx.Context()) == nil {
+		env.settlementsByContract.Range(handle)
+		return
+	}
+	// settle in a deterministic order when replaying
+	for _, contract := range env.validContractsInfo {
+		if settlements, ok := env.settlementsByContract.Load(contract.ContractAddr); ok {
+			handle(contract.ContractAddr, settlements)
+		}
+	}
 }
 
 func handleUnfulfilledMarketOrders(ctx context.Context, sdkCtx sdk.Context, env *environment, keeper *keeper.Keeper, tracer *otrace.Tracer) {
@@ -233,6 +314,9 @@ func OrderMatchingRunnable(ctx context.Context, sdkContext sdk.Context, env *env
 			telemetry.IncrCounter(1, "recovered_panics")
 			msg := fmt.Sprintf("PANIC RECOVERED during order matching: %s", err)
 			sdkContext.Logger().Error(msg)
+			if recorder := dexutils.GetReplayRecorder(sdkContext.Context()); recorder != nil {
+				recorder.RecordError(contractInfo.ContractAddr, fmt.Sprintf("%s\n%s", msg, debug.Stack()))
+			}
 			if env != nil {
 				env.addError(contractInfo.ContractAddr, errors.New(msg))
 			}
@@ -268,11 +352,18 @@ func OrderMatchingRunnable(ctx context.Context, sdkContext sdk.Context, env *env
 	if !pairFound || !found {
 		sdkContext.Logger().Error(fmt.Sprintf("No pair or order book for %s", contractInfo.ContractAddr))
 		env.addError(contractInfo.ContractAddr, errors.New("no pair found (internal error)"))
+	} else if err := placeRoutedHopOrders(ctx, sdkContext, env, keeper, contractInfo.ContractAddr, tracer); err != nil {
+		sdkContext.Logger().Error(fmt.Sprintf("Error placing routed hop orders for %s", contractInfo.ContractAddr))
+		env.addError(contractInfo.ContractAddr, err)
 	} else if settlements, err := HandleExecutionForContract(ctx, sdkContext, contractInfo, keeper, pairs, orderBooks, tracer); err != nil {
 		sdkContext.Logger().Error(fmt.Sprintf("Error for EndBlock of %s", contractInfo.ContractAddr))
 		env.addError(contractInfo.ContractAddr, err)
 	} else {
 		env.settlementsByContract.Store(contractInfo.ContractAddr, settlements)
+		recordRoutedHopOutputs(env, contractInfo.ContractAddr, settlements)
+		if recorder := dexutils.GetReplayRecorder(sdkContext.Context()); recorder != nil {
+			recorder.RecordSettlements(contractInfo.ContractAddr, settlements)
+		}
 	}
 
 	// ordering of events doesn't matter since events aren't part of consensus
@@ -308,6 +399,25 @@ func getOutOfRentContracts(env *environment) []types.ContractInfoV2 {
 	return outOfRentContracts
 }
 
+func persistExecutionStats(ctx sdk.Context, keeper *keeper.Keeper, collector *dexutils.ExecutionStatsCollector, contracts []types.ContractInfoV2) {
+	for _, contract := range contracts {
+		if stats, ok := collector.Get(contract.ContractAddr); ok {
+			keeper.AddContractExecutionStats(ctx, stats)
+		}
+	}
+}
+
+// Collects rent drawn from top-ups during the block, and adds the collected amounts
+// to the pre-run rents so that only the rent actually charged is transferred.
+func collectRentTopUps(ctx sdk.Context, keeper *keeper.Keeper, contracts []types.ContractInfoV2, preRunRents map[string]uint64) {
+	for _, contract := range contracts {
+		collected := keeper.CollectRentTopUp(ctx, contract.ContractAddr)
+		if preRent, ok := preRunRents[contract.ContractAddr]; ok {
+			preRunRents[contract.ContractAddr] = preRent + collected
+		}
+	}
+}
+
 func TransferRentFromDexToCollector(ctx sdk.Context, bankKeeper bankkeeper.Keeper, preRents map[string]uint64, postRents map[string]uint64) {
 	total := uint64(0)
 	for addr, preRent := range preRents {
@@ -0,0 +1,90 @@
+package contract
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// Collects the price levels of a pair's order book that may have changed in the block.
+type touchedLevels struct {
+	longs  map[string]sdk.Dec
+	shorts map[string]sdk.Dec
+}
+
+func newTouchedLevels() *touchedLevels {
+	return &touchedLevels{longs: map[string]sdk.Dec{}, shorts: map[string]sdk.Dec{}}
+}
+
+func (t *touchedLevels) add(direction types.PositionDirection, prices ...sdk.Dec) {
+	levels := t.longs
+	if direction == types.PositionDirection_SHORT {
+		levels = t.shorts
+	}
+	for _, price := range prices {
+		levels[price.String()] = price
+	}
+}
+
+func (t *touchedLevels) addOrders(orders []*types.Order) {
+	for _, order := range orders {
+		t.add(order.PositionDirection, order.Price)
+	}
+}
+
+// Records the new quantity of every order book level touched by cancellations,
+// amendments, newly placed orders and matching in the block, along with the block's
+// fills, so that they can be streamed to clients. Updates are kept in the mem state
+// so that they are rolled back along with failed contract runs and never become part
+// of consensus state.
+func recordPairBlockUpdate(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	orderbook *types.OrderBook,
+	placedOrders []*types.Order,
+	trades []*types.Trade,
+) {
+	touched := newTouchedLevels()
+	memState := dexutils.GetMemState(ctx.Context())
+	for _, cancel := range memState.GetBlockCancels(ctx, contractAddr, pair).Get() {
+		touched.add(cancel.PositionDirection, cancel.Price)
+	}
+	for _, amendment := range memState.GetBlockAmendments(ctx, contractAddr, pair).Get() {
+		touched.add(amendment.PositionDirection, amendment.Price)
+	}
+	touched.addOrders(placedOrders)
+	touched.add(types.PositionDirection_LONG, orderbook.Longs.FlushedPrices()...)
+	touched.add(types.PositionDirection_SHORT, orderbook.Shorts.FlushedPrices()...)
+	if len(touched.longs) == 0 && len(touched.shorts) == 0 && len(trades) == 0 {
+		return
+	}
+
+	update := &types.PairBlockUpdate{
+		Height:     ctx.BlockHeight(),
+		PriceDenom: pair.PriceDenom,
+		AssetDenom: pair.AssetDenom,
+		Trades:     trades,
+	}
+	for _, price := range touched.longs {
+		quantity := sdk.ZeroDec()
+		if entry, found := dexkeeper.GetLongBookByPrice(ctx, string(contractAddr), price, pair.PriceDenom, pair.AssetDenom); found {
+			quantity = entry.Entry.Quantity
+		}
+		update.Longs = append(update.Longs, &types.OrderBookLevelUpdate{Price: price, Quantity: quantity})
+	}
+	for _, price := range touched.shorts {
+		quantity := sdk.ZeroDec()
+		if entry, found := dexkeeper.GetShortBookByPrice(ctx, string(contractAddr), price, pair.PriceDenom, pair.AssetDenom); found {
+			quantity = entry.Entry.Quantity
+		}
+		update.Shorts = append(update.Shorts, &types.OrderBookLevelUpdate{Price: price, Quantity: quantity})
+	}
+	sort.Slice(update.Longs, func(i, j int) bool { return update.Longs[i].Price.GT(update.Longs[j].Price) })
+	sort.Slice(update.Shorts, func(i, j int) bool { return update.Shorts[i].Price.LT(update.Shorts[j].Price) })
+	memState.GetBlockUpdates(ctx, contractAddr).Add(update)
+}
@@ -36,7 +36,10 @@ func CallPreExecutionHooks(
 	if err := abciWrapper.HandleEBCancelOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs); err != nil {
 		return err
 	}
-	return abciWrapper.HandleEBPlaceOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs)
+	if err := abciWrapper.HandleEBPlaceOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs); err != nil {
+		return err
+	}
+	return abciWrapper.HandleEBRestoreAmendedOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs)
 }
 
 func ExecutePair(
@@ -45,25 +48,67 @@ func ExecutePair(
 	pair types.Pair,
 	dexkeeper *keeper.Keeper,
 	orderbook *types.OrderBook,
-) []*types.SettlementEntry {
+) ([]*types.SettlementEntry, []*types.SelfTradePrevention) {
 	typedContractAddr := types.ContractAddress(contractAddr)
 
 	// First cancel orders
 	cancelForPair(ctx, dexkeeper, typedContractAddr, pair)
-	// Add all limit orders to the orderbook
+	// Then reduce the quantity of amended orders in place
+	amendForPair(ctx, dexkeeper, typedContractAddr, pair)
+	// Inject stop orders triggered in previous blocks and park new ones in the trigger book
 	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	exchange.InjectTriggeredOrders(ctx, dexkeeper, typedContractAddr, pair, orders)
+	exchange.MoveToTriggerBook(ctx, dexkeeper, typedContractAddr, orders)
+	// Add all limit orders to the orderbook
 	limitBuys := orders.GetLimitOrders(types.PositionDirection_LONG)
 	limitSells := orders.GetLimitOrders(types.PositionDirection_SHORT)
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
-	// Fill market orders
-	marketOrderOutcome := matchMarketOrderForPair(ctx, typedContractAddr, pair, orderbook)
-	// Fill limit orders
-	limitOrderOutcome := exchange.MatchLimitOrders(ctx, orderbook)
-	totalOutcome := marketOrderOutcome.Merge(&limitOrderOutcome)
+	postOnlyBuys := orders.GetPostOnlyOrders(types.PositionDirection_LONG)
+	postOnlySells := orders.GetPostOnlyOrders(types.PositionDirection_SHORT)
+	exchange.AddPostOnlyOrdersToOrderbook(ctx, dexkeeper, pair, postOnlyBuys, postOnlySells, orders)
+	var totalOutcome exchange.ExecutionOutcome
+	if pair.MatchingMode == types.MatchingMode_BATCH_AUCTION {
+		// Clear all crossing orders at a single price
+		totalOutcome = exchange.MatchBatchAuction(
+			ctx,
+			orderbook,
+			orders.GetSortedMarketOrders(types.PositionDirection_LONG),
+			orders.GetSortedMarketOrders(types.PositionDirection_SHORT),
+			orders,
+		)
+	} else {
+		// Fill market orders
+		marketOrderOutcome := matchMarketOrderForPair(ctx, typedContractAddr, pair, orderbook)
+		// Fill limit orders
+		limitOrderOutcome := exchange.MatchLimitOrders(ctx, orderbook)
+		totalOutcome = marketOrderOutcome.Merge(&limitOrderOutcome)
+	}
+	exchange.RemoveClosedOrders(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	dexkeeper.SetTrades(ctx, contractAddr, pair, totalOutcome.Trades)
+	dexkeeper.UpdateCandles(ctx, contractAddr, pair, totalOutcome.Trades)
+	recordPairBlockUpdate(ctx, dexkeeper, typedContractAddr, pair, orderbook, append(append(limitBuys, limitSells...), append(postOnlyBuys, postOnlySells...)...), totalOutcome.Trades)
 
 	dexkeeperutils.SetPriceStateFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	// Trigger stop orders against the new last traded price, unless the pair's stop
+	// orders are triggered by the oracle price in BeginBlock
+	if lastTradePrice, traded := totalOutcome.LastTradePrice(); traded && !pair.TriggerOnOraclePrice {
+		exchange.TriggerOrders(ctx, dexkeeper, typedContractAddr, pair, lastTradePrice)
+	}
+
+	return totalOutcome.Settlements, totalOutcome.SelfTradePreventions
+}
 
-	return totalOutcome.Settlements
+// ExecuteHaltedPair applies the cancellations and in-place amendments of a halted
+// pair without matching it, and cancels orders placed before the pair was halted.
+func ExecuteHaltedPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+) {
+	cancelForPair(ctx, dexkeeper, typedContractAddr, pair)
+	amendForPair(ctx, dexkeeper, typedContractAddr, pair)
+	PrepareCancelHaltedPairOrders(ctx, typedContractAddr, pair)
 }
 
 func cancelForPair(
@@ -76,6 +121,21 @@ func cancelForPair(
 	exchange.CancelOrders(ctx, keeper, contractAddress, pair, cancels.Get())
 }
 
+func amendForPair(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddress types.ContractAddress,
+	pair types.Pair,
+) {
+	memState := dexutils.GetMemState(ctx.Context())
+	amendments := memState.GetBlockAmendments(ctx, contractAddress, pair)
+	blockCancels := memState.GetBlockCancels(ctx, contractAddress, pair)
+	// applied amendments are reported to the contract as partial cancellations
+	for _, cancel := range exchange.AmendOrders(ctx, keeper, contractAddress, pair, amendments.Get()) {
+		blockCancels.Add(cancel)
+	}
+}
+
 func matchMarketOrderForPair(
 	ctx sdk.Context,
 	typedContractAddr types.ContractAddress,
@@ -90,6 +150,7 @@ func matchMarketOrderForPair(
 		marketBuys,
 		orderbook.Shorts,
 		types.PositionDirection_LONG,
+		pair.SelfTradePreventionMode,
 		orders,
 	)
 	marketSellOutcome := exchange.MatchMarketOrders(
@@ -97,6 +158,7 @@ func matchMarketOrderForPair(
 		marketSells,
 		orderbook.Longs,
 		types.PositionDirection_SHORT,
+		pair.SelfTradePreventionMode,
 		orders,
 	)
 	return marketBuyOutcome.Merge(&marketSellOutcome)
@@ -128,6 +190,7 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 	orderResults := []*types.Order{}
 	cancelResults := []*types.Cancellation{}
 	settlements := []*types.SettlementEntry{}
+	selfTradePreventions := []*types.SelfTradePrevention{}
 
 	// mu := sync.Mutex{}
 	// wg := sync.WaitGroup{}
@@ -146,9 +209,17 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 			if !found {
 				panic(fmt.Sprintf("Orderbook not found for %s", pairCopy.String()))
 			}
-			pairSettlements := ExecutePair(pairCtx, contractAddr, pair, dexkeeper, orderbook)
-			orderIDToSettledQuantities := GetOrderIDToSettledQuantities(pairSettlements)
-			PrepareCancelUnfulfilledMarketOrders(pairCtx, typedContractAddr, pairCopy, orderIDToSettledQuantities)
+			var pairSettlements []*types.SettlementEntry
+			var pairSelfTradePreventions []*types.SelfTradePrevention
+			if pair.Halted {
+				// halted pairs still process cancellations and amendments but are not matched
+				ExecuteHaltedPair(pairCtx, typedContractAddr, pairCopy, dexkeeper)
+			} else {
+				pairSettlements, pairSelfTradePreventions = ExecutePair(pairCtx, contractAddr, pair, dexkeeper, orderbook)
+				orderIDToSettledQuantities := GetOrderIDToSettledQuantities(pairSettlements)
+				PrepareCancelUnfulfilledMarketOrders(pairCtx, typedContractAddr, pairCopy, orderIDToSettledQuantities)
+				PrepareCancelSelfTradePreventedOrders(pairCtx, typedContractAddr, pairCopy, pairSelfTradePreventions)
+			}
 
 			// mu.Lock()
 			// defer mu.Unlock()
@@ -156,12 +227,13 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 			orderResults = append(orderResults, orders...)
 			cancelResults = append(cancelResults, cancels...)
 			settlements = append(settlements, pairSettlements...)
+			selfTradePreventions = append(selfTradePreventions, pairSelfTradePreventions...)
 			// ordering of events doesn't matter since events aren't part of consensus
 			ctx.EventManager().EmitEvents(pairCtx.EventManager().Events())
 		}()
 	}
 	// wg.Wait()
-	dexkeeper.SetMatchResult(ctx, contractAddr, types.NewMatchResult(orderResults, cancelResults, settlements))
+	dexkeeper.SetMatchResult(ctx, contractAddr, types.NewMatchResult(orderResults, cancelResults, settlements, selfTradePreventions))
 
 	return settlements
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/utils/datastructures"
 	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
 
@@ -29,6 +30,45 @@ const (
 	TestHeight    uint64 = 1
 )
 
+// Builds a limit order of the test account on the test contract. Fields that a test
+// cares about beyond the side, price and quantity are set through order options.
+func newTestOrder(pair types.Pair, id uint64, direction types.PositionDirection, price int64, quantity int64, opts ...func(*types.Order)) *types.Order {
+	order := &types.Order{
+		Id:                id,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(price),
+		Quantity:          sdk.NewDec(quantity),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: direction,
+	}
+	for _, opt := range opts {
+		opt(order)
+	}
+	return order
+}
+
+func withAccount(account string) func(*types.Order) {
+	return func(order *types.Order) {
+		order.Account = account
+	}
+}
+
+func withOrderType(orderType types.OrderType) func(*types.Order) {
+	return func(order *types.Order) {
+		order.OrderType = orderType
+	}
+}
+
+func withExpiry(expiryHeight uint64, expiryTimestamp uint64) func(*types.Order) {
+	return func(order *types.Order) {
+		order.ExpiryHeight = expiryHeight
+		order.ExpiryTimestamp = expiryTimestamp
+	}
+}
+
 func TestExecutePair(t *testing.T) {
 	pair := types.Pair{
 		PriceDenom: "USDC",
@@ -104,7 +144,7 @@ func TestExecutePair(t *testing.T) {
 	}
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 
-	settlements := contract.ExecutePair(
+	settlements, _ := contract.ExecutePair(
 		ctx,
 		TEST_CONTRACT,
 		pair,
@@ -157,7 +197,7 @@ func TestExecutePair(t *testing.T) {
 		},
 	)
 
-	settlements = contract.ExecutePair(
+	settlements, _ = contract.ExecutePair(
 		ctx,
 		TEST_CONTRACT,
 		pair,
@@ -338,6 +378,518 @@ func TestExecutePairInParallel(t *testing.T) {
 	require.Equal(t, uint64(3), settlements[1].OrderId)
 }
 
+func TestExecutePairsInParallelSkipsHaltedPair(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+		Halted:     true,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetLongOrderBookEntry(ctx, TEST_CONTRACT, &types.LongBook{
+		Price: sdk.NewDec(98),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(98),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  5,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	orderbooks := datastructures.NewTypedSyncMap[types.PairString, *types.OrderBook]()
+	orderbooks.Store(types.GetPairString(&pair), orderbook)
+
+	// an order that made it into the block before the pair was halted
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(
+		&types.Order{
+			Id:                3,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.MustNewDecFromStr("200"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_MARKET,
+			PositionDirection: types.PositionDirection_LONG,
+		},
+	)
+	dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(
+		&types.Cancellation{
+			Id:                5,
+			Creator:           "abc",
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.NewDec(98),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: types.PositionDirection_LONG,
+		},
+	)
+
+	settlements := contract.ExecutePairsInParallel(
+		ctx,
+		TEST_CONTRACT,
+		dexkeeper,
+		[]types.Pair{pair},
+		orderbooks,
+	)
+
+	require.Empty(t, settlements)
+	// the cancellation is still processed while the resting short is not matched
+	_, found := dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(98), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	_, found = dexkeeper.GetShortBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(101), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(3), cancels[0].Id)
+}
+
+func TestExecutePairWithTriggerOrders(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	// stop limit buy that should trigger once the price reaches 100
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(101),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(100),
+	})
+	// stop loss sell that should stay untriggered
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.NewDec(90),
+	})
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(101),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	settlements, _ := contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	untriggered := dexkeeper.GetAllTriggerOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(untriggered))
+	require.Equal(t, uint64(2), untriggered[0].Id)
+	triggered := dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(triggered))
+	require.Equal(t, uint64(1), triggered[0].Id)
+	require.Equal(t, types.OrderType_LIMIT, triggered[0].OrderType)
+	require.True(t, triggered[0].TriggerStatus)
+
+	// next block: the triggered order is matched as a limit order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	ctx = ctx.WithBlockHeight(int64(TestHeight) + 1)
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	settlements, _ = contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	require.ElementsMatch(t, []uint64{1, 7}, []uint64{settlements[0].OrderId, settlements[1].OrderId})
+	require.Empty(t, dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	require.Equal(t, 1, len(dexkeeper.GetAllTriggerOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+}
+
+func TestExecutePairTriggersOnLastTradePrice(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	for i, price := range []sdk.Dec{sdk.NewDec(99), sdk.NewDec(110)} {
+		dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+			Price: price,
+			Entry: &types.OrderEntry{
+				Price:    price,
+				Quantity: sdk.NewDec(1),
+				Allocations: []*types.Allocation{{
+					OrderId:  uint64(7 + i),
+					Account:  "abc",
+					Quantity: sdk.NewDec(1),
+				}},
+				PriceDenom: "USDC",
+				AssetDenom: "ATOM",
+			},
+		})
+	}
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	// the average traded price of 104.5 is below the trigger price but the last traded price of 110 is not
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(110),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(105),
+	})
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(110),
+		Quantity:          sdk.NewDec(2),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Empty(t, dexkeeper.GetAllTriggerOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	triggered := dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(triggered))
+	require.Equal(t, uint64(1), triggered[0].Id)
+}
+
+func TestExecutePairWithIOCOrders(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	// partially filled
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.NewDec(101),
+		Quantity:          sdk.NewDec(8),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_IOC,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	// not filled at all
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.NewDec(100),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_IOC,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements, _ := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	settledQuantities := contract.GetOrderIDToSettledQuantities(settlements)
+	require.Equal(t, sdk.NewDec(5), settledQuantities[1])
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair, settledQuantities)
+
+	// IOC remainders never rest on the book
+	_, found := dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(101), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 2, len(cancels))
+	require.Equal(t, types.OrderStatus_IOC_CANCELLED, blockOrders.GetByID(1).Status)
+	require.Equal(t, types.OrderStatus_IOC_CANCELLED, blockOrders.GetByID(2).Status)
+}
+
+func TestExecutePairWithSelfTradePrevention(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom:              "USDC",
+		AssetDenom:              "ATOM",
+		SelfTradePreventionMode: types.SelfTradePreventionMode_CANCEL_OLDEST,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(10),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  TEST_ACCOUNT,
+				Quantity: sdk.NewDec(5),
+			}, {
+				OrderId:  8,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.NewDec(100),
+		Quantity:          sdk.NewDec(5),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements, selfTradePreventions := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	require.Equal(t, 1, len(selfTradePreventions))
+	settledQuantities := contract.GetOrderIDToSettledQuantities(settlements)
+	require.Equal(t, sdk.NewDec(5), settledQuantities[8])
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair, settledQuantities)
+	contract.PrepareCancelSelfTradePreventedOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair, selfTradePreventions)
+
+	_, found := dexkeeper.GetShortBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(100), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(7), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_SELF_TRADE_PREVENTED, cancels[0].Initiator)
+}
+
+func TestExecutePairWithPartialSelfTradePrevention(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom:              "USDC",
+		AssetDenom:              "ATOM",
+		SelfTradePreventionMode: types.SelfTradePreventionMode_DECREMENT_AND_CANCEL,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	blockOrders.Add(newTestOrder(pair, 7, types.PositionDirection_SHORT, 100, 5))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	blockOrders.Add(newTestOrder(pair, 9, types.PositionDirection_LONG, 100, 3))
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	settlements, selfTradePreventions := contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Empty(t, settlements)
+	require.Equal(t, 1, len(selfTradePreventions))
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, types.ContractAddress(keepertest.TestContract), pair, contract.GetOrderIDToSettledQuantities(settlements))
+	contract.PrepareCancelSelfTradePreventedOrders(ctx, types.ContractAddress(keepertest.TestContract), pair, selfTradePreventions)
+
+	// the new order is cancelled while the resting one stays on the book with a reduced quantity
+	entry, found := dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), entry.GetOrderEntry().Quantity)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	full, found := cancels.GetByID(9)
+	require.True(t, found)
+	require.False(t, full.IsPartial())
+	require.Equal(t, types.CancellationInitiator_SELF_TRADE_PREVENTED, full.Initiator)
+	partial, found := cancels.GetByID(7)
+	require.True(t, found)
+	require.True(t, partial.IsPartial())
+	require.Equal(t, sdk.NewDec(3), partial.Quantity)
+	require.Equal(t, types.CancellationInitiator_SELF_TRADE_PREVENTED, partial.Initiator)
+	require.Equal(t, TEST_ACCOUNT, partial.Creator)
+}
+
+func TestExecutePairMaintainsAccountOrderIndex(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	getIndexedIDs := func(account string) []uint64 {
+		orders, _, err := dexkeeper.GetAccountOrdersPaginated(ctx, keepertest.TestContract, account, nil)
+		require.NoError(t, err)
+		return utils.Map(orders, func(o *types.Order) uint64 { return o.Id })
+	}
+	memState := dexutil.GetMemState(ctx.Context())
+
+	// resting orders are indexed when they are added to the book
+	memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(newTestOrder(pair, 1, types.PositionDirection_SHORT, 100, 2))
+	memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(newTestOrder(pair, 2, types.PositionDirection_SHORT, 100, 2))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.ElementsMatch(t, []uint64{1, 2}, getIndexedIDs(TEST_ACCOUNT))
+
+	// fully filled orders are dropped while partially filled ones stay
+	memState.Clear(ctx)
+	memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(newTestOrder(pair, 3, types.PositionDirection_LONG, 100, 3, withAccount("buyer"), withOrderType(types.OrderType_MARKET)))
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, []uint64{2}, getIndexedIDs(TEST_ACCOUNT))
+	require.Empty(t, getIndexedIDs("buyer"))
+
+	// cancelled orders are dropped
+	memState.Clear(ctx)
+	memState.GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(&types.Cancellation{
+		Id:                2,
+		Price:             sdk.NewDec(100),
+		PositionDirection: types.PositionDirection_SHORT,
+	})
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Empty(t, getIndexedIDs(TEST_ACCOUNT))
+}
+
+func TestExecutePairRecordsTrades(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	params := dexkeeper.GetParams(ctx)
+	params.TradeLogRetention = 100
+	dexkeeper.SetParams(ctx, params)
+	memState := dexutil.GetMemState(ctx.Context())
+	blockOrders := memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	blockOrders.Add(newTestOrder(pair, 1, types.PositionDirection_SHORT, 100, 2, withAccount("maker")))
+	blockOrders.Add(newTestOrder(pair, 2, types.PositionDirection_SHORT, 101, 2, withAccount("maker")))
+	blockOrders.Add(newTestOrder(pair, 3, types.PositionDirection_LONG, 101, 2, withAccount("taker"), withOrderType(types.OrderType_MARKET)))
+	blockOrders.Add(newTestOrder(pair, 4, types.PositionDirection_LONG, 101, 2, withAccount("taker")))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+
+	trades, _, err := dexkeeper.GetTradesByPairPaginated(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trades))
+	// the market order takes the best level first
+	require.Equal(t, uint64(3), trades[0].TakerOrderId)
+	require.Equal(t, uint64(1), trades[0].MakerOrderId)
+	require.Equal(t, sdk.NewDec(100), trades[0].Price)
+	require.Equal(t, types.PositionDirection_LONG, trades[0].TakerDirection)
+	require.Equal(t, TestHeight, trades[0].Height)
+	require.Equal(t, TestTimestamp, trades[0].Timestamp)
+	// crossing limit orders: the newer order is the taker
+	require.Equal(t, uint64(4), trades[1].TakerOrderId)
+	require.Equal(t, uint64(2), trades[1].MakerOrderId)
+	require.Equal(t, sdk.NewDec(101), trades[1].Price)
+	require.Equal(t, sdk.NewDec(2), trades[1].Quantity)
+
+	accountTrades, _, err := dexkeeper.GetTradesByAccountPaginated(ctx, keepertest.TestContract, "maker", 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(accountTrades))
+}
+
+func TestExecutePairRecordsBlockUpdate(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(95),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(95),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "maker", Quantity: sdk.NewDec(5)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	memState := dexutil.GetMemState(ctx.Context())
+	memState.GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(&types.Cancellation{
+		Id:                10,
+		Creator:           "maker",
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(95),
+		PositionDirection: types.PositionDirection_LONG,
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+	})
+	blockOrders := memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	blockOrders.Add(newTestOrder(pair, 1, types.PositionDirection_SHORT, 100, 2, withAccount("maker")))
+	blockOrders.Add(newTestOrder(pair, 2, types.PositionDirection_SHORT, 101, 2, withAccount("maker")))
+	blockOrders.Add(newTestOrder(pair, 3, types.PositionDirection_LONG, 101, 2, withAccount("maker"), withOrderType(types.OrderType_MARKET)))
+	blockOrders.Add(newTestOrder(pair, 4, types.PositionDirection_LONG, 101, 2, withAccount("maker")))
+	blockOrders.Add(newTestOrder(pair, 5, types.PositionDirection_LONG, 99, 2, withAccount("maker")))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+
+	updates := memState.GetBlockUpdates(ctx, types.ContractAddress(keepertest.TestContract)).Get()
+	require.Equal(t, 1, len(updates))
+	update := updates[0]
+	require.Equal(t, int64(TestHeight), update.Height)
+	require.Equal(t, []*types.OrderBookLevelUpdate{
+		{Price: sdk.NewDec(101), Quantity: sdk.ZeroDec()},
+		{Price: sdk.NewDec(99), Quantity: sdk.NewDec(2)},
+		{Price: sdk.NewDec(95), Quantity: sdk.ZeroDec()},
+	}, update.Longs)
+	require.Equal(t, []*types.OrderBookLevelUpdate{
+		{Price: sdk.NewDec(100), Quantity: sdk.ZeroDec()},
+		{Price: sdk.NewDec(101), Quantity: sdk.ZeroDec()},
+	}, update.Shorts)
+	require.Equal(t, 2, len(update.Trades))
+}
+
 func TestGetOrderIDToSettledQuantities(t *testing.T) {
 	settlements := []*types.SettlementEntry{
 		{
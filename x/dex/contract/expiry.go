@@ -0,0 +1,48 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// PrepareCancelExpiredOrders drains the expiry queue of all registered pairs of the contract
+// and adds a cancellation for every expired order that is still resting on the book, so that
+// they are removed during execution and the contract is notified via the cancel sudo call.
+// Returns true if any cancellation was added.
+func PrepareCancelExpiredOrders(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr string,
+	registeredPairs []types.Pair,
+) bool {
+	typedContractAddr := types.ContractAddress(contractAddr)
+	height, timestamp := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())
+	added := false
+	for _, pair := range registeredPairs {
+		blockCancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+		for _, order := range dexkeeper.PopExpiredOrders(ctx, contractAddr, pair, height, timestamp) {
+			if !dexkeeper.IsOrderResting(ctx, contractAddr, order) {
+				// already filled or cancelled
+				continue
+			}
+			cancel := types.Cancellation{
+				Id:                order.Id,
+				Initiator:         types.CancellationInitiator_EXPIRED,
+				Creator:           order.Account,
+				ContractAddr:      contractAddr,
+				Price:             order.Price,
+				AssetDenom:        order.AssetDenom,
+				PriceDenom:        order.PriceDenom,
+				PositionDirection: order.PositionDirection,
+			}
+			if blockCancels.Has(&cancel) {
+				continue
+			}
+			blockCancels.Add(&cancel)
+			added = true
+		}
+	}
+	return added
+}
@@ -0,0 +1,75 @@
+package contract_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareCancelExpiredOrders(t *testing.T) {
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, []*types.Order{
+		newTestOrder(pair, 1, types.PositionDirection_LONG, 100, 1, withExpiry(TestHeight+1, 0)),
+		newTestOrder(pair, 2, types.PositionDirection_LONG, 100, 1, withExpiry(0, TestTimestamp+10)),
+		newTestOrder(pair, 3, types.PositionDirection_LONG, 100, 1),
+	}, []*types.Order{})
+	require.Equal(t, uint64(3), dexkeeper.GetOrderCountState(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(100)))
+
+	// nothing has expired yet
+	require.False(t, contract.PrepareCancelExpiredOrders(ctx, dexkeeper, keepertest.TestContract, []types.Pair{pair}))
+
+	// order 1 expires by height
+	ctx = ctx.WithBlockHeight(int64(TestHeight) + 1)
+	require.True(t, contract.PrepareCancelExpiredOrders(ctx, dexkeeper, keepertest.TestContract, []types.Pair{pair}))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_EXPIRED, cancels[0].Initiator)
+
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, uint64(2), dexkeeper.GetOrderCountState(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(100)))
+	_, found := dexkeeper.GetLongAllocationForOrderID(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, sdk.NewDec(100), 1)
+	require.False(t, found)
+
+	// order 2 expires by timestamp but has been cancelled by its owner already
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	exchange.CancelOrders(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair, []*types.Cancellation{
+		{Id: 2, Price: sdk.NewDec(100), PositionDirection: types.PositionDirection_LONG},
+	})
+	// the cancellation drops the order from the expiry queue right away
+	require.Empty(t, dexkeeper.PopExpiredOrders(ctx, keepertest.TestContract, pair, TestHeight+100, TestTimestamp+100))
+	ctx = ctx.WithBlockTime(time.Unix(int64(TestTimestamp)+10, 0))
+	require.False(t, contract.PrepareCancelExpiredOrders(ctx, dexkeeper, keepertest.TestContract, []types.Pair{pair}))
+}
+
+func TestExecutePairRemovesFilledOrdersFromExpiryQueue(t *testing.T) {
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair)
+	blockOrders.Add(newTestOrder(pair, 1, types.PositionDirection_SHORT, 100, 1, withExpiry(TestHeight+100, 0)))
+	blockOrders.Add(newTestOrder(pair, 2, types.PositionDirection_SHORT, 101, 1, withExpiry(0, TestTimestamp+100)))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+
+	// order 1 is fully filled long before it would expire
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	blockOrders.Add(newTestOrder(pair, 3, types.PositionDirection_LONG, 100, 1, withAccount("buyer"), withOrderType(types.OrderType_MARKET)))
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+
+	expired := dexkeeper.PopExpiredOrders(ctx, keepertest.TestContract, pair, TestHeight+100, TestTimestamp+100)
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, uint64(2), expired[0].Id)
+}
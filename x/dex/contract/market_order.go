@@ -27,6 +27,85 @@ func PrepareCancelUnfulfilledMarketOrders(
 	}
 }
 
+// Orders of a halted pair are never matched, so any order that made it into the
+// block before the pair was halted is cancelled back to its owner.
+func PrepareCancelHaltedPairOrders(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+) {
+	dexutils.GetMemState(ctx.Context()).ClearCancellationForPair(ctx, typedContractAddr, pair)
+	blockCancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get() {
+		if order.Status == types.OrderStatus_FAILED_TO_PLACE {
+			continue
+		}
+		blockCancels.Add(&types.Cancellation{
+			Id:        order.Id,
+			Initiator: types.CancellationInitiator_USER,
+		})
+	}
+}
+
+// Notifies the contract of orders cancelled or reduced by self-trade prevention during matching.
+// Orders that were only reduced are reported as partial cancellations carrying the removed
+// quantity. Market orders whose remainder was cancelled are already covered by the
+// unfulfilled-order cancellations.
+func PrepareCancelSelfTradePreventedOrders(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	selfTradePreventions []*types.SelfTradePrevention,
+) {
+	blockCancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	for _, prevention := range selfTradePreventions {
+		reductions := map[uint64]sdk.Dec{
+			prevention.TakerOrderId: prevention.TakerReduction,
+			prevention.MakerOrderId: prevention.MakerReduction,
+		}
+		for _, orderID := range prevention.CancelledOrderIds {
+			delete(reductions, orderID)
+			existing, found := blockCancels.GetByID(orderID)
+			if found && !existing.IsPartial() {
+				continue
+			}
+			blockCancels.Add(newSelfTradePreventedCancellation(typedContractAddr, pair, prevention, orderID, sdk.ZeroDec()))
+		}
+		for _, orderID := range []uint64{prevention.TakerOrderId, prevention.MakerOrderId} {
+			reduction, ok := reductions[orderID]
+			if !ok || reduction.IsNil() || !reduction.IsPositive() {
+				continue
+			}
+			existing, found := blockCancels.GetByID(orderID)
+			if found && !existing.IsPartial() {
+				continue
+			}
+			if found {
+				reduction = reduction.Add(existing.Quantity)
+			}
+			blockCancels.Add(newSelfTradePreventedCancellation(typedContractAddr, pair, prevention, orderID, reduction))
+		}
+	}
+}
+
+func newSelfTradePreventedCancellation(
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	prevention *types.SelfTradePrevention,
+	orderID uint64,
+	quantity sdk.Dec,
+) *types.Cancellation {
+	return &types.Cancellation{
+		Id:           orderID,
+		Initiator:    types.CancellationInitiator_SELF_TRADE_PREVENTED,
+		Creator:      prevention.Account,
+		ContractAddr: string(typedContractAddr),
+		PriceDenom:   pair.PriceDenom,
+		AssetDenom:   pair.AssetDenom,
+		Quantity:     quantity,
+	}
+}
+
 func getUnfulfilledPlacedMarketOrderIds(
 	ctx sdk.Context,
 	typedContractAddr types.ContractAddress,
@@ -34,7 +113,8 @@ func getUnfulfilledPlacedMarketOrderIds(
 	orderIDToSettledQuantities map[uint64]sdk.Dec,
 ) []uint64 {
 	res := []uint64{}
-	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get() {
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, order := range blockOrders.Get() {
 		if order.Status == types.OrderStatus_FAILED_TO_PLACE {
 			continue
 		}
@@ -47,6 +127,15 @@ func getUnfulfilledPlacedMarketOrderIds(
 			if _, ok := orderIDToSettledQuantities[order.Id]; !ok {
 				res = append(res, order.Id)
 			}
+		} else if order.OrderType == types.OrderType_IOC {
+			// IOC orders never rest on the book, so any remainder is cancelled
+			if order.Status != types.OrderStatus_FULFILLED {
+				order.Status = types.OrderStatus_IOC_CANCELLED
+				blockOrders.Add(order)
+				res = append(res, order.Id)
+			}
+		} else if order.Status == types.OrderStatus_POST_ONLY_REJECTED {
+			res = append(res, order.Id)
 		}
 	}
 	return res
@@ -0,0 +1,269 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexkeeperabci "github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+// routedOrderProgress tracks the hops of a routed order executed so far in an
+// EndBlocker attempt. Each hop is only written by the run of its own contract,
+// which only starts after the contract of the previous hop has finished, so no
+// locking is needed.
+type routedOrderProgress struct {
+	routedOrder *types.RoutedOrder
+	directions  []types.PositionDirection
+	placed      []bool
+	hopOrderIDs []uint64
+	outputs     []sdk.Dec
+	// output of the previous hop credited to the contract of each hop but the first
+	deposits []*types.DepositInfoEntry
+}
+
+type routedHop struct {
+	progress *routedOrderProgress
+	hopIdx   int
+}
+
+type failedRoutedOrder struct {
+	routedOrder *types.RoutedOrder
+	reason      string
+	// charged to the creator for the discarded EndBlocker attempt
+	revertFee sdk.Int
+}
+
+func newRoutedOrderProgresses(routedOrders []*types.RoutedOrder) ([]*routedOrderProgress, map[string][]routedHop) {
+	progresses := []*routedOrderProgress{}
+	hopsByContract := map[string][]routedHop{}
+	for _, routedOrder := range routedOrders {
+		directions, _, err := types.GetRouteHopDirections(routedOrder.InputDenom, routedOrder.Hops)
+		if err != nil {
+			// routes are validated when placed so this should never happen
+			panic(err)
+		}
+		progress := &routedOrderProgress{
+			routedOrder: routedOrder,
+			directions:  directions,
+			placed:      make([]bool, len(routedOrder.Hops)),
+			hopOrderIDs: make([]uint64, len(routedOrder.Hops)),
+			outputs:     make([]sdk.Dec, len(routedOrder.Hops)),
+			deposits:    make([]*types.DepositInfoEntry, len(routedOrder.Hops)),
+		}
+		for i, hop := range routedOrder.Hops {
+			progress.outputs[i] = sdk.ZeroDec()
+			hopsByContract[hop.ContractAddr] = append(hopsByContract[hop.ContractAddr], routedHop{progress: progress, hopIdx: i})
+		}
+		progresses = append(progresses, progress)
+	}
+	return progresses, hopsByContract
+}
+
+// Returns how much the hop can spend. Hops after the first spend the output of the
+// previous hop, truncated to what can be moved between contracts.
+func (h routedHop) input() sdk.Dec {
+	if h.hopIdx == 0 {
+		return h.progress.routedOrder.InputQuantity
+	}
+	return h.progress.outputs[h.hopIdx-1].TruncateDec()
+}
+
+// Returns the denom the hop spends.
+func (h routedHop) inputDenom() string {
+	if h.hopIdx == 0 {
+		return h.progress.routedOrder.InputDenom
+	}
+	previousHop := h.progress.routedOrder.Hops[h.hopIdx-1]
+	if h.progress.directions[h.hopIdx-1] == types.PositionDirection_LONG {
+		return previousHop.AssetDenom
+	}
+	return previousHop.PriceDenom
+}
+
+// placeRoutedHopOrders adds the market orders of the routed order hops on a contract
+// to the block's orders, sized by the output of the previous hops, before the
+// contract is run. The output of the previous hop is deposited to the contract
+// first, so that the contract can check the order against the creator's balance.
+func placeRoutedHopOrders(ctx context.Context, sdkCtx sdk.Context, env *environment, keeper *keeper.Keeper, contractAddr string, tracer *otrace.Tracer) error {
+	hops := env.routedHopsByContract[contractAddr]
+	if len(hops) == 0 {
+		return nil
+	}
+	deposits := []*types.DepositInfoEntry{}
+	for _, hop := range hops {
+		input := hop.input()
+		if hop.hopIdx == 0 || !input.IsPositive() {
+			continue
+		}
+		deposit := &types.DepositInfoEntry{
+			Creator: hop.progress.routedOrder.Creator,
+			Denom:   hop.inputDenom(),
+			Amount:  input,
+		}
+		hop.progress.deposits[hop.hopIdx] = deposit
+		deposits = append(deposits, deposit)
+	}
+	keeperWrapper := dexkeeperabci.KeeperWrapper{Keeper: keeper}
+	if err := keeperWrapper.HandleEBRoutedHopDeposits(ctx, sdkCtx, tracer, contractAddr, deposits); err != nil {
+		return err
+	}
+	nextID := keeper.GetNextOrderID(sdkCtx, contractAddr)
+	for _, hop := range hops {
+		input := hop.input()
+		if !input.IsPositive() {
+			// nothing came out of the previous hop
+			continue
+		}
+		order := hop.progress.routedOrder.GetHopOrder(hop.hopIdx, hop.progress.directions[hop.hopIdx], input)
+		order.Id = nextID
+		pair := types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom}
+		dexutils.GetMemState(sdkCtx.Context()).GetBlockOrders(sdkCtx, types.ContractAddress(contractAddr), pair).Add(order)
+		hop.progress.placed[hop.hopIdx] = true
+		hop.progress.hopOrderIDs[hop.hopIdx] = nextID
+		nextID++
+	}
+	keeper.SetNextOrderID(sdkCtx, contractAddr, nextID)
+	return nil
+}
+
+// transferRoutedHopDeposits moves the output of every hop deposited to the contract
+// of the next hop from the contract of the hop, through the dex module's escrow, to
+// the contract of the next hop. This happens once all contracts have been run, since
+// bank balances can't be changed while contracts are run in parallel, but before
+// settlements are sent, so that the contract of the hop still holds the output.
+// Returns the routed orders whose output couldn't be moved.
+func transferRoutedHopDeposits(ctx sdk.Context, env *environment, keeper *keeper.Keeper) []failedRoutedOrder {
+	failed := []failedRoutedOrder{}
+	for _, progress := range env.routedOrders {
+		for hopIdx, deposit := range progress.deposits {
+			if deposit == nil {
+				continue
+			}
+			if err := transferRoutedHopDeposit(ctx, keeper, progress.routedOrder.Hops[hopIdx-1].ContractAddr, progress.routedOrder.Hops[hopIdx].ContractAddr, deposit); err != nil {
+				failed = append(failed, failedRoutedOrder{
+					routedOrder: progress.routedOrder,
+					reason:      fmt.Sprintf("failed to move the output of hop %d: %s", hopIdx-1, err),
+				})
+				break
+			}
+		}
+	}
+	return failed
+}
+
+func transferRoutedHopDeposit(ctx sdk.Context, keeper *keeper.Keeper, fromContractAddr string, toContractAddr string, deposit *types.DepositInfoEntry) error {
+	coins := sdk.NewCoins(sdk.NewCoin(deposit.Denom, deposit.Amount.TruncateInt()))
+	if err := keeper.BankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(fromContractAddr), types.ModuleName, coins); err != nil {
+		return err
+	}
+	return keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(toContractAddr), coins)
+}
+
+// recordRoutedHopOutputs computes the output of the routed order hops on a contract
+// from the settlements of the contract's run.
+func recordRoutedHopOutputs(env *environment, contractAddr string, settlements []*types.SettlementEntry) {
+	for _, hop := range env.routedHopsByContract[contractAddr] {
+		if !hop.progress.placed[hop.hopIdx] {
+			continue
+		}
+		hopSettlements := []*types.SettlementEntry{}
+		for _, settlement := range settlements {
+			if settlement.OrderId == hop.progress.hopOrderIDs[hop.hopIdx] && settlement.Account == hop.progress.routedOrder.Creator {
+				hopSettlements = append(hopSettlements, settlement)
+			}
+		}
+		hop.progress.outputs[hop.hopIdx] = types.GetHopOutput(hop.progress.directions[hop.hopIdx], hopSettlements)
+	}
+}
+
+// Returns the routed orders that didn't meet their minimum output, in addition to
+// the given ones that failed otherwise.
+func getFailedRoutedOrders(env *environment, failed []failedRoutedOrder) []failedRoutedOrder {
+	alreadyFailed := map[uint64]struct{}{}
+	for _, f := range failed {
+		alreadyFailed[f.routedOrder.Id] = struct{}{}
+	}
+	for _, progress := range env.routedOrders {
+		routedOrder := progress.routedOrder
+		if _, ok := alreadyFailed[routedOrder.Id]; ok {
+			continue
+		}
+		output := progress.outputs[len(progress.outputs)-1]
+		if output.LT(routedOrder.MinOutput) {
+			failed = append(failed, failedRoutedOrder{
+				routedOrder: routedOrder,
+				reason:      fmt.Sprintf("route yielded %s, less than the minimum output %s", output, routedOrder.MinOutput),
+			})
+		}
+	}
+	return failed
+}
+
+// chargeRevertedRoutedOrders charges the creators of routed orders that failed for the
+// sudo gas their hops' contracts used in the EndBlocker attempt, at the sudo call gas
+// price, since the attempt is discarded and all contracts are run again without their
+// routed orders. The gas of a contract is split evenly between the failed routed orders
+// with a hop on it, and contracts without any such hop are not charged for. The fee is
+// sent to the fee collector and, unlike everything else in the attempt, persisted. A
+// creator is charged at most its spendable balance.
+func chargeRevertedRoutedOrders(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	statsCollector *dexutils.ExecutionStatsCollector,
+	failedRoutedOrders []failedRoutedOrder,
+) {
+	numFailedByContract := map[string]int64{}
+	for _, failed := range failedRoutedOrders {
+		for _, contractAddr := range failed.routedOrder.GetHopContractAddrs() {
+			numFailedByContract[contractAddr]++
+		}
+	}
+	gasPrice := keeper.GetParams(ctx).SudoCallGasPrice
+	for i, failed := range failedRoutedOrders {
+		failedRoutedOrders[i].revertFee = sdk.ZeroInt()
+		fee := sdk.ZeroDec()
+		for _, contractAddr := range failed.routedOrder.GetHopContractAddrs() {
+			if stats, ok := statsCollector.Get(contractAddr); ok {
+				gasUsed := sdk.NewDecFromBigInt(new(big.Int).SetUint64(stats.TotalGasUsed()))
+				fee = fee.Add(gasUsed.Mul(gasPrice).QuoInt64(numFailedByContract[contractAddr]))
+			}
+		}
+		creator := sdk.MustAccAddressFromBech32(failed.routedOrder.Creator)
+		charge := sdk.MinInt(fee.Ceil().RoundInt(), keeper.BankKeeper.SpendableCoins(ctx, creator).AmountOf(appparams.BaseCoinUnit))
+		if !charge.IsPositive() {
+			continue
+		}
+		if err := keeper.BankKeeper.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, charge))); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error %s when charging %s for reverted routed order %d", err, failed.routedOrder.Creator, failed.routedOrder.Id))
+			continue
+		}
+		failedRoutedOrders[i].revertFee = charge
+	}
+}
+
+// revertRoutedOrders removes routed orders that failed so that the next EndBlocker
+// attempt runs without any of their hops.
+func revertRoutedOrders(ctx sdk.Context, failedRoutedOrders []failedRoutedOrder) {
+	recorder := dexutils.GetReplayRecorder(ctx.Context())
+	for _, failed := range failedRoutedOrders {
+		dexutils.GetMemState(ctx.Context()).DeleteRoutedOrder(ctx, failed.routedOrder.Id)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRevertRoutedOrder,
+			sdk.NewAttribute(types.AttributeKeyRoutedOrderID, fmt.Sprint(failed.routedOrder.Id)),
+			sdk.NewAttribute(types.AttributeKeyAccount, failed.routedOrder.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, failed.reason),
+			sdk.NewAttribute(types.AttributeKeyRevertFee, sdk.NewCoin(appparams.BaseCoinUnit, failed.revertFee).String()),
+		))
+		if recorder != nil {
+			recorder.RecordError(failed.routedOrder.Hops[0].ContractAddr, fmt.Sprintf("routed order %d reverted: %s", failed.routedOrder.Id, failed.reason))
+		}
+	}
+}
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"fmt"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -150,6 +151,35 @@ func (r *ParallelRunner) wrapRunnable(contractAddr types.ContractAddress) {
 		}
 	}()
 
+	r.runAndPromoteDependencies(contractAddr)
+}
+
+// RunSequentially runs one contract at a time, always picking the frontier contract
+// with the smallest address, so that the execution order is deterministic. It's only
+// meant for offline debugging since it gives up all parallelism.
+func (r *ParallelRunner) RunSequentially() {
+	for atomic.LoadInt64(&r.readyCnt) > 0 {
+		ready := []types.ContractAddress{}
+		r.readyContracts.Range(func(key types.ContractAddress, _ struct{}) bool {
+			ready = append(ready, key)
+			return true
+		})
+		sort.Slice(ready, func(i, j int) bool { return ready[i] < ready[j] })
+		r.readyContracts.Delete(ready[0])
+		atomic.AddInt64(&r.readyCnt, -1)
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					telemetry.IncrCounter(1, "recovered_panics")
+					r.sdkCtx.Logger().Error(fmt.Sprintf("panic in sequential runner recovered: %s", err))
+				}
+			}()
+			r.runAndPromoteDependencies(ready[0])
+		}()
+	}
+}
+
+func (r *ParallelRunner) runAndPromoteDependencies(contractAddr types.ContractAddress) {
 	contractInfo, _ := r.contractAddrToInfo.Load(contractAddr)
 	r.runnable(*contractInfo)
 
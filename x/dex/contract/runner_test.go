@@ -139,3 +139,36 @@ func TestRunnerPanicContract(t *testing.T) {
 	runner := contract.NewParallelRunner(panicRunnable, []types.ContractInfoV2{contractInfo}, sdkCtx)
 	require.NotPanics(t, runner.Run)
 }
+
+func TestRunnerSequentialContractWithDependency(t *testing.T) {
+	order := []string{}
+	contractInfoA := types.ContractInfoV2{
+		ContractAddr:            "A",
+		NumIncomingDependencies: 0,
+		Dependencies: []*types.ContractDependencyInfo{
+			{
+				Dependency: "C",
+			},
+		},
+	}
+	contractInfoB := types.ContractInfoV2{
+		ContractAddr:            "B",
+		NumIncomingDependencies: 0,
+	}
+	contractInfoC := types.ContractInfoV2{
+		ContractAddr:            "C",
+		NumIncomingDependencies: 1,
+	}
+	contractInfoD := types.ContractInfoV2{
+		ContractAddr:            "D",
+		NumIncomingDependencies: 0,
+	}
+	runner := contract.NewParallelRunner(func(contractInfo types.ContractInfoV2) {
+		if contractInfo.ContractAddr == "B" {
+			panic("")
+		}
+		order = append(order, contractInfo.ContractAddr)
+	}, []types.ContractInfoV2{contractInfoD, contractInfoC, contractInfoB, contractInfoA}, sdkCtx)
+	require.NotPanics(t, runner.RunSequentially)
+	require.Equal(t, []string{"A", "C", "D"}, order)
+}
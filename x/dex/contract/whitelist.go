@@ -21,13 +21,32 @@ var DexWhitelistedKeys = []string{
 	types.MatchResultKey,
 	types.LongOrderCountKey,
 	types.ShortOrderCountKey,
+	types.TriggerBookKey,
+	types.TriggerBookPriceKey,
+	types.TriggeredOrderKey,
+	types.ExpiryQueueKey,
+	types.AccountOrderKey,
+	types.TradeKey,
+	types.AccountTradeKey,
+	types.CandleKey,
+	types.RentTopUpKey,
 	keeper.ContractPrefixKey,
 }
 
+// Keys whose entries are scoped by something other than the pair right after the
+// contract address (e.g. the account order index, keyed by account and then pair).
+// Entries of different pairs still never collide since the pair is part of the key.
+var DexContractScopedWhitelistedKeys = []string{
+	types.AccountOrderKey,
+	types.AccountTradeKey,
+}
+
 var DexMemWhitelistedKeys = []string{
 	types.MemOrderKey,
 	types.MemDepositKey,
 	types.MemCancelKey,
+	types.MemAmendKey,
+	types.MemBlockUpdateKey,
 }
 
 var WasmWhitelistedKeys = []string{
@@ -75,11 +94,14 @@ func GetWasmWhitelistedPrefixes(contractAddr string) []string {
 }
 
 func GetDexPerPairWhitelistedPrefixes(contractAddr string, pair types.Pair) []string {
-	return utils.Map(DexWhitelistedKeys, func(key string) string {
+	prefixes := utils.Map(DexWhitelistedKeys, func(key string) string {
 		return string(append(append(
 			types.KeyPrefix(key), types.AddressKeyPrefix(contractAddr)...,
 		), types.PairPrefix(pair.PriceDenom, pair.AssetDenom)...))
 	})
+	return append(prefixes, utils.Map(DexContractScopedWhitelistedKeys, func(key string) string {
+		return string(types.ContractKeyPrefix(key, contractAddr))
+	})...)
 }
 
 func GetDexMemPerPairWhitelistedPrefixes(contractAddr string, pair types.Pair) []string {
@@ -0,0 +1,75 @@
+package exchange
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Reduces the quantity of resting orders in place, keeping each allocation at its
+// position in the order entry so that it retains time priority. Amendments of
+// orders that are no longer resting (e.g. cancelled earlier in the block), that
+// would not reduce the quantity or that replace the order at a new price are ignored.
+// Returns a partial cancellation for each applied amendment, carrying the quantity
+// removed from the order, so that the contract can be notified of the reduction.
+func AmendOrders(
+	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress, pair types.Pair,
+	amendments []*types.Amendment,
+) []*types.Cancellation {
+	cancels := []*types.Cancellation{}
+	for _, amendment := range amendments {
+		if cancel := amendOrder(ctx, keeper, amendment, contract, pair); cancel != nil {
+			cancels = append(cancels, cancel)
+		}
+	}
+	return cancels
+}
+
+func amendOrder(ctx sdk.Context, keeper *keeper.Keeper, amendment *types.Amendment, contract types.ContractAddress, pair types.Pair) *types.Cancellation {
+	if amendment.NewOrderId != 0 {
+		return nil
+	}
+	getter, setter := keeper.GetLongOrderBookEntryByPrice, keeper.SetLongOrderBookEntry
+	if amendment.PositionDirection == types.PositionDirection_SHORT {
+		getter, setter = keeper.GetShortOrderBookEntryByPrice, keeper.SetShortOrderBookEntry
+	}
+	entry, found := getter(ctx, string(contract), amendment.Price, pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return nil
+	}
+	newEntry := *entry.GetOrderEntry()
+	newAllocations := make([]*types.Allocation, 0, len(newEntry.Allocations))
+	var reduction sdk.Dec
+	amended := false
+	for _, allocation := range newEntry.Allocations {
+		if allocation.OrderId == amendment.Id && allocation.Account == amendment.Creator && amendment.NewQuantity.LT(allocation.Quantity) {
+			reduction = allocation.Quantity.Sub(amendment.NewQuantity)
+			newEntry.Quantity = newEntry.Quantity.Sub(reduction)
+			newAllocations = append(newAllocations, &types.Allocation{
+				OrderId:  allocation.OrderId,
+				Quantity: amendment.NewQuantity,
+				Account:  allocation.Account,
+			})
+			amended = true
+			continue
+		}
+		newAllocations = append(newAllocations, allocation)
+	}
+	if !amended {
+		return nil
+	}
+	newEntry.Allocations = newAllocations
+	entry.SetEntry(&newEntry)
+	setter(ctx, string(contract), entry)
+	return &types.Cancellation{
+		Id:                amendment.Id,
+		Initiator:         types.CancellationInitiator_AMENDED,
+		Creator:           amendment.Creator,
+		ContractAddr:      string(contract),
+		Price:             amendment.Price,
+		AssetDenom:        pair.AssetDenom,
+		PriceDenom:        pair.PriceDenom,
+		PositionDirection: amendment.PositionDirection,
+		Quantity:          reduction,
+	}
+}
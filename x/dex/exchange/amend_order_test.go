@@ -0,0 +1,88 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmendOrder(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(15),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}, {
+				OrderId:  8,
+				Account:  "def",
+				Quantity: sdk.NewDec(7),
+			}, {
+				OrderId:  9,
+				Account:  "abc",
+				Quantity: sdk.NewDec(3),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+
+	newAmendment := func(id uint64, creator string, newQuantity int64) *types.Amendment {
+		return &types.Amendment{
+			Id:                id,
+			Creator:           creator,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			PositionDirection: types.PositionDirection_SHORT,
+			Price:             sdk.NewDec(101),
+			NewPrice:          sdk.NewDec(101),
+			NewQuantity:       sdk.NewDec(newQuantity),
+		}
+	}
+	replacedAmendment := newAmendment(9, "abc", 1)
+	replacedAmendment.NewPrice = sdk.NewDec(102)
+	replacedAmendment.NewOrderId = 10
+	cancels := exchange.AmendOrders(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}, []*types.Amendment{
+		newAmendment(7, "abc", 2),
+		// not the creator of the order
+		newAmendment(8, "abc", 1),
+		// amendments can only reduce quantity in place
+		newAmendment(9, "abc", 4),
+		// amendments that replace the order at a new price are not applied in place
+		replacedAmendment,
+	})
+
+	entry, found := dexkeeper.GetShortBookByPrice(ctx, "test", sdk.NewDec(101), "USDC", "ATOM")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(12), entry.Entry.Quantity)
+	// the amended order keeps its position at the head of the queue
+	require.Equal(t, []*types.Allocation{{
+		OrderId:  7,
+		Account:  "abc",
+		Quantity: sdk.NewDec(2),
+	}, {
+		OrderId:  8,
+		Account:  "def",
+		Quantity: sdk.NewDec(7),
+	}, {
+		OrderId:  9,
+		Account:  "abc",
+		Quantity: sdk.NewDec(3),
+	}}, entry.Entry.Allocations)
+	// only the applied amendment is reported, with the quantity it removed
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(7), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_AMENDED, cancels[0].Initiator)
+	require.Equal(t, sdk.NewDec(3), cancels[0].Quantity)
+}
@@ -0,0 +1,372 @@
+package exchange
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// AuctionInterest is the quantity that one side of a batch auction is willing to
+// trade at its limit price or better. A zero price means any price.
+type AuctionInterest struct {
+	Price    sdk.Dec
+	Quantity sdk.Dec
+}
+
+// GetClearingPrice returns the single price at which `bids` and `asks` clear in a
+// batch auction, along with the quantity executed at that price. The limit price
+// that maximises the executed quantity is chosen, with ties broken by:
+//  1. the smallest surplus, i.e. unexecuted quantity left on the larger side;
+//  2. the highest price if demand exceeds supply at all remaining prices, or the
+//     lowest price if supply exceeds demand at all of them;
+//  3. otherwise the remaining price closest to their midpoint, the lower one if two
+//     are equally close. The midpoint itself is not used as it may not be a multiple
+//     of the pair's price tick size, whereas every remaining price is an order's
+//     limit price.
+//
+// Returns false if no quantity can be executed at any price.
+func GetClearingPrice(bids []AuctionInterest, asks []AuctionInterest) (sdk.Dec, sdk.Dec, bool) {
+	prices := []sdk.Dec{}
+	totalDemand := sdk.ZeroDec()
+	for _, bid := range bids {
+		totalDemand = totalDemand.Add(bid.Quantity)
+		if bid.Price.IsPositive() {
+			prices = append(prices, bid.Price)
+		}
+	}
+	for _, ask := range asks {
+		if ask.Price.IsPositive() {
+			prices = append(prices, ask.Price)
+		}
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+	boundedBids := sortedAuctionInterests(bids, func(bid AuctionInterest) bool { return bid.Price.IsPositive() })
+	sortedAsks := sortedAuctionInterests(asks, func(AuctionInterest) bool { return true })
+
+	type candidate struct {
+		price    sdk.Dec
+		executed sdk.Dec
+		// demand minus supply at the price
+		surplus sdk.Dec
+	}
+	best := []candidate{}
+	// prices are visited in ascending order, so demand only goes down and supply only goes up
+	demand, supply := totalDemand, sdk.ZeroDec()
+	bidPtr, askPtr := 0, 0
+	for i, price := range prices {
+		if i > 0 && price.Equal(prices[i-1]) {
+			continue
+		}
+		for ; bidPtr < len(boundedBids) && boundedBids[bidPtr].Price.LT(price); bidPtr++ {
+			demand = demand.Sub(boundedBids[bidPtr].Quantity)
+		}
+		for ; askPtr < len(sortedAsks) && sortedAsks[askPtr].Price.LTE(price); askPtr++ {
+			supply = supply.Add(sortedAsks[askPtr].Quantity)
+		}
+		executed := sdk.MinDec(demand, supply)
+		if !executed.IsPositive() {
+			continue
+		}
+		c := candidate{price: price, executed: executed, surplus: demand.Sub(supply)}
+		switch {
+		case len(best) == 0, executed.GT(best[0].executed),
+			executed.Equal(best[0].executed) && c.surplus.Abs().LT(best[0].surplus.Abs()):
+			best = []candidate{c}
+		case executed.Equal(best[0].executed) && c.surplus.Abs().Equal(best[0].surplus.Abs()):
+			best = append(best, c)
+		}
+	}
+	if len(best) == 0 {
+		return sdk.ZeroDec(), sdk.ZeroDec(), false
+	}
+
+	lowest, highest := best[0], best[len(best)-1]
+	excessDemand, excessSupply := true, true
+	for _, c := range best {
+		excessDemand = excessDemand && c.surplus.IsPositive()
+		excessSupply = excessSupply && c.surplus.IsNegative()
+	}
+	switch {
+	case excessDemand:
+		return highest.price, highest.executed, true
+	case excessSupply:
+		return lowest.price, lowest.executed, true
+	default:
+		midpoint := lowest.price.Add(highest.price).QuoInt64(2)
+		closest := lowest
+		for _, c := range best[1:] {
+			if c.price.Sub(midpoint).Abs().LT(closest.price.Sub(midpoint).Abs()) {
+				closest = c
+			}
+		}
+		return closest.price, closest.executed, true
+	}
+}
+
+// Returns the interests for which `include` holds, sorted by ascending price.
+func sortedAuctionInterests(interests []AuctionInterest, include func(AuctionInterest) bool) []AuctionInterest {
+	res := []AuctionInterest{}
+	for _, interest := range interests {
+		if include(interest) {
+			res = append(res, interest)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Price.LT(res[j].Price) })
+	return res
+}
+
+// MatchBatchAuction clears all crossing orders of a pair at a single uniform price
+// (see `GetClearingPrice`) instead of matching them one after another. Market and IOC
+// orders take part with their worst price as limit price, or at any price if they have
+// none; fill-or-kill orders don't take part and are left unfilled. On each side, orders
+// with better limit prices are filled first, orders without a limit price before all
+// others, and resting orders before market orders at the same price. Fills at the
+// marginal price level of the book are allocated according to the pair's matching
+// algorithm.
+func MatchBatchAuction(
+	ctx sdk.Context,
+	orderbook *types.OrderBook,
+	marketBuys []*types.Order,
+	marketSells []*types.Order,
+	blockOrders *cache.BlockOrders,
+) ExecutionOutcome {
+	marketBuys, marketSells = auctionTakers(marketBuys), auctionTakers(marketSells)
+	defer orderbook.Longs.Flush(ctx)
+	defer orderbook.Shorts.Flush(ctx)
+
+	// only entries that cross some order on the other side can be executed
+	lowestAsk, askFound := sdk.ZeroDec(), false
+	if entry := orderbook.Shorts.Next(ctx); entry != nil {
+		lowestAsk, askFound = entry.GetPrice(), true
+	}
+	for _, order := range marketSells {
+		if !askFound || order.Price.LT(lowestAsk) {
+			lowestAsk, askFound = order.Price, true
+		}
+	}
+	highestBid, bidFound, anyBid := sdk.ZeroDec(), false, false
+	if entry := orderbook.Longs.Next(ctx); entry != nil {
+		highestBid, bidFound = entry.GetPrice(), true
+	}
+	for _, order := range marketBuys {
+		if order.Price.IsZero() {
+			anyBid = true
+		} else if !bidFound || order.Price.GT(highestBid) {
+			highestBid, bidFound = order.Price, true
+		}
+	}
+	if !askFound || !(bidFound || anyBid) {
+		return emptyExecutionOutcome()
+	}
+	longEntries := orderbook.Longs.Peek(ctx, func(entry types.OrderBookEntry) bool {
+		return entry.GetPrice().GTE(lowestAsk)
+	})
+	shortEntries := orderbook.Shorts.Peek(ctx, func(entry types.OrderBookEntry) bool {
+		return anyBid || entry.GetPrice().LTE(highestBid)
+	})
+
+	clearingPrice, executed, found := GetClearingPrice(
+		auctionInterests(longEntries, marketBuys),
+		auctionInterests(shortEntries, marketSells),
+	)
+	if !found {
+		return emptyExecutionOutcome()
+	}
+	longFills := fillAuctionSide(ctx, orderbook.Longs, auctionParticipants(longEntries, marketBuys, clearingPrice, types.PositionDirection_LONG), executed, blockOrders)
+	shortFills := fillAuctionSide(ctx, orderbook.Shorts, auctionParticipants(shortEntries, marketSells, clearingPrice, types.PositionDirection_SHORT), executed, blockOrders)
+	settlements := settleAuctionFills(ctx, orderbook.Pair, longFills, shortFills, clearingPrice)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBatchAuction,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, string(orderbook.Contract)),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, orderbook.Pair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, orderbook.Pair.AssetDenom),
+		sdk.NewAttribute(types.AttributeKeyClearingPrice, clearingPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyQuantity, executed.String()),
+	))
+	return ExecutionOutcome{
+		TotalNotional:        executed.Mul(clearingPrice),
+		TotalQuantity:        executed,
+		Settlements:          settlements,
+		MinPrice:             clearingPrice,
+		MaxPrice:             clearingPrice,
+		SelfTradePreventions: []*types.SelfTradePrevention{},
+		Trades:               tradesFromBookSettlements(settlements),
+	}
+}
+
+func emptyExecutionOutcome() ExecutionOutcome {
+	return ExecutionOutcome{
+		TotalNotional:        sdk.ZeroDec(),
+		TotalQuantity:        sdk.ZeroDec(),
+		Settlements:          []*types.SettlementEntry{},
+		MinPrice:             sdk.OneDec().Neg(),
+		MaxPrice:             sdk.OneDec().Neg(),
+		SelfTradePreventions: []*types.SelfTradePrevention{},
+		Trades:               []*types.Trade{},
+	}
+}
+
+func auctionTakers(marketOrders []*types.Order) []*types.Order {
+	res := []*types.Order{}
+	for _, order := range marketOrders {
+		if order.OrderType != types.OrderType_FOKMARKET && order.OrderType != types.OrderType_FOKMARKETBYVALUE {
+			res = append(res, order)
+		}
+	}
+	return res
+}
+
+func auctionInterests(entries []types.OrderBookEntry, takers []*types.Order) []AuctionInterest {
+	res := []AuctionInterest{}
+	for _, entry := range entries {
+		res = append(res, AuctionInterest{Price: entry.GetPrice(), Quantity: entry.GetOrderEntry().Quantity})
+	}
+	for _, order := range takers {
+		res = append(res, AuctionInterest{Price: order.Price, Quantity: order.Quantity})
+	}
+	return res
+}
+
+// either a price level of the book or a market order
+type auctionParticipant struct {
+	entry types.OrderBookEntry
+	order *types.Order
+	price sdk.Dec
+}
+
+type auctionFill struct {
+	toSettle   types.ToSettle
+	limitPrice sdk.Dec
+	orderType  types.OrderType
+}
+
+// Returns the participants of one side that are willing to trade at `clearingPrice`,
+// in the order they are filled.
+func auctionParticipants(
+	entries []types.OrderBookEntry,
+	takers []*types.Order,
+	clearingPrice sdk.Dec,
+	direction types.PositionDirection,
+) []auctionParticipant {
+	accepts := func(price sdk.Dec) bool {
+		if direction == types.PositionDirection_LONG {
+			return price.IsZero() || price.GTE(clearingPrice)
+		}
+		return price.LTE(clearingPrice)
+	}
+	participants := []auctionParticipant{}
+	for _, entry := range entries {
+		if accepts(entry.GetPrice()) {
+			participants = append(participants, auctionParticipant{entry: entry, price: entry.GetPrice()})
+		}
+	}
+	for _, order := range takers {
+		if accepts(order.Price) {
+			participants = append(participants, auctionParticipant{order: order, price: order.Price})
+		}
+	}
+	sort.SliceStable(participants, func(i, j int) bool {
+		a, b := participants[i], participants[j]
+		aAny, bAny := a.order != nil && a.price.IsZero(), b.order != nil && b.price.IsZero()
+		if aAny != bAny {
+			return aAny
+		}
+		if !a.price.Equal(b.price) {
+			if direction == types.PositionDirection_LONG {
+				return a.price.GT(b.price)
+			}
+			return a.price.LT(b.price)
+		}
+		return a.entry != nil && b.entry == nil
+	})
+	return participants
+}
+
+// Fills `quantity` across `participants` in order. Book entries are settled through
+// `entries`, which must currently point at the first entry among the participants.
+func fillAuctionSide(
+	ctx sdk.Context,
+	entries *types.CachedSortedOrderBookEntries,
+	participants []auctionParticipant,
+	quantity sdk.Dec,
+	blockOrders *cache.BlockOrders,
+) []auctionFill {
+	fills := []auctionFill{}
+	remaining := quantity
+	for _, participant := range participants {
+		if !remaining.IsPositive() {
+			break
+		}
+		if participant.order != nil {
+			executed := sdk.MinDec(remaining, participant.order.Quantity)
+			UpdateOrderData(participant.order, executed, blockOrders)
+			fills = append(fills, auctionFill{
+				toSettle:   types.ToSettle{OrderID: participant.order.Id, Account: participant.order.Account, Amount: executed},
+				limitPrice: participant.price,
+				orderType:  participant.order.OrderType,
+			})
+			remaining = remaining.Sub(executed)
+			continue
+		}
+		entries.Next(ctx)
+		toSettle, settled := entries.AllocateQuantity(ctx, sdk.MinDec(remaining, participant.entry.GetOrderEntry().Quantity))
+		for _, s := range toSettle {
+			fills = append(fills, auctionFill{toSettle: s, limitPrice: participant.price, orderType: types.OrderType_LIMIT})
+		}
+		remaining = remaining.Sub(settled)
+	}
+	return fills
+}
+
+// Pairs up long and short fills, which add up to the same quantity, into settlements
+// at the clearing price. Settlements come in (long, short) pairs like those returned
+// by `SettleFromBook`.
+func settleAuctionFills(
+	ctx sdk.Context,
+	pair types.Pair,
+	longFills []auctionFill,
+	shortFills []auctionFill,
+	clearingPrice sdk.Dec,
+) []*types.SettlementEntry {
+	settlements := []*types.SettlementEntry{}
+	longPtr, shortPtr := 0, 0
+	for longPtr < len(longFills) && shortPtr < len(shortFills) {
+		long, short := &longFills[longPtr], &shortFills[shortPtr]
+		quantity := sdk.MinDec(long.toSettle.Amount, short.toSettle.Amount)
+		settlements = append(settlements, types.NewSettlementEntry(
+			ctx,
+			long.toSettle.OrderID,
+			long.toSettle.Account,
+			types.PositionDirection_LONG,
+			pair.PriceDenom,
+			pair.AssetDenom,
+			quantity,
+			clearingPrice,
+			long.limitPrice,
+			long.orderType,
+		), types.NewSettlementEntry(
+			ctx,
+			short.toSettle.OrderID,
+			short.toSettle.Account,
+			types.PositionDirection_SHORT,
+			pair.PriceDenom,
+			pair.AssetDenom,
+			quantity,
+			clearingPrice,
+			short.limitPrice,
+			short.orderType,
+		))
+		long.toSettle.Amount = long.toSettle.Amount.Sub(quantity)
+		short.toSettle.Amount = short.toSettle.Amount.Sub(quantity)
+		if long.toSettle.Amount.IsZero() {
+			longPtr++
+		}
+		if short.toSettle.Amount.IsZero() {
+			shortPtr++
+		}
+	}
+	return settlements
+}
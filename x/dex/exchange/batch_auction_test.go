@@ -0,0 +1,173 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func interest(price int64, quantity int64) exchange.AuctionInterest {
+	return exchange.AuctionInterest{Price: sdk.NewDec(price), Quantity: sdk.NewDec(quantity)}
+}
+
+func TestGetClearingPrice(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		bids             []exchange.AuctionInterest
+		asks             []exchange.AuctionInterest
+		expectedPrice    sdk.Dec
+		expectedQuantity sdk.Dec
+		expectedFound    bool
+	}{
+		{
+			name:          "no crossing orders",
+			bids:          []exchange.AuctionInterest{interest(99, 5)},
+			asks:          []exchange.AuctionInterest{interest(100, 5)},
+			expectedFound: false,
+		},
+		{
+			name:          "only orders without a limit price",
+			bids:          []exchange.AuctionInterest{interest(0, 5)},
+			asks:          []exchange.AuctionInterest{interest(0, 5)},
+			expectedFound: false,
+		},
+		{
+			name:             "maximum executed quantity",
+			bids:             []exchange.AuctionInterest{interest(102, 3), interest(101, 4)},
+			asks:             []exchange.AuctionInterest{interest(100, 2), interest(101, 5)},
+			expectedPrice:    sdk.NewDec(101),
+			expectedQuantity: sdk.NewDec(7),
+			expectedFound:    true,
+		},
+		{
+			name:             "smallest surplus",
+			bids:             []exchange.AuctionInterest{interest(102, 4), interest(100, 4)},
+			asks:             []exchange.AuctionInterest{interest(99, 3)},
+			expectedPrice:    sdk.NewDec(102),
+			expectedQuantity: sdk.NewDec(3),
+			expectedFound:    true,
+		},
+		{
+			name:             "excess demand picks the highest price",
+			bids:             []exchange.AuctionInterest{interest(0, 10)},
+			asks:             []exchange.AuctionInterest{interest(99, 2), interest(101, 2)},
+			expectedPrice:    sdk.NewDec(101),
+			expectedQuantity: sdk.NewDec(4),
+			expectedFound:    true,
+		},
+		{
+			name:             "excess supply picks the lowest price",
+			bids:             []exchange.AuctionInterest{interest(101, 2), interest(99, 2)},
+			asks:             []exchange.AuctionInterest{interest(0, 10)},
+			expectedPrice:    sdk.NewDec(99),
+			expectedQuantity: sdk.NewDec(4),
+			expectedFound:    true,
+		},
+		{
+			name:             "balanced picks the lower price closest to the midpoint",
+			bids:             []exchange.AuctionInterest{interest(102, 5)},
+			asks:             []exchange.AuctionInterest{interest(99, 5)},
+			expectedPrice:    sdk.NewDec(99),
+			expectedQuantity: sdk.NewDec(5),
+			expectedFound:    true,
+		},
+		{
+			name:             "balanced picks the limit price closest to the midpoint",
+			bids:             []exchange.AuctionInterest{interest(102, 5), interest(100, 0)},
+			asks:             []exchange.AuctionInterest{interest(99, 5)},
+			expectedPrice:    sdk.NewDec(100),
+			expectedQuantity: sdk.NewDec(5),
+			expectedFound:    true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			price, quantity, found := exchange.GetClearingPrice(tt.bids, tt.asks)
+			require.Equal(t, tt.expectedFound, found)
+			if found {
+				require.Equal(t, tt.expectedPrice, price)
+				require.Equal(t, tt.expectedQuantity, quantity)
+			}
+		})
+	}
+}
+
+func TestMatchBatchAuction(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MatchingMode: types.MatchingMode_BATCH_AUCTION}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper,
+		[]*types.Order{
+			newTestOrder(pair, 1, types.PositionDirection_LONG, 100, 5),
+			newTestOrder(pair, 2, types.PositionDirection_LONG, 99, 5),
+		},
+		[]*types.Order{
+			newTestOrder(pair, 3, types.PositionDirection_SHORT, 98, 4),
+			newTestOrder(pair, 4, types.PositionDirection_SHORT, 101, 5),
+		},
+	)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+	marketBuy := newTestOrder(pair, 5, types.PositionDirection_LONG, 0, 2, withOrderType(types.OrderType_MARKET))
+	fokBuy := newTestOrder(pair, 6, types.PositionDirection_LONG, 0, 1, withOrderType(types.OrderType_FOKMARKET))
+	blockOrders.Add(marketBuy)
+	blockOrders.Add(fokBuy)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+
+	// bids: any:2, 100:5, 99:5; asks: 98:4, 101:5. 4 can be executed at 98, 99 or 100,
+	// and the surplus is the smallest at 100
+	outcome := exchange.MatchBatchAuction(ctx, orderbook, []*types.Order{marketBuy, fokBuy}, []*types.Order{}, blockOrders)
+	require.Equal(t, sdk.NewDec(4), outcome.TotalQuantity)
+	require.Equal(t, sdk.NewDec(400), outcome.TotalNotional)
+	require.Equal(t, sdk.NewDec(100), outcome.MinPrice)
+	require.Equal(t, sdk.NewDec(100), outcome.MaxPrice)
+	require.Equal(t, 4, len(outcome.Settlements))
+	for _, settlement := range outcome.Settlements {
+		require.Equal(t, sdk.NewDec(100), settlement.ExecutionCostOrProceed)
+		require.Equal(t, sdk.NewDec(2), settlement.Quantity)
+	}
+	// the market order is filled first, then the best bid
+	require.Equal(t, uint64(5), outcome.Settlements[0].OrderId)
+	require.Equal(t, "Market", outcome.Settlements[0].OrderType)
+	require.Equal(t, uint64(3), outcome.Settlements[1].OrderId)
+	require.Equal(t, uint64(1), outcome.Settlements[2].OrderId)
+	require.Equal(t, sdk.NewDec(100), outcome.Settlements[2].ExpectedCostOrProceed)
+	require.Equal(t, uint64(3), outcome.Settlements[3].OrderId)
+	require.Equal(t, sdk.NewDec(98), outcome.Settlements[3].ExpectedCostOrProceed)
+	require.Equal(t, 2, len(outcome.Trades))
+	require.Equal(t, sdk.NewDec(100), outcome.Trades[0].Price)
+
+	require.Equal(t, types.OrderStatus_FULFILLED, blockOrders.GetByID(5).Status)
+	require.Equal(t, types.OrderStatus_PLACED, blockOrders.GetByID(6).Status)
+	require.Equal(t, sdk.NewDec(1), blockOrders.GetByID(6).Quantity)
+
+	longBook := dexkeeper.GetAllLongBookForPair(ctx, "test", pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 2, len(longBook))
+	require.Equal(t, sdk.NewDec(5), longBook[0].GetOrderEntry().Quantity)
+	require.Equal(t, sdk.NewDec(3), longBook[1].GetOrderEntry().Quantity)
+	shortBook := dexkeeper.GetAllShortBookForPair(ctx, "test", pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(shortBook))
+	require.Equal(t, sdk.NewDec(101), shortBook[0].GetPrice())
+}
+
+func TestMatchBatchAuctionNoCross(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MatchingMode: types.MatchingMode_BATCH_AUCTION}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper,
+		[]*types.Order{{Id: 1, Price: sdk.NewDec(99), Quantity: sdk.NewDec(5), Account: "abc", PositionDirection: types.PositionDirection_LONG, ContractAddr: "test", PriceDenom: "USDC", AssetDenom: "ATOM"}},
+		[]*types.Order{{Id: 2, Price: sdk.NewDec(100), Quantity: sdk.NewDec(5), Account: "def", PositionDirection: types.PositionDirection_SHORT, ContractAddr: "test", PriceDenom: "USDC", AssetDenom: "ATOM"}},
+	)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+
+	outcome := exchange.MatchBatchAuction(ctx, orderbook, []*types.Order{}, []*types.Order{}, blockOrders)
+	require.True(t, outcome.TotalQuantity.IsZero())
+	require.Empty(t, outcome.Settlements)
+	require.Equal(t, 1, len(dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")))
+	require.Equal(t, 1, len(dexkeeper.GetAllShortBookForPair(ctx, "test", "USDC", "ATOM")))
+}
@@ -14,6 +14,8 @@ func CancelOrders(
 ) {
 	for _, cancel := range cancels {
 		cancelOrder(ctx, keeper, cancel, contract, pair)
+		keeper.RemoveTriggerOrder(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, cancel.Id)
+		keeper.RemoveTriggeredOrder(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, cancel.Id)
 	}
 }
 
@@ -33,6 +35,11 @@ func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Can
 		if allocation.OrderId != cancellation.Id {
 			newAllocations = append(newAllocations, allocation)
 			newQuantity = newQuantity.Add(allocation.Quantity)
+		} else {
+			if order, found := keeper.GetAccountOrder(ctx, string(contract), allocation.Account, pair.PriceDenom, pair.AssetDenom, allocation.OrderId); found {
+				keeper.RemoveOrderFromExpiryQueue(ctx, string(contract), order)
+			}
+			keeper.RemoveAccountOrder(ctx, string(contract), allocation.Account, pair.PriceDenom, pair.AssetDenom, allocation.OrderId)
 		}
 	}
 	numAllocationsRemoved := len(newEntry.Allocations) - len(newAllocations)
@@ -11,14 +11,28 @@ type ExecutionOutcome struct {
 	Settlements   []*types.SettlementEntry
 	MinPrice      sdk.Dec // deprecate?
 	MaxPrice      sdk.Dec // deprecate?
+	// volume that was not matched because both sides belonged to the same account
+	SelfTradePreventions []*types.SelfTradePrevention
+	// individual fills between a taker and a maker order
+	Trades []*types.Trade
+}
+
+// LastTradePrice returns the price of the last fill of the outcome, if any
+func (o *ExecutionOutcome) LastTradePrice() (sdk.Dec, bool) {
+	if len(o.Trades) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	return o.Trades[len(o.Trades)-1].Price, true
 }
 
 func (o *ExecutionOutcome) Merge(other *ExecutionOutcome) ExecutionOutcome {
 	return ExecutionOutcome{
-		TotalNotional: o.TotalNotional.Add(other.TotalNotional),
-		TotalQuantity: o.TotalQuantity.Add(other.TotalQuantity),
-		Settlements:   append(o.Settlements, other.Settlements...),
-		MinPrice:      sdk.MinDec(o.MinPrice, other.MinPrice),
-		MaxPrice:      sdk.MaxDec(o.MaxPrice, other.MaxPrice),
+		TotalNotional:        o.TotalNotional.Add(other.TotalNotional),
+		TotalQuantity:        o.TotalQuantity.Add(other.TotalQuantity),
+		Settlements:          append(o.Settlements, other.Settlements...),
+		MinPrice:             sdk.MinDec(o.MinPrice, other.MinPrice),
+		MaxPrice:             sdk.MaxDec(o.MaxPrice, other.MaxPrice),
+		SelfTradePreventions: append(o.SelfTradePreventions, other.SelfTradePreventions...),
+		Trades:               append(o.Trades, other.Trades...),
 	}
 }
@@ -62,3 +62,17 @@ func TestMergeExecutionOutcomes(t *testing.T) {
 	require.Equal(t, outcome.MinPrice, sdk.MustNewDecFromStr("0.5"))
 	require.Equal(t, outcome.MaxPrice, sdk.MustNewDecFromStr("4"))
 }
+
+func TestLastTradePrice(t *testing.T) {
+	outcome := exchange.ExecutionOutcome{}
+	_, traded := outcome.LastTradePrice()
+	require.False(t, traded)
+
+	outcome.Trades = []*types.Trade{
+		{Price: sdk.NewDec(100), Quantity: sdk.NewDec(9)},
+		{Price: sdk.NewDec(90), Quantity: sdk.NewDec(1)},
+	}
+	price, traded := outcome.LastTradePrice()
+	require.True(t, traded)
+	require.Equal(t, sdk.NewDec(90), price)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
@@ -15,13 +16,21 @@ func MatchLimitOrders(
 	settlements := []*types.SettlementEntry{}
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
+	selfTradePreventions := []*types.SelfTradePrevention{}
+	trades := []*types.Trade{}
 
 	for longEntry, shortEntry := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx); longEntry != nil && shortEntry != nil && longEntry.GetPrice().GTE(shortEntry.GetPrice()); longEntry, shortEntry = orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx) {
-		var executed sdk.Dec
-		if longEntry.GetOrderEntry().Quantity.LT(shortEntry.GetOrderEntry().Quantity) {
-			executed = longEntry.GetOrderEntry().Quantity
-		} else {
-			executed = shortEntry.GetOrderEntry().Quantity
+		executed, longSelfAllocation, shortSelfAllocation := matchableBetweenEntriesBeforeSelfTrade(longEntry, shortEntry, orderbook.Pair.SelfTradePreventionMode)
+		if executed.IsZero() && longSelfAllocation != nil {
+			selfTradePreventions = append(selfTradePreventions, preventBookSelfTrade(
+				ctx,
+				orderbook.Pair.SelfTradePreventionMode,
+				orderbook,
+				longSelfAllocation,
+				shortSelfAllocation,
+				longEntry.GetPrice().Add(shortEntry.GetPrice()).Quo(sdk.NewDec(2)),
+			))
+			continue
 		}
 		totalExecuted = totalExecuted.Add(executed).Add(executed)
 		totalPrice = totalPrice.Add(
@@ -42,16 +51,19 @@ func MatchLimitOrders(
 			shortEntry.GetPrice(),
 		)
 		settlements = append(settlements, newSettlements...)
+		trades = append(trades, tradesFromBookSettlements(newSettlements)...)
 	}
 
 	orderbook.Longs.Flush(ctx)
 	orderbook.Shorts.Flush(ctx)
 	return ExecutionOutcome{
-		TotalNotional: totalPrice,
-		TotalQuantity: totalExecuted,
-		Settlements:   settlements,
-		MinPrice:      minPrice,
-		MaxPrice:      maxPrice,
+		TotalNotional:        totalPrice,
+		TotalQuantity:        totalExecuted,
+		Settlements:          settlements,
+		MinPrice:             minPrice,
+		MaxPrice:             maxPrice,
+		SelfTradePreventions: selfTradePreventions,
+		Trades:               trades,
 	}
 }
 
@@ -87,6 +99,8 @@ func addOrderToOrderBookEntry(
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("error increasing order count: %s", err))
 	}
+	keeper.AddOrderToExpiryQueue(ctx, order.ContractAddr, *order)
+	keeper.SetAccountOrder(ctx, order.ContractAddr, *order)
 }
 
 func AddOutstandingLimitOrdersToOrderbook(
@@ -101,3 +115,64 @@ func AddOutstandingLimitOrdersToOrderbook(
 		addOrderToOrderBookEntry(ctx, keeper, order)
 	}
 }
+
+// Post-only orders are only added to the order book if they would not cross it.
+// POSTONLY orders that would cross are rejected, whereas POSTONLYREPRICE orders are
+// moved one price tick behind the best opposite price.
+func AddPostOnlyOrdersToOrderbook(
+	ctx sdk.Context, keeper *keeper.Keeper, pair types.Pair,
+	postOnlyBuys []*types.Order,
+	postOnlySells []*types.Order,
+	blockOrders *cache.BlockOrders,
+) {
+	for _, order := range append(postOnlyBuys, postOnlySells...) {
+		bestOpposite, found := getBestOppositePrice(ctx, keeper, order)
+		if found && wouldCross(order, bestOpposite) {
+			if order.OrderType != types.OrderType_POSTONLYREPRICE || !repricePostOnlyOrder(order, bestOpposite, pair.PriceTicksize) {
+				order.Status = types.OrderStatus_POST_ONLY_REJECTED
+				blockOrders.Add(order)
+				continue
+			}
+			order.Status = types.OrderStatus_POST_ONLY_REPRICED
+			blockOrders.Add(order)
+		}
+		addOrderToOrderBookEntry(ctx, keeper, order)
+	}
+}
+
+func getBestOppositePrice(ctx sdk.Context, keeper *keeper.Keeper, order *types.Order) (sdk.Dec, bool) {
+	var entries []types.OrderBookEntry
+	if order.PositionDirection == types.PositionDirection_LONG {
+		entries = keeper.GetTopNShortBooksForPair(ctx, order.ContractAddr, order.PriceDenom, order.AssetDenom, 1)
+	} else {
+		entries = keeper.GetTopNLongBooksForPair(ctx, order.ContractAddr, order.PriceDenom, order.AssetDenom, 1)
+	}
+	if len(entries) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	return entries[0].GetPrice(), true
+}
+
+func wouldCross(order *types.Order, bestOpposite sdk.Dec) bool {
+	if order.PositionDirection == types.PositionDirection_LONG {
+		return order.Price.GTE(bestOpposite)
+	}
+	return order.Price.LTE(bestOpposite)
+}
+
+// returns false if the order cannot be repriced to a valid price
+func repricePostOnlyOrder(order *types.Order, bestOpposite sdk.Dec, tickSize *sdk.Dec) bool {
+	if tickSize == nil || !tickSize.IsPositive() {
+		return false
+	}
+	if order.PositionDirection == types.PositionDirection_LONG {
+		newPrice := bestOpposite.Sub(*tickSize)
+		if !newPrice.IsPositive() {
+			return false
+		}
+		order.Price = newPrice
+	} else {
+		order.Price = bestOpposite.Add(*tickSize)
+	}
+	return true
+}
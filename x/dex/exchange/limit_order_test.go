@@ -8,6 +8,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/exchange"
 	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 	"github.com/stretchr/testify/assert"
 
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -1163,3 +1164,56 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Height:                 TestHeight,
 	})
 }
+
+func TestAddPostOnlyOrdersToOrderbook(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	tickSize := sdk.NewDec(1)
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", PriceTicksize: &tickSize}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, []*types.Order{}, []*types.Order{
+		{
+			Id:                1,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	})
+	newPostOnly := func(id uint64, price int64, orderType types.OrderType) *types.Order {
+		return &types.Order{
+			Id:                id,
+			Price:             sdk.NewDec(price),
+			Quantity:          sdk.NewDec(1),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         orderType,
+		}
+	}
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress("test"), pair)
+	resting := newPostOnly(2, 99, types.OrderType_POSTONLY)
+	rejected := newPostOnly(3, 100, types.OrderType_POSTONLY)
+	repriced := newPostOnly(4, 101, types.OrderType_POSTONLYREPRICE)
+	for _, order := range []*types.Order{resting, rejected, repriced} {
+		blockOrders.Add(order)
+	}
+	exchange.AddPostOnlyOrdersToOrderbook(ctx, dexkeeper, pair, []*types.Order{resting, rejected, repriced}, []*types.Order{}, blockOrders)
+
+	assert.Equal(t, types.OrderStatus_PLACED, blockOrders.GetByID(2).Status)
+	assert.Equal(t, types.OrderStatus_POST_ONLY_REJECTED, blockOrders.GetByID(3).Status)
+	assert.Equal(t, types.OrderStatus_POST_ONLY_REPRICED, blockOrders.GetByID(4).Status)
+	assert.Equal(t, sdk.NewDec(99), blockOrders.GetByID(4).Price)
+
+	longBook, found := dexkeeper.GetLongBookByPrice(ctx, "test", sdk.NewDec(99), "USDC", "ATOM")
+	assert.True(t, found)
+	assert.Equal(t, sdk.NewDec(2), longBook.Entry.Quantity)
+	assert.Equal(t, 2, len(longBook.Entry.Allocations))
+	_, found = dexkeeper.GetLongBookByPrice(ctx, "test", sdk.NewDec(100), "USDC", "ATOM")
+	assert.False(t, found)
+}
@@ -11,24 +11,30 @@ func MatchMarketOrders(
 	marketOrders []*types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	selfTradePreventionMode types.SelfTradePreventionMode,
 	blockOrders *cache.BlockOrders,
 ) ExecutionOutcome {
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
 	settlements := []*types.SettlementEntry{}
 	allTakerSettlements := []*types.SettlementEntry{}
+	selfTradePreventions := []*types.SelfTradePrevention{}
+	trades := []*types.Trade{}
 	for _, marketOrder := range marketOrders {
+		numSettlements := len(settlements)
 		switch marketOrder.OrderType {
 		case types.OrderType_FOKMARKETBYVALUE:
-			settlements, allTakerSettlements = MatchByValueFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+			settlements, allTakerSettlements, selfTradePreventions = MatchByValueFOKMarketOrder(
+				ctx, marketOrder, orderBookEntries, direction, selfTradePreventionMode, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, selfTradePreventions, blockOrders)
 		case types.OrderType_FOKMARKET:
-			settlements, allTakerSettlements = MatchFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+			settlements, allTakerSettlements, selfTradePreventions = MatchFOKMarketOrder(
+				ctx, marketOrder, orderBookEntries, direction, selfTradePreventionMode, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, selfTradePreventions, blockOrders)
 		default:
-			settlements, allTakerSettlements = MatchMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+			settlements, allTakerSettlements, selfTradePreventions = MatchMarketOrder(
+				ctx, marketOrder, orderBookEntries, direction, selfTradePreventionMode, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, selfTradePreventions, blockOrders)
 		}
+		// only maker settlements are added to `settlements` while matching
+		trades = append(trades, tradesFromMakerSettlements(marketOrder, settlements[numSettlements:])...)
 	}
 
 	if totalExecuted.IsPositive() {
@@ -40,11 +46,13 @@ func MatchMarketOrders(
 		settlements = append(settlements, allTakerSettlements...)
 	}
 	return ExecutionOutcome{
-		TotalNotional: totalPrice,
-		TotalQuantity: totalExecuted,
-		Settlements:   settlements,
-		MinPrice:      minPrice,
-		MaxPrice:      maxPrice,
+		TotalNotional:        totalPrice,
+		TotalQuantity:        totalExecuted,
+		Settlements:          settlements,
+		MinPrice:             minPrice,
+		MaxPrice:             maxPrice,
+		SelfTradePreventions: selfTradePreventions,
+		Trades:               trades,
 	}
 }
 
@@ -53,14 +61,16 @@ func MatchMarketOrder(
 	marketOrder *types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	selfTradePreventionMode types.SelfTradePreventionMode,
 	totalExecuted *sdk.Dec,
 	totalPrice *sdk.Dec,
 	minPrice *sdk.Dec,
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	selfTradePreventions []*types.SelfTradePrevention,
 	blockOrders *cache.BlockOrders,
-) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+) ([]*types.SettlementEntry, []*types.SettlementEntry, []*types.SelfTradePrevention) {
 	remainingQuantity := marketOrder.Quantity
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
 		// If price is zero, it means the order sender
@@ -73,11 +83,21 @@ func MatchMarketOrder(
 				break
 			}
 		}
+		matchable, selfAllocation := matchableBeforeSelfTrade(entry, marketOrder.Account, selfTradePreventionMode)
+		if matchable.IsZero() && selfAllocation != nil {
+			selfTradePreventions = append(selfTradePreventions, preventMarketSelfTrade(
+				ctx, selfTradePreventionMode, marketOrder, &remainingQuantity, orderBookEntries, entry.GetPrice(), selfAllocation,
+			))
+			if remainingQuantity.IsZero() {
+				break
+			}
+			continue
+		}
 		var executed sdk.Dec
-		if remainingQuantity.LTE(entry.GetOrderEntry().Quantity) {
+		if remainingQuantity.LTE(matchable) {
 			executed = remainingQuantity
 		} else {
-			executed = entry.GetOrderEntry().Quantity
+			executed = matchable
 		}
 		remainingQuantity = remainingQuantity.Sub(executed)
 		*totalExecuted = totalExecuted.Add(executed)
@@ -109,7 +129,7 @@ func MatchMarketOrder(
 
 	orderBookEntries.Flush(ctx)
 
-	return settlements, allTakerSettlements
+	return settlements, allTakerSettlements, selfTradePreventions
 }
 
 func MatchFOKMarketOrder(
@@ -117,17 +137,20 @@ func MatchFOKMarketOrder(
 	marketOrder *types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	selfTradePreventionMode types.SelfTradePreventionMode,
 	totalExecuted *sdk.Dec,
 	totalPrice *sdk.Dec,
 	minPrice *sdk.Dec,
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	selfTradePreventions []*types.SelfTradePrevention,
 	blockOrders *cache.BlockOrders,
-) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+) ([]*types.SettlementEntry, []*types.SettlementEntry, []*types.SelfTradePrevention) {
 	// check if there is enough liquidity for fill-or-kill market order, if not skip them
 	remainingQuantity := marketOrder.Quantity
 	newSettlements, newTakerSettlements := []*types.SettlementEntry{}, []*types.SettlementEntry{}
+	newSelfTradePreventions := []*types.SelfTradePrevention{}
 	orders, executedQuantities, entryPrices := []*types.Order{}, []sdk.Dec{}, []sdk.Dec{}
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
 		if !marketOrder.Price.IsZero() {
@@ -136,12 +159,21 @@ func MatchFOKMarketOrder(
 				break
 			}
 		}
+		matchable, selfAllocation := matchableBeforeSelfTrade(entry, marketOrder.Account, selfTradePreventionMode)
+		if matchable.IsZero() && selfAllocation != nil {
+			// the order is killed, so the remaining quantity is left untouched
+			unfilled := remainingQuantity
+			newSelfTradePreventions = append(newSelfTradePreventions, preventMarketSelfTrade(
+				ctx, selfTradePreventionMode, marketOrder, &unfilled, orderBookEntries, entry.GetPrice(), selfAllocation,
+			))
+			break
+		}
 
 		var executed sdk.Dec
-		if remainingQuantity.LTE(entry.GetOrderEntry().Quantity) {
+		if remainingQuantity.LTE(matchable) {
 			executed = remainingQuantity
 		} else {
-			executed = entry.GetOrderEntry().Quantity
+			executed = matchable
 		}
 		remainingQuantity = remainingQuantity.Sub(executed)
 
@@ -182,8 +214,9 @@ func MatchFOKMarketOrder(
 	} else {
 		orderBookEntries.Refresh(ctx)
 	}
+	selfTradePreventions = append(selfTradePreventions, newSelfTradePreventions...)
 
-	return settlements, allTakerSettlements
+	return settlements, allTakerSettlements, selfTradePreventions
 }
 
 func MatchByValueFOKMarketOrder(
@@ -191,17 +224,20 @@ func MatchByValueFOKMarketOrder(
 	marketOrder *types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	selfTradePreventionMode types.SelfTradePreventionMode,
 	totalExecuted *sdk.Dec,
 	totalPrice *sdk.Dec,
 	minPrice *sdk.Dec,
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	selfTradePreventions []*types.SelfTradePrevention,
 	blockOrders *cache.BlockOrders,
-) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+) ([]*types.SettlementEntry, []*types.SettlementEntry, []*types.SelfTradePrevention) {
 	remainingFund := marketOrder.Nominal
 	remainingQuantity := marketOrder.Quantity
 	newSettlements, newTakerSettlements := []*types.SettlementEntry{}, []*types.SettlementEntry{}
+	newSelfTradePreventions := []*types.SelfTradePrevention{}
 	orders, executedQuantities, entryPrices := []*types.Order{}, []sdk.Dec{}, []sdk.Dec{}
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
 		if !marketOrder.Price.IsZero() {
@@ -210,12 +246,21 @@ func MatchByValueFOKMarketOrder(
 				break
 			}
 		}
+		matchable, selfAllocation := matchableBeforeSelfTrade(entry, marketOrder.Account, selfTradePreventionMode)
+		if matchable.IsZero() && selfAllocation != nil {
+			// the order is killed, so the remaining quantity is left untouched
+			unfilled := remainingQuantity
+			newSelfTradePreventions = append(newSelfTradePreventions, preventMarketSelfTrade(
+				ctx, selfTradePreventionMode, marketOrder, &unfilled, orderBookEntries, entry.GetPrice(), selfAllocation,
+			))
+			break
+		}
 		var executed sdk.Dec
-		if remainingFund.LTE(entry.GetOrderEntry().Quantity.Mul(entry.GetPrice())) {
+		if remainingFund.LTE(matchable.Mul(entry.GetPrice())) {
 			executed = remainingFund.Quo(entry.GetPrice())
 			remainingFund = sdk.ZeroDec()
 		} else {
-			executed = entry.GetOrderEntry().Quantity
+			executed = matchable
 			remainingFund = remainingFund.Sub(executed.Mul(entry.GetPrice()))
 		}
 		remainingQuantity = remainingQuantity.Sub(executed)
@@ -257,8 +302,9 @@ func MatchByValueFOKMarketOrder(
 	} else {
 		orderBookEntries.Refresh(ctx)
 	}
+	selfTradePreventions = append(selfTradePreventions, newSelfTradePreventions...)
 
-	return settlements, allTakerSettlements
+	return settlements, allTakerSettlements, selfTradePreventions
 }
 
 func MergeByNominalTakerSettlements(settlements []*types.SettlementEntry) []*types.SettlementEntry {
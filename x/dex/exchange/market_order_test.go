@@ -20,6 +20,38 @@ const (
 	TestHeight    uint64 = 1
 )
 
+// Builds a limit order of account "abc" on the "test" contract. Fields that a test
+// cares about beyond the side, price and quantity are set through order options.
+func newTestOrder(pair types.Pair, id uint64, direction types.PositionDirection, price int64, quantity int64, opts ...func(*types.Order)) *types.Order {
+	order := &types.Order{
+		Id:                id,
+		Account:           "abc",
+		ContractAddr:      "test",
+		Price:             sdk.NewDec(price),
+		Quantity:          sdk.NewDec(quantity),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: direction,
+	}
+	for _, opt := range opts {
+		opt(order)
+	}
+	return order
+}
+
+func withAccount(account string) func(*types.Order) {
+	return func(order *types.Order) {
+		order.Account = account
+	}
+}
+
+func withOrderType(orderType types.OrderType) func(*types.Order) {
+	return func(order *types.Order) {
+		order.OrderType = orderType
+	}
+}
+
 func TestMatchFoKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T) {
 	dexkeeper, ctx := keepertest.DexKeeper(t)
 	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
@@ -72,7 +104,7 @@ func TestMatchFoKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -140,7 +172,7 @@ func TestMatchFoKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
 	})
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -236,7 +268,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, &dex.BlockOrders{},
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, &dex.BlockOrders{},
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -308,7 +340,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookHappyPath(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -388,7 +420,7 @@ func TestMatchSingleMarketOrderFromShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -468,7 +500,7 @@ func TestMatchSingleMarketOrderFromLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -566,7 +598,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -706,7 +738,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -870,7 +902,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -1061,7 +1093,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, types.SelfTradePreventionMode_NO_PREVENTION, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
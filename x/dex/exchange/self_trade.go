@@ -0,0 +1,200 @@
+package exchange
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Returns the quantity of the order book entry that can be matched against an
+// order of `account` before running into one of the account's own allocations,
+// along with that allocation. If self-trade prevention is off or the account has
+// no allocation in the entry, the full entry quantity is matchable.
+func matchableBeforeSelfTrade(
+	entry types.OrderBookEntry,
+	account string,
+	mode types.SelfTradePreventionMode,
+) (sdk.Dec, *types.Allocation) {
+	if mode == types.SelfTradePreventionMode_NO_PREVENTION {
+		return entry.GetOrderEntry().Quantity, nil
+	}
+	matchable := sdk.ZeroDec()
+	for _, allocation := range entry.GetOrderEntry().Allocations {
+		if allocation.Account == account {
+			return matchable, allocation
+		}
+		matchable = matchable.Add(allocation.Quantity)
+	}
+	return matchable, nil
+}
+
+// Same as `matchableBeforeSelfTrade` but for crossing a long entry with a short
+// entry, where allocations on both sides are paired up in FIFO order. Returns the
+// first long and short allocations that would be paired against each other while
+// belonging to the same account.
+func matchableBetweenEntriesBeforeSelfTrade(
+	longEntry types.OrderBookEntry,
+	shortEntry types.OrderBookEntry,
+	mode types.SelfTradePreventionMode,
+) (sdk.Dec, *types.Allocation, *types.Allocation) {
+	matchable := sdk.MinDec(longEntry.GetOrderEntry().Quantity, shortEntry.GetOrderEntry().Quantity)
+	if mode == types.SelfTradePreventionMode_NO_PREVENTION {
+		return matchable, nil, nil
+	}
+	longs, shorts := longEntry.GetOrderEntry().Allocations, shortEntry.GetOrderEntry().Allocations
+	matched := sdk.ZeroDec()
+	longPtr, shortPtr := 0, 0
+	longRemaining, shortRemaining := sdk.ZeroDec(), sdk.ZeroDec()
+	if len(longs) > 0 && len(shorts) > 0 {
+		longRemaining, shortRemaining = longs[0].Quantity, shorts[0].Quantity
+	}
+	for longPtr < len(longs) && shortPtr < len(shorts) && matched.LT(matchable) {
+		if longs[longPtr].Account == shorts[shortPtr].Account {
+			return matched, longs[longPtr], shorts[shortPtr]
+		}
+		quantity := sdk.MinDec(longRemaining, shortRemaining)
+		matched = matched.Add(quantity)
+		longRemaining, shortRemaining = longRemaining.Sub(quantity), shortRemaining.Sub(quantity)
+		if longRemaining.IsZero() {
+			longPtr++
+			if longPtr < len(longs) {
+				longRemaining = longs[longPtr].Quantity
+			}
+		}
+		if shortRemaining.IsZero() {
+			shortPtr++
+			if shortPtr < len(shorts) {
+				shortRemaining = shorts[shortPtr].Quantity
+			}
+		}
+	}
+	return sdk.MinDec(matched, matchable), nil, nil
+}
+
+// Returns by how much the newer and the older order should be reduced when they
+// would otherwise trade against each other.
+func selfTradeReductions(
+	mode types.SelfTradePreventionMode,
+	newerQuantity sdk.Dec,
+	olderQuantity sdk.Dec,
+) (sdk.Dec, sdk.Dec) {
+	switch mode {
+	case types.SelfTradePreventionMode_CANCEL_NEWEST:
+		return newerQuantity, sdk.ZeroDec()
+	case types.SelfTradePreventionMode_CANCEL_OLDEST:
+		return sdk.ZeroDec(), olderQuantity
+	case types.SelfTradePreventionMode_CANCEL_BOTH:
+		return newerQuantity, olderQuantity
+	default:
+		prevented := sdk.MinDec(newerQuantity, olderQuantity)
+		return prevented, prevented
+	}
+}
+
+func newSelfTradePrevention(
+	ctx sdk.Context,
+	mode types.SelfTradePreventionMode,
+	account string,
+	priceDenom string,
+	assetDenom string,
+	newerOrderID uint64,
+	newerQuantity sdk.Dec,
+	newerReduction sdk.Dec,
+	olderOrderID uint64,
+	olderQuantity sdk.Dec,
+	olderReduction sdk.Dec,
+	price sdk.Dec,
+) *types.SelfTradePrevention {
+	cancelled := []uint64{}
+	if newerReduction.Equal(newerQuantity) {
+		cancelled = append(cancelled, newerOrderID)
+	}
+	if olderReduction.Equal(olderQuantity) {
+		cancelled = append(cancelled, olderOrderID)
+	}
+	prevention := &types.SelfTradePrevention{
+		Account:           account,
+		PriceDenom:        priceDenom,
+		AssetDenom:        assetDenom,
+		TakerOrderId:      newerOrderID,
+		MakerOrderId:      olderOrderID,
+		Price:             price,
+		Quantity:          sdk.MinDec(newerQuantity, olderQuantity),
+		Mode:              mode,
+		CancelledOrderIds: cancelled,
+		TakerReduction:    newerReduction,
+		MakerReduction:    olderReduction,
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSelfTradePrevention,
+		sdk.NewAttribute(types.AttributeKeyAccount, account),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, priceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, assetDenom),
+		sdk.NewAttribute(types.AttributeKeyTakerOrderID, fmt.Sprint(newerOrderID)),
+		sdk.NewAttribute(types.AttributeKeyMakerOrderID, fmt.Sprint(olderOrderID)),
+		sdk.NewAttribute(types.AttributeKeyQuantity, prevention.Quantity.String()),
+		sdk.NewAttribute(types.AttributeKeySelfTradePreventionMode, mode.String()),
+	))
+	return prevention
+}
+
+// Applies self-trade prevention between an incoming market order and the
+// allocation of the same account at the head of the current order book entry.
+// The incoming order is always considered the newer one. Fill-or-kill orders
+// cannot be partially filled, so they are always the ones cancelled.
+func preventMarketSelfTrade(
+	ctx sdk.Context,
+	mode types.SelfTradePreventionMode,
+	marketOrder *types.Order,
+	remainingQuantity *sdk.Dec,
+	orderBookEntries *types.CachedSortedOrderBookEntries,
+	entryPrice sdk.Dec,
+	allocation *types.Allocation,
+) *types.SelfTradePrevention {
+	effectiveMode := mode
+	if marketOrder.OrderType == types.OrderType_FOKMARKET || marketOrder.OrderType == types.OrderType_FOKMARKETBYVALUE {
+		effectiveMode = types.SelfTradePreventionMode_CANCEL_NEWEST
+	}
+	takerQuantity, makerQuantity := *remainingQuantity, allocation.Quantity
+	takerReduction, makerReduction := selfTradeReductions(effectiveMode, takerQuantity, makerQuantity)
+	prevention := newSelfTradePrevention(
+		ctx, effectiveMode, marketOrder.Account, marketOrder.PriceDenom, marketOrder.AssetDenom,
+		marketOrder.Id, takerQuantity, takerReduction,
+		allocation.OrderId, makerQuantity, makerReduction,
+		entryPrice,
+	)
+	*remainingQuantity = remainingQuantity.Sub(takerReduction)
+	orderBookEntries.SettleQuantity(ctx, makerReduction)
+	return prevention
+}
+
+// Applies self-trade prevention between two allocations of the same account at
+// the heads of a crossing long and short entry. The order with the larger ID is
+// considered the newer one.
+func preventBookSelfTrade(
+	ctx sdk.Context,
+	mode types.SelfTradePreventionMode,
+	orderbook *types.OrderBook,
+	longAllocation *types.Allocation,
+	shortAllocation *types.Allocation,
+	price sdk.Dec,
+) *types.SelfTradePrevention {
+	newer, older := longAllocation, shortAllocation
+	newerBook, olderBook := orderbook.Longs, orderbook.Shorts
+	if shortAllocation.OrderId > longAllocation.OrderId {
+		newer, older = shortAllocation, longAllocation
+		newerBook, olderBook = orderbook.Shorts, orderbook.Longs
+	}
+	newerQuantity, olderQuantity := newer.Quantity, older.Quantity
+	newerReduction, olderReduction := selfTradeReductions(mode, newerQuantity, olderQuantity)
+	prevention := newSelfTradePrevention(
+		ctx, mode, newer.Account, orderbook.Pair.PriceDenom, orderbook.Pair.AssetDenom,
+		newer.OrderId, newerQuantity, newerReduction,
+		older.OrderId, olderQuantity, olderReduction,
+		price,
+	)
+	newerBook.SettleQuantity(ctx, newerReduction)
+	olderBook.SettleQuantity(ctx, olderReduction)
+	return prevention
+}
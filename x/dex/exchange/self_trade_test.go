@@ -0,0 +1,171 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSelfTradeShortBook(t *testing.T, allocations []*types.Allocation) (sdk.Context, *types.OrderBook) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	quantity := sdk.ZeroDec()
+	for _, allocation := range allocations {
+		quantity = quantity.Add(allocation.Quantity)
+	}
+	dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    quantity,
+			Allocations: allocations,
+			PriceDenom:  "USDC",
+			AssetDenom:  "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	return ctx, orderbook
+}
+
+func newSelfTradeMarketOrder(ctx sdk.Context, orderType types.OrderType) *types.Order {
+	order := &types.Order{
+		Id:                3,
+		Account:           "abc",
+		ContractAddr:      "test",
+		Price:             sdk.NewDec(100),
+		Quantity:          sdk.NewDec(8),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         orderType,
+		PositionDirection: types.PositionDirection_LONG,
+	}
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}).Add(order)
+	return order
+}
+
+func TestMatchMarketOrderCancelOldest(t *testing.T) {
+	ctx, orderbook := setupSelfTradeShortBook(t, []*types.Allocation{
+		{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(5)},
+		{OrderId: 2, Account: "def", Quantity: sdk.NewDec(5)},
+	})
+	order := newSelfTradeMarketOrder(ctx, types.OrderType_MARKET)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	outcome := exchange.MatchMarketOrders(
+		ctx, []*types.Order{order}, orderbook.Shorts, types.PositionDirection_LONG, types.SelfTradePreventionMode_CANCEL_OLDEST, blockOrders,
+	)
+	assert.Equal(t, sdk.NewDec(5), outcome.TotalQuantity)
+	assert.Equal(t, 2, len(outcome.Settlements))
+	for _, settlement := range outcome.Settlements {
+		assert.NotEqual(t, uint64(1), settlement.OrderId)
+	}
+	assert.Equal(t, 1, len(outcome.SelfTradePreventions))
+	prevention := outcome.SelfTradePreventions[0]
+	assert.Equal(t, uint64(3), prevention.TakerOrderId)
+	assert.Equal(t, uint64(1), prevention.MakerOrderId)
+	assert.Equal(t, sdk.NewDec(5), prevention.Quantity)
+	assert.Equal(t, []uint64{1}, prevention.CancelledOrderIds)
+	assert.Equal(t, 0, len(orderbook.Shorts.CachedEntries))
+	assert.Equal(t, types.EventTypeSelfTradePrevention, ctx.EventManager().Events()[0].Type)
+}
+
+func TestMatchMarketOrderCancelNewest(t *testing.T) {
+	ctx, orderbook := setupSelfTradeShortBook(t, []*types.Allocation{
+		{OrderId: 1, Account: "def", Quantity: sdk.NewDec(5)},
+		{OrderId: 2, Account: "abc", Quantity: sdk.NewDec(5)},
+	})
+	order := newSelfTradeMarketOrder(ctx, types.OrderType_MARKET)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	outcome := exchange.MatchMarketOrders(
+		ctx, []*types.Order{order}, orderbook.Shorts, types.PositionDirection_LONG, types.SelfTradePreventionMode_CANCEL_NEWEST, blockOrders,
+	)
+	assert.Equal(t, sdk.NewDec(5), outcome.TotalQuantity)
+	assert.Equal(t, 1, len(outcome.SelfTradePreventions))
+	prevention := outcome.SelfTradePreventions[0]
+	assert.Equal(t, sdk.NewDec(3), prevention.Quantity)
+	assert.Equal(t, []uint64{3}, prevention.CancelledOrderIds)
+	assert.Equal(t, sdk.NewDec(3), prevention.TakerReduction)
+	assert.Equal(t, sdk.ZeroDec(), prevention.MakerReduction)
+	// the resting order of the same account stays on the book
+	assert.Equal(t, 1, len(orderbook.Shorts.CachedEntries))
+	assert.Equal(t, sdk.NewDec(5), orderbook.Shorts.CachedEntries[0].GetOrderEntry().Quantity)
+	assert.Equal(t, uint64(2), orderbook.Shorts.CachedEntries[0].GetOrderEntry().Allocations[0].OrderId)
+}
+
+func TestMatchMarketOrderDecrementAndCancel(t *testing.T) {
+	ctx, orderbook := setupSelfTradeShortBook(t, []*types.Allocation{
+		{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(5)},
+	})
+	order := newSelfTradeMarketOrder(ctx, types.OrderType_MARKET)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	outcome := exchange.MatchMarketOrders(
+		ctx, []*types.Order{order}, orderbook.Shorts, types.PositionDirection_LONG, types.SelfTradePreventionMode_DECREMENT_AND_CANCEL, blockOrders,
+	)
+	assert.Equal(t, sdk.ZeroDec(), outcome.TotalQuantity)
+	assert.Equal(t, 0, len(outcome.Settlements))
+	assert.Equal(t, 1, len(outcome.SelfTradePreventions))
+	prevention := outcome.SelfTradePreventions[0]
+	assert.Equal(t, sdk.NewDec(5), prevention.Quantity)
+	assert.Equal(t, []uint64{1}, prevention.CancelledOrderIds)
+	assert.Equal(t, 0, len(orderbook.Shorts.CachedEntries))
+}
+
+func TestMatchFOKMarketOrderSelfTrade(t *testing.T) {
+	ctx, orderbook := setupSelfTradeShortBook(t, []*types.Allocation{
+		{OrderId: 1, Account: "def", Quantity: sdk.NewDec(5)},
+		{OrderId: 2, Account: "abc", Quantity: sdk.NewDec(5)},
+	})
+	order := newSelfTradeMarketOrder(ctx, types.OrderType_FOKMARKET)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	outcome := exchange.MatchMarketOrders(
+		ctx, []*types.Order{order}, orderbook.Shorts, types.PositionDirection_LONG, types.SelfTradePreventionMode_CANCEL_OLDEST, blockOrders,
+	)
+	// fill-or-kill orders are killed instead of cancelling the resting order
+	assert.Equal(t, sdk.ZeroDec(), outcome.TotalQuantity)
+	assert.Equal(t, 0, len(outcome.Settlements))
+	assert.Equal(t, 1, len(outcome.SelfTradePreventions))
+	assert.Equal(t, []uint64{3}, outcome.SelfTradePreventions[0].CancelledOrderIds)
+	// the mode that was actually applied is recorded, not the pair's mode
+	assert.Equal(t, types.SelfTradePreventionMode_CANCEL_NEWEST, outcome.SelfTradePreventions[0].Mode)
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	assert.Equal(t, types.EventTypeSelfTradePrevention, event.Type)
+	assert.Equal(t, types.AttributeKeySelfTradePreventionMode, string(event.Attributes[len(event.Attributes)-1].Key))
+	assert.Equal(t, types.SelfTradePreventionMode_CANCEL_NEWEST.String(), string(event.Attributes[len(event.Attributes)-1].Value))
+	assert.Equal(t, sdk.NewDec(10), orderbook.Shorts.CachedEntries[0].GetOrderEntry().Quantity)
+}
+
+func TestMatchLimitOrdersCancelBoth(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", SelfTradePreventionMode: types.SelfTradePreventionMode_CANCEL_BOTH}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, []*types.Order{
+		newTestOrder(pair, 1, types.PositionDirection_LONG, 100, 5),
+		newTestOrder(pair, 3, types.PositionDirection_LONG, 100, 2, withAccount("ghi")),
+	}, []*types.Order{
+		newTestOrder(pair, 2, types.PositionDirection_SHORT, 100, 2, withAccount("def")),
+		newTestOrder(pair, 4, types.PositionDirection_SHORT, 100, 3),
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	outcome := exchange.MatchLimitOrders(ctx, orderbook)
+	// order 1 is filled by order 2 up to 2, then both remaining orders of "abc" are cancelled
+	assert.Equal(t, sdk.NewDec(4), outcome.TotalQuantity)
+	assert.Equal(t, 2, len(outcome.Settlements))
+	assert.Equal(t, 1, len(outcome.SelfTradePreventions))
+	prevention := outcome.SelfTradePreventions[0]
+	assert.Equal(t, "abc", prevention.Account)
+	assert.Equal(t, uint64(4), prevention.TakerOrderId)
+	assert.Equal(t, uint64(1), prevention.MakerOrderId)
+	assert.Equal(t, sdk.NewDec(3), prevention.Quantity)
+	assert.Equal(t, []uint64{4, 1}, prevention.CancelledOrderIds)
+	_, found := dexkeeper.GetLongBookByPrice(ctx, "test", sdk.NewDec(100), "USDC", "ATOM")
+	assert.True(t, found)
+	_, found = dexkeeper.GetShortBookByPrice(ctx, "test", sdk.NewDec(100), "USDC", "ATOM")
+	assert.False(t, found)
+}
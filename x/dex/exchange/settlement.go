@@ -3,6 +3,7 @@ package exchange
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
@@ -15,13 +16,14 @@ func Settle(
 	worstPrice sdk.Dec,
 	makerPrice sdk.Dec,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
-	// settlement of one liquidity taker's order is allocated on a FIFO basis
+	// settlement of one liquidity taker's order is allocated according to the
+	// pair's matching algorithm (FIFO by default)
 	takerSettlements := []*types.SettlementEntry{}
 	makerSettlements := []*types.SettlementEntry{}
 	if quantityTaken.IsZero() {
 		return takerSettlements, makerSettlements
 	}
-	newToSettle, _ := orderbook.SettleQuantity(ctx, quantityTaken)
+	newToSettle, _ := orderbook.AllocateQuantity(ctx, quantityTaken)
 	for _, toSettle := range newToSettle {
 		takerSettlements = append(takerSettlements, types.NewSettlementEntry(
 			ctx,
@@ -75,13 +77,14 @@ func SettleFromBook(
 	longPrice sdk.Dec,
 	shortPrice sdk.Dec,
 ) []*types.SettlementEntry {
-	// settlement from within the order book is also allocated on a FIFO basis
+	// settlement from within the order book is allocated according to the pair's
+	// matching algorithm as well
 	settlements := []*types.SettlementEntry{}
 	if executedQuantity.IsZero() {
 		return settlements
 	}
-	newLongToSettle, _ := orderbook.Longs.SettleQuantity(ctx, executedQuantity)
-	newShortToSettle, _ := orderbook.Shorts.SettleQuantity(ctx, executedQuantity)
+	newLongToSettle, _ := orderbook.Longs.AllocateQuantity(ctx, executedQuantity)
+	newShortToSettle, _ := orderbook.Shorts.AllocateQuantity(ctx, executedQuantity)
 	avgPrice := longPrice.Add(shortPrice).Quo(sdk.NewDec(2))
 	longPtr, shortPtr := 0, 0
 	for longPtr < len(newLongToSettle) && shortPtr < len(newShortToSettle) {
@@ -127,3 +130,30 @@ func SettleFromBook(
 	}
 	return settlements
 }
+
+// Removes orders that no longer rest on the book after matching from the per-account
+// open-order index and from the expiry queue. Only orders that were settled or cancelled
+// by self-trade prevention during matching can have left the book.
+func RemoveClosedOrders(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contract types.ContractAddress,
+	pair types.Pair,
+	outcome ExecutionOutcome,
+) {
+	removeIfClosed := func(account string, orderID uint64) {
+		order, found := keeper.GetAccountOrder(ctx, string(contract), account, pair.PriceDenom, pair.AssetDenom, orderID)
+		if found && !keeper.IsOrderResting(ctx, string(contract), order) {
+			keeper.RemoveAccountOrder(ctx, string(contract), account, pair.PriceDenom, pair.AssetDenom, orderID)
+			keeper.RemoveOrderFromExpiryQueue(ctx, string(contract), order)
+		}
+	}
+	for _, settlement := range outcome.Settlements {
+		removeIfClosed(settlement.Account, settlement.OrderId)
+	}
+	for _, prevention := range outcome.SelfTradePreventions {
+		for _, orderID := range prevention.CancelledOrderIds {
+			removeIfClosed(prevention.Account, orderID)
+		}
+	}
+}
@@ -22,6 +22,13 @@ func FuzzSettleMarketOrder(f *testing.F) {
 	f.Fuzz(fuzzTargetMatchMarketOrders)
 }
 
+func FuzzSettleMarketOrderWithMatchingAlgorithm(f *testing.F) {
+	f.Add(true, []byte{1, 2, 3}, []byte{5, 9, 2}, []byte{10, 20, 30}, []byte{0, 1, 2, 3, 4, 5}, []byte{1, 2, 3, 4, 5, 6}, int64(0), false, int64(7), false, true, int64(2), false)
+	f.Add(false, []byte{1, 2}, []byte{50, 50}, []byte{100, 3}, []byte{7, 3, 1, 9}, []byte{33, 1, 1, 65}, int64(0), false, int64(40), false, true, int64(0), true)
+	f.Add(true, []byte{1}, []byte{5}, []byte{10}, []byte{1, 2}, []byte{1, 1}, int64(0), false, int64(3), false, false, int64(0), false)
+	f.Fuzz(fuzzTargetSettle)
+}
+
 func fuzzTargetSettle(
 	t *testing.T,
 	long bool,
@@ -34,6 +41,9 @@ func fuzzTargetSettle(
 	priceIsNil bool,
 	quantityI int64,
 	quantityIsNil bool,
+	proRata bool,
+	minAllocationI int64,
+	largestRemainder bool,
 ) {
 	dexkeeper, ctx := keepertest.DexKeeper(t)
 	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
@@ -62,16 +72,63 @@ func fuzzTargetSettle(
 		orders = orders[:len(entries)]
 	}
 
-	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+	pair := fuzzMatchingAlgorithmPair(proRata, minAllocationI, largestRemainder)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
 	book := orderbook.Longs
 	if long {
 		book = orderbook.Shorts
 	}
-	for i, entry := range entries {
+	for i := range entries {
+		entry := book.Next(ctx)
+		if entry == nil {
+			break
+		}
+		allocated := totalAllocated(entry)
+		var makerSettlements []*types.SettlementEntry
 		require.NotPanics(t, func() {
-			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice())
+			_, makerSettlements = exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice())
 		})
+		if quantity.IsPositive() {
+			requireSettledWithinLevel(t, makerSettlements, allocated, entry)
+		}
+	}
+}
+
+func fuzzMatchingAlgorithmPair(proRata bool, minAllocationI int64, largestRemainder bool) types.Pair {
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	if !proRata {
+		return pair
+	}
+	minAllocation := sdk.NewDec(minAllocationI).Abs()
+	pair.MatchingAlgorithm = types.MatchingAlgorithm_PRO_RATA
+	pair.ProRataMinAllocation = &minAllocation
+	if largestRemainder {
+		pair.ProRataRemainderRule = types.ProRataRemainderRule_REMAINDER_LARGEST_ALLOCATION
+	}
+	return pair
+}
+
+// Checks that settling against a single price level neither allocates more than
+// the level's allocations hold nor leaves any allocation with a negative quantity,
+// whatever the matching algorithm.
+func requireSettledWithinLevel(t *testing.T, settlements []*types.SettlementEntry, allocated sdk.Dec, entry types.OrderBookEntry) {
+	settled := sdk.ZeroDec()
+	for _, settlement := range settlements {
+		require.False(t, settlement.Quantity.IsNegative())
+		settled = settled.Add(settlement.Quantity)
+	}
+	require.True(t, settled.LTE(allocated))
+	for _, allocation := range entry.GetOrderEntry().Allocations {
+		require.False(t, allocation.Quantity.IsNegative())
+	}
+}
+
+func totalAllocated(entry types.OrderBookEntry) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, allocation := range entry.GetOrderEntry().Allocations {
+		total = total.Add(allocation.Quantity)
 	}
+	return total
 }
 
 func FuzzSettleLimitOrder(f *testing.F) {
@@ -79,6 +136,13 @@ func FuzzSettleLimitOrder(f *testing.F) {
 	f.Fuzz(fuzzTargetMatchMarketOrders)
 }
 
+func FuzzSettleLimitOrderWithMatchingAlgorithm(f *testing.F) {
+	f.Add([]byte{5, 9, 2}, []byte{7, 3}, []byte{0, 1, 2, 3}, []byte{4, 5, 6}, []byte{1, 2, 3, 4}, []byte{9, 9, 1}, int64(4), false, true, int64(1), false)
+	f.Add([]byte{50, 50}, []byte{100}, []byte{7, 3, 1, 9}, []byte{1, 2}, []byte{33, 1, 1, 65}, []byte{2, 3}, int64(30), false, true, int64(0), true)
+	f.Add([]byte{5}, []byte{5}, []byte{1}, []byte{2}, []byte{1}, []byte{1}, int64(5), false, false, int64(0), false)
+	f.Fuzz(fuzzTargetSettleFromBook)
+}
+
 func fuzzTargetSettleFromBook(
 	t *testing.T,
 	buyEntryWeights []byte,
@@ -89,6 +153,9 @@ func fuzzTargetSettleFromBook(
 	sellAllocationWeights []byte,
 	quantityI int64,
 	quantityIsNil bool,
+	proRata bool,
+	minAllocationI int64,
+	largestRemainder bool,
 ) {
 	dexkeeper, ctx := keepertest.DexKeeper(t)
 	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
@@ -109,10 +176,31 @@ func fuzzTargetSettleFromBook(
 		sellEntries = sellEntries[:len(buyEntries)]
 	}
 
-	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
-	for i, longEntry := range buyEntries {
+	pair := fuzzMatchingAlgorithmPair(proRata, minAllocationI, largestRemainder)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	for range buyEntries {
+		longEntry, shortEntry := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx)
+		if longEntry == nil || shortEntry == nil {
+			break
+		}
+		executed := sdk.MinDec(quantity, sdk.MinDec(longEntry.GetOrderEntry().Quantity, shortEntry.GetOrderEntry().Quantity))
+		longAllocated, shortAllocated := totalAllocated(longEntry), totalAllocated(shortEntry)
+		var settlements []*types.SettlementEntry
 		require.NotPanics(t, func() {
-			exchange.SettleFromBook(ctx, orderbook, quantity, longEntry.GetPrice(), sellEntries[i].GetPrice())
+			settlements = exchange.SettleFromBook(ctx, orderbook, executed, longEntry.GetPrice(), shortEntry.GetPrice())
 		})
+		if !executed.IsPositive() {
+			continue
+		}
+		longs, shorts := []*types.SettlementEntry{}, []*types.SettlementEntry{}
+		for _, settlement := range settlements {
+			if settlement.PositionDirection == types.GetContractPositionDirection(types.PositionDirection_LONG) {
+				longs = append(longs, settlement)
+			} else {
+				shorts = append(shorts, settlement)
+			}
+		}
+		requireSettledWithinLevel(t, longs, longAllocated, longEntry)
+		requireSettledWithinLevel(t, shorts, shortAllocated, shortEntry)
 	}
 }
@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Builds the trades of a market order from the maker settlements produced while
+// matching it. Each maker settlement is a fill against the market order.
+func tradesFromMakerSettlements(
+	takerOrder *types.Order,
+	makerSettlements []*types.SettlementEntry,
+) []*types.Trade {
+	trades := []*types.Trade{}
+	for _, maker := range makerSettlements {
+		trades = append(trades, &types.Trade{
+			PriceDenom:     takerOrder.PriceDenom,
+			AssetDenom:     takerOrder.AssetDenom,
+			Height:         maker.Height,
+			Timestamp:      maker.Timestamp,
+			TakerOrderId:   takerOrder.Id,
+			TakerAccount:   takerOrder.Account,
+			MakerOrderId:   maker.OrderId,
+			MakerAccount:   maker.Account,
+			TakerDirection: takerOrder.PositionDirection,
+			Price:          maker.ExecutionCostOrProceed,
+			Quantity:       maker.Quantity,
+		})
+	}
+	return trades
+}
+
+// Builds the trades of limit orders crossing within the order book from the
+// settlements returned by `SettleFromBook`, which come in (long, short) pairs.
+// The order with the larger ID is considered the taker.
+func tradesFromBookSettlements(settlements []*types.SettlementEntry) []*types.Trade {
+	trades := []*types.Trade{}
+	for i := 0; i+1 < len(settlements); i += 2 {
+		taker, maker := settlements[i], settlements[i+1]
+		takerDirection := types.PositionDirection_LONG
+		if maker.OrderId > taker.OrderId {
+			taker, maker = maker, taker
+			takerDirection = types.PositionDirection_SHORT
+		}
+		trades = append(trades, &types.Trade{
+			PriceDenom:     taker.PriceDenom,
+			AssetDenom:     taker.AssetDenom,
+			Height:         taker.Height,
+			Timestamp:      taker.Timestamp,
+			TakerOrderId:   taker.OrderId,
+			TakerAccount:   taker.Account,
+			MakerOrderId:   maker.OrderId,
+			MakerAccount:   maker.Account,
+			TakerDirection: takerDirection,
+			Price:          taker.ExecutionCostOrProceed,
+			Quantity:       taker.Quantity,
+		})
+	}
+	return trades
+}
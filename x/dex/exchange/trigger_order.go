@@ -0,0 +1,70 @@
+package exchange
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func IsTriggerOrder(order *types.Order) bool {
+	return order.OrderType == types.OrderType_STOPLOSS || order.OrderType == types.OrderType_STOPLIMIT
+}
+
+// Injects orders triggered in previous blocks into the current block's orders so
+// that they participate in this matching round. STOPLOSS orders are matched as
+// market orders and STOPLIMIT orders as limit orders.
+func InjectTriggeredOrders(
+	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress, pair types.Pair,
+	blockOrders *cache.BlockOrders,
+) {
+	for _, order := range keeper.GetAllTriggeredOrdersForPair(ctx, string(contract), pair.PriceDenom, pair.AssetDenom) {
+		order := order
+		blockOrders.Add(&order)
+		keeper.RemoveTriggeredOrder(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, order.Id)
+	}
+}
+
+// Moves newly placed stop orders into the trigger book where they wait until
+// the pair's price crosses their trigger price.
+func MoveToTriggerBook(
+	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress,
+	blockOrders *cache.BlockOrders,
+) {
+	for _, order := range blockOrders.Get() {
+		if !IsTriggerOrder(order) || order.TriggerStatus || order.Status == types.OrderStatus_FAILED_TO_PLACE {
+			continue
+		}
+		keeper.SetTriggerOrder(ctx, string(contract), *order)
+	}
+}
+
+// Triggers all stop orders of the pair whose trigger price has been crossed by
+// `price`. A LONG stop order triggers when the price rises to or above its
+// trigger price and a SHORT one when the price falls to or below it.
+func TriggerOrders(
+	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress, pair types.Pair,
+	price sdk.Dec,
+) {
+	events := []sdk.Event{}
+	for _, order := range keeper.GetTriggerOrdersCrossedByPrice(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, price) {
+		keeper.RemoveTriggerOrder(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, order.Id)
+		if order.OrderType == types.OrderType_STOPLOSS {
+			order.OrderType = types.OrderType_MARKET
+		} else {
+			order.OrderType = types.OrderType_LIMIT
+		}
+		order.TriggerStatus = true
+		order.Status = types.OrderStatus_PLACED
+		keeper.SetTriggeredOrder(ctx, string(contract), order)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeTriggerOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(order.Id)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, string(contract)),
+			sdk.NewAttribute(types.AttributeKeyTriggerPrice, order.TriggerPrice.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+}
@@ -26,11 +26,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		for _, elem := range contractState.LongBookList {
 			k.SetLongBook(ctx, contractState.ContractInfo.ContractAddr, elem)
+			if err := k.SetOrderCount(
+				ctx, contractState.ContractInfo.ContractAddr, elem.Entry.PriceDenom, elem.Entry.AssetDenom,
+				types.PositionDirection_LONG, elem.Price, uint64(len(elem.Entry.Allocations)),
+			); err != nil {
+				panic(err)
+			}
 		}
 
 		for _, elem := range contractState.ShortBookList {
 			k.SetShortBook(ctx, contractState.ContractInfo.ContractAddr, elem)
+			if err := k.SetOrderCount(
+				ctx, contractState.ContractInfo.ContractAddr, elem.Entry.PriceDenom, elem.Entry.AssetDenom,
+				types.PositionDirection_SHORT, elem.Price, uint64(len(elem.Entry.Allocations)),
+			); err != nil {
+				panic(err)
+			}
 		}
+		k.IndexAllRestingOrdersForContract(ctx, contractState.ContractInfo.ContractAddr)
 
 		for _, elem := range contractState.PriceList {
 			for _, priceElem := range elem.Prices {
@@ -40,6 +53,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		k.SetNextOrderID(ctx, contractState.ContractInfo.ContractAddr, contractState.NextOrderId)
 
+		if contractState.RentTopUp != nil {
+			k.SetRentTopUp(ctx, *contractState.RentTopUp)
+		}
+
 	}
 
 	// this line is used by starport scaffolding # genesis/module/init
@@ -75,6 +92,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 			PriceList:     contractPrices,
 			NextOrderId:   k.GetNextOrderID(ctx, contractAddr),
 		}
+		if rentTopUp, found := k.GetRentTopUp(ctx, contractAddr); found {
+			contractStates[i].RentTopUp = &rentTopUp
+		}
 	}
 	genesis.ContractState = contractStates
 
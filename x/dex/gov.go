@@ -12,3 +12,15 @@ func HandleAddAssetMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 	}
 	return nil
 }
+
+func HandleUpdatePairMatchingAlgorithmProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdatePairMatchingAlgorithmProposal) error {
+	return k.SetMatchingAlgorithmForPair(ctx, p.ContractAddr, p.Pair)
+}
+
+func HandleHaltPairProposal(ctx sdk.Context, k *keeper.Keeper, p *types.HaltPairProposal) error {
+	return k.SetPairHalted(ctx, p.ContractAddr, p.PriceDenom, p.AssetDenom, !p.Resume)
+}
+
+func HandleUpdatePairMatchingModeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdatePairMatchingModeProposal) error {
+	return k.SetMatchingModeForPair(ctx, p.ContractAddr, p.PriceDenom, p.AssetDenom, p.MatchingMode)
+}
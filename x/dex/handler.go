@@ -47,6 +47,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnsuspendContract:
 			res, err := msgServer.UnsuspendContract(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgHaltPair:
+			res, err := msgServer.HaltPair(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgResumePair:
+			res, err := msgServer.ResumePair(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetRentTopUp:
+			res, err := msgServer.SetRentTopUp(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevokeRentTopUp:
+			res, err := msgServer.RevokeRentTopUp(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceRoutedOrder:
+			res, err := msgServer.PlaceRoutedOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -60,6 +75,12 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddAssetMetadataProposal:
 			return HandleAddAssetMetadataProposal(ctx, &k, c)
+		case *types.UpdatePairMatchingAlgorithmProposal:
+			return HandleUpdatePairMatchingAlgorithmProposal(ctx, &k, c)
+		case *types.HaltPairProposal:
+			return HandleHaltPairProposal(ctx, &k, c)
+		case *types.UpdatePairMatchingModeProposal:
+			return HandleUpdatePairMatchingModeProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
 		}
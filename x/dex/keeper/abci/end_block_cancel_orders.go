@@ -17,11 +17,12 @@ func (w KeeperWrapper) HandleEBCancelOrders(ctx context.Context, sdkCtx sdk.Cont
 	span.SetAttributes(attribute.String("contractAddr", contractAddr))
 
 	typedContractAddr := types.ContractAddress(contractAddr)
-	msg := w.getCancelSudoMsg(sdkCtx, typedContractAddr, registeredPairs)
-	if len(msg.OrderCancellations.IdsToCancel) == 0 {
+	msg := w.GetCancelSudoMsg(sdkCtx, typedContractAddr, registeredPairs)
+	numCancels := len(msg.OrderCancellations.IdsToCancel) + len(msg.OrderCancellations.PartialCancellations)
+	if numCancels == 0 {
 		return nil
 	}
-	userProvidedGas := w.GetParams(sdkCtx).DefaultGasPerCancel * uint64(len(msg.OrderCancellations.IdsToCancel))
+	userProvidedGas := w.GetParams(sdkCtx).DefaultGasPerCancel * uint64(numCancels)
 	if _, err := utils.CallContractSudo(sdkCtx, w.Keeper, contractAddr, msg, userProvidedGas); err != nil {
 		sdkCtx.Logger().Error(fmt.Sprintf("Error during cancellation: %s", err.Error()))
 		return err
@@ -30,16 +31,22 @@ func (w KeeperWrapper) HandleEBCancelOrders(ctx context.Context, sdkCtx sdk.Cont
 	return nil
 }
 
-func (w KeeperWrapper) getCancelSudoMsg(sdkCtx sdk.Context, typedContractAddr types.ContractAddress, registeredPairs []types.Pair) types.SudoOrderCancellationMsg {
+func (w KeeperWrapper) GetCancelSudoMsg(sdkCtx sdk.Context, typedContractAddr types.ContractAddress, registeredPairs []types.Pair) types.SudoOrderCancellationMsg {
 	idsToCancel := []uint64{}
+	partialCancellations := []types.PartialCancellation{}
 	for _, pair := range registeredPairs {
 		for _, cancel := range dexutils.GetMemState(sdkCtx.Context()).GetBlockCancels(sdkCtx, typedContractAddr, pair).Get() {
+			if cancel.IsPartial() {
+				partialCancellations = append(partialCancellations, types.PartialCancellation{ID: cancel.Id, Quantity: cancel.Quantity})
+				continue
+			}
 			idsToCancel = append(idsToCancel, cancel.Id)
 		}
 	}
 	return types.SudoOrderCancellationMsg{
 		OrderCancellations: types.OrderCancellationMsgDetails{
-			IdsToCancel: idsToCancel,
+			IdsToCancel:          idsToCancel,
+			PartialCancellations: partialCancellations,
 		},
 	}
 }
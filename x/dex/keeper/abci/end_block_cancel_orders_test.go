@@ -0,0 +1,24 @@
+package abci_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCancelSudoMsg(t *testing.T) {
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	keeper, ctx := keepertest.DexKeeper(t)
+	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, pair)
+	cancels.Add(&types.Cancellation{Id: 1, Initiator: types.CancellationInitiator_USER})
+	cancels.Add(&types.Cancellation{Id: 2, Initiator: types.CancellationInitiator_SELF_TRADE_PREVENTED, Quantity: sdk.NewDec(3)})
+	wrapper := abci.KeeperWrapper{Keeper: keeper}
+	msg := wrapper.GetCancelSudoMsg(ctx, keepertest.TestContract, []types.Pair{pair})
+	require.Equal(t, []uint64{1}, msg.OrderCancellations.IdsToCancel)
+	require.Equal(t, []types.PartialCancellation{{ID: 2, Quantity: sdk.NewDec(3)}}, msg.OrderCancellations.PartialCancellations)
+}
@@ -56,3 +56,28 @@ func (w KeeperWrapper) GetDepositSudoMsg(ctx sdk.Context, typedContractAddr type
 		},
 	}
 }
+
+// HandleEBRoutedHopDeposits credits a contract with the output of the previous hops of
+// routed orders that are about to place an order on it. Unlike regular deposits, the
+// funds are only moved to the contract once all contracts have been run, since the
+// output is held by the contract of the previous hop until then.
+func (w KeeperWrapper) HandleEBRoutedHopDeposits(ctx context.Context, sdkCtx sdk.Context, tracer *otrace.Tracer, contractAddr string, deposits []*types.DepositInfoEntry) error {
+	_, span := (*tracer).Start(ctx, "SudoRoutedHopDeposit")
+	span.SetAttributes(attribute.String("contractAddr", contractAddr))
+	defer span.End()
+
+	if len(deposits) == 0 {
+		return nil
+	}
+	msg := types.SudoOrderPlacementMsg{
+		OrderPlacements: types.OrderPlacementMsgDetails{
+			Orders:   []types.Order{},
+			Deposits: seiutils.Map(deposits, func(d *types.DepositInfoEntry) types.ContractDepositInfo { return d.ToContractDepositInfo() }),
+		},
+	}
+	if _, err := utils.CallContractSudo(sdkCtx, w.Keeper, contractAddr, msg, dexutils.ZeroUserProvidedGas); err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error during routed hop deposit: %s", err.Error()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"go.opentelemetry.io/otel/attribute"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+// HandleEBRestoreAmendedOrders places orders amended to a new price back with the
+// contract if the contract failed to place their replacement. Orders the contract
+// accepts again have their cancellation dropped so that they stay on the book with
+// their original allocation and time priority.
+func (w KeeperWrapper) HandleEBRestoreAmendedOrders(ctx context.Context, sdkCtx sdk.Context, tracer *otrace.Tracer, contractAddr string, registeredPairs []types.Pair) error {
+	_, span := (*tracer).Start(ctx, "SudoRestoreAmendedOrders")
+	span.SetAttributes(attribute.String("contractAddr", contractAddr))
+	defer span.End()
+
+	typedContractAddr := types.ContractAddress(contractAddr)
+	originals, pairs := w.getOrdersToRestore(sdkCtx, typedContractAddr, registeredPairs)
+	if len(originals) == 0 {
+		return nil
+	}
+	msg := types.SudoOrderPlacementMsg{
+		OrderPlacements: types.OrderPlacementMsgDetails{
+			Orders:   originals,
+			Deposits: []types.ContractDepositInfo{},
+		},
+	}
+	userProvidedGas := w.GetParams(sdkCtx).DefaultGasPerOrder * uint64(len(originals))
+	data, err := utils.CallContractSudo(sdkCtx, w.Keeper, contractAddr, msg, userProvidedGas)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error during amended order restoration: %s", err.Error()))
+		return err
+	}
+	response := types.SudoOrderPlacementResponse{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		sdkCtx.Logger().Error("Failed to parse amended order restoration response")
+		return err
+	}
+	failed := map[uint64]struct{}{}
+	for _, unsuccessfulOrder := range response.UnsuccessfulOrders {
+		failed[unsuccessfulOrder.ID] = struct{}{}
+	}
+	memState := dexutils.GetMemState(sdkCtx.Context())
+	for i, original := range originals {
+		if _, ok := failed[original.Id]; ok {
+			sdkCtx.Logger().Info(fmt.Sprintf("%s failed to restore amended order %d", contractAddr, original.Id))
+			continue
+		}
+		memState.GetBlockCancels(sdkCtx, typedContractAddr, pairs[i]).Delete(original.Id)
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRestoreOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(original.Id)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		))
+	}
+	return nil
+}
+
+// Returns the resting orders whose replacement failed to be placed, as they currently
+// are on the book, along with the pair each of them belongs to.
+func (w KeeperWrapper) getOrdersToRestore(sdkCtx sdk.Context, typedContractAddr types.ContractAddress, registeredPairs []types.Pair) ([]types.Order, []types.Pair) {
+	originals := []types.Order{}
+	pairs := []types.Pair{}
+	memState := dexutils.GetMemState(sdkCtx.Context())
+	for _, pair := range registeredPairs {
+		failedToPlace := map[uint64]struct{}{}
+		for _, order := range memState.GetBlockOrders(sdkCtx, typedContractAddr, pair).Get() {
+			if order.Status == types.OrderStatus_FAILED_TO_PLACE {
+				failedToPlace[order.Id] = struct{}{}
+			}
+		}
+		if len(failedToPlace) == 0 {
+			continue
+		}
+		for _, amendment := range memState.GetBlockAmendments(sdkCtx, typedContractAddr, pair).Get() {
+			if _, ok := failedToPlace[amendment.NewOrderId]; amendment.NewOrderId == 0 || !ok {
+				continue
+			}
+			if original, found := w.getRestingOrder(sdkCtx, string(typedContractAddr), amendment); found {
+				originals = append(originals, original)
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	return originals, pairs
+}
+
+func (w KeeperWrapper) getRestingOrder(sdkCtx sdk.Context, contractAddr string, amendment *types.Amendment) (types.Order, bool) {
+	var allocation *types.Allocation
+	var found bool
+	if amendment.PositionDirection == types.PositionDirection_LONG {
+		allocation, found = w.GetLongAllocationForOrderID(sdkCtx, contractAddr, amendment.PriceDenom, amendment.AssetDenom, amendment.Price, amendment.Id)
+	} else {
+		allocation, found = w.GetShortAllocationForOrderID(sdkCtx, contractAddr, amendment.PriceDenom, amendment.AssetDenom, amendment.Price, amendment.Id)
+	}
+	if !found {
+		return types.Order{}, false
+	}
+	order := types.Order{
+		OrderType:    types.OrderType_LIMIT,
+		Nominal:      sdk.ZeroDec(),
+		TriggerPrice: sdk.ZeroDec(),
+	}
+	if indexed, found := w.GetAccountOrder(sdkCtx, contractAddr, allocation.Account, amendment.PriceDenom, amendment.AssetDenom, amendment.Id); found {
+		order = indexed
+	}
+	order.Id = amendment.Id
+	order.Status = types.OrderStatus_PLACED
+	order.Account = allocation.Account
+	order.ContractAddr = contractAddr
+	order.PriceDenom = amendment.PriceDenom
+	order.AssetDenom = amendment.AssetDenom
+	order.PositionDirection = amendment.PositionDirection
+	order.Price = amendment.Price
+	order.Quantity = allocation.Quantity
+	return order, true
+}
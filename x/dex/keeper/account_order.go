@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetAccountOrder adds a resting order to the per-account open-order index
+func (k Keeper) SetAccountOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderPairPrefix(contractAddr, order.Account, order.PriceDenom, order.AssetDenom))
+	store.Set(GetKeyForOrderID(order.Id), k.Cdc.MustMarshal(&order))
+}
+
+func (k Keeper) GetAccountOrder(ctx sdk.Context, contractAddr string, account string, priceDenom string, assetDenom string, orderID uint64) (val types.Order, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderPairPrefix(contractAddr, account, priceDenom, assetDenom))
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveAccountOrder(ctx sdk.Context, contractAddr string, account string, priceDenom string, assetDenom string, orderID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderPairPrefix(contractAddr, account, priceDenom, assetDenom))
+	store.Delete(GetKeyForOrderID(orderID))
+}
+
+func (k Keeper) GetAccountOrdersPaginated(ctx sdk.Context, contractAddr string, account string, page *query.PageRequest) ([]*types.Order, *query.PageResponse, error) {
+	return k.getAllOrdersForPrefixPaginated(ctx, types.AccountOrderPrefix(contractAddr, account), page)
+}
+
+func (k Keeper) RemoveAllAccountOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.AccountOrderKey, contractAddr))
+}
+
+// IndexAllRestingOrdersForContract rebuilds the per-account open-order index from
+// the allocations currently resting on the contract's order books.
+func (k Keeper) IndexAllRestingOrdersForContract(ctx sdk.Context, contractAddr string) {
+	for _, longBook := range k.GetAllLongBook(ctx, contractAddr) {
+		longBook := longBook
+		k.indexAllocations(ctx, contractAddr, &longBook, types.PositionDirection_LONG)
+	}
+	for _, shortBook := range k.GetAllShortBook(ctx, contractAddr) {
+		shortBook := shortBook
+		k.indexAllocations(ctx, contractAddr, &shortBook, types.PositionDirection_SHORT)
+	}
+}
+
+func (k Keeper) indexAllocations(ctx sdk.Context, contractAddr string, entry types.OrderBookEntry, direction types.PositionDirection) {
+	for _, allocation := range entry.GetOrderEntry().Allocations {
+		k.SetAccountOrder(ctx, contractAddr, types.Order{
+			Id:                allocation.OrderId,
+			Account:           allocation.Account,
+			ContractAddr:      contractAddr,
+			Price:             entry.GetPrice(),
+			Quantity:          allocation.Quantity,
+			PriceDenom:        entry.GetOrderEntry().PriceDenom,
+			AssetDenom:        entry.GetOrderEntry().AssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			Status:            types.OrderStatus_PLACED,
+			PositionDirection: direction,
+		})
+	}
+}
+
+// IsOrderResting returns true if the order still has an allocation on the order book
+func (k Keeper) IsOrderResting(ctx sdk.Context, contractAddr string, order types.Order) bool {
+	var found bool
+	if order.PositionDirection == types.PositionDirection_LONG {
+		_, found = k.GetLongAllocationForOrderID(ctx, contractAddr, order.PriceDenom, order.AssetDenom, order.Price, order.Id)
+	} else {
+		_, found = k.GetShortAllocationForOrderID(ctx, contractAddr, order.PriceDenom, order.AssetDenom, order.Price, order.Id)
+	}
+	return found
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Builds a long limit order of the test account on the test pair. Fields that a
+// test cares about are set through order options.
+func createOrder(id uint64, opts ...func(*types.Order)) types.Order {
+	order := types.Order{
+		Id:                id,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(10),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		Nominal:           sdk.ZeroDec(),
+		TriggerPrice:      sdk.ZeroDec(),
+	}
+	for _, opt := range opts {
+		opt(&order)
+	}
+	return order
+}
+
+func withAccount(account string) func(*types.Order) {
+	return func(order *types.Order) {
+		order.Account = account
+	}
+}
+
+func withPriceDenom(priceDenom string) func(*types.Order) {
+	return func(order *types.Order) {
+		order.PriceDenom = priceDenom
+	}
+}
+
+func withStopLimit(triggerPrice int64) func(*types.Order) {
+	return func(order *types.Order) {
+		order.OrderType = types.OrderType_STOPLIMIT
+		order.TriggerPrice = sdk.NewDec(triggerPrice)
+	}
+}
+
+func withExpiry(expiryHeight uint64, expiryTimestamp uint64) func(*types.Order) {
+	return func(order *types.Order) {
+		order.ExpiryHeight = expiryHeight
+		order.ExpiryTimestamp = expiryTimestamp
+	}
+}
+
+func TestAccountOrderGetSetRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := createOrder(1)
+	keeper.SetAccountOrder(ctx, keepertest.TestContract, order)
+	got, found := keeper.GetAccountOrder(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.True(t, found)
+	require.Equal(t, order, got)
+	_, found = keeper.GetAccountOrder(ctx, keepertest.TestContract, "other", keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.False(t, found)
+
+	keeper.RemoveAccountOrder(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	_, found = keeper.GetAccountOrder(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.False(t, found)
+}
+
+func TestGetAccountOrdersPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetAccountOrder(ctx, keepertest.TestContract, createOrder(1))
+	keeper.SetAccountOrder(ctx, keepertest.TestContract, createOrder(2, withPriceDenom("uusdt")))
+	keeper.SetAccountOrder(ctx, keepertest.TestContract, createOrder(3))
+	keeper.SetAccountOrder(ctx, keepertest.TestContract, createOrder(4, withAccount("other")))
+
+	orders, pageRes, err := keeper.GetAccountOrdersPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, uint64(3), pageRes.Total)
+	next, _, err := keeper.GetAccountOrdersPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(next))
+	ids := []uint64{orders[0].Id, orders[1].Id, next[0].Id}
+	require.ElementsMatch(t, []uint64{1, 2, 3}, ids)
+
+	keeper.RemoveAllAccountOrdersForContract(ctx, keepertest.TestContract)
+	orders, _, err = keeper.GetAccountOrdersPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, nil)
+	require.NoError(t, err)
+	require.Empty(t, orders)
+}
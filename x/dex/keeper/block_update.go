@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// MaxBufferedBlockUpdateHeights is the number of most recent blocks whose order book
+// updates are kept for streaming, which bounds how far back a stream can start.
+const MaxBufferedBlockUpdateHeights = 1000
+
+// BlockUpdateBuffer keeps the order book updates of the most recent blocks in memory.
+// The updates are not part of consensus state, so a node can only stream the blocks it
+// executed itself since it started.
+type BlockUpdateBuffer struct {
+	mtx        *sync.RWMutex
+	maxHeights int
+	heights    []int64
+	updates    map[int64]map[string][]*types.PairBlockUpdate
+}
+
+func NewBlockUpdateBuffer(maxHeights int) *BlockUpdateBuffer {
+	return &BlockUpdateBuffer{
+		mtx:        &sync.RWMutex{},
+		maxHeights: maxHeights,
+		updates:    map[int64]map[string][]*types.PairBlockUpdate{},
+	}
+}
+
+// Add buffers the pair updates of each contract at the given height, evicting the
+// oldest height once the buffer is full.
+func (b *BlockUpdateBuffer) Add(height int64, contractToUpdates map[string][]*types.PairBlockUpdate) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if _, ok := b.updates[height]; !ok {
+		b.heights = append(b.heights, height)
+	}
+	b.updates[height] = contractToUpdates
+	for len(b.heights) > b.maxHeights {
+		delete(b.updates, b.heights[0])
+		b.heights = b.heights[1:]
+	}
+}
+
+// Get returns the pair updates of a contract at the given height, and false if the
+// height is not buffered.
+func (b *BlockUpdateBuffer) Get(height int64, contractAddr string) ([]*types.PairBlockUpdate, bool) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	contractToUpdates, ok := b.updates[height]
+	if !ok {
+		return nil, false
+	}
+	return contractToUpdates[contractAddr], true
+}
+
+// OldestHeight returns the oldest buffered height, and false if nothing is buffered.
+func (b *BlockUpdateBuffer) OldestHeight() (int64, bool) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	if len(b.heights) == 0 {
+		return 0, false
+	}
+	return b.heights[0], true
+}
+
+// BufferBlockUpdates hands the pair updates recorded in the mem state for the given
+// contracts over to the stream buffer. It is called at the end of the dex EndBlock so
+// that only updates of contract runs that were not rolled back are streamed.
+func (k Keeper) BufferBlockUpdates(ctx sdk.Context, contractAddrs []string) {
+	memState := dexutils.GetMemState(ctx.Context())
+	contractToUpdates := map[string][]*types.PairBlockUpdate{}
+	for _, contractAddr := range contractAddrs {
+		if updates := memState.GetBlockUpdates(ctx, types.ContractAddress(contractAddr)).Get(); len(updates) > 0 {
+			contractToUpdates[contractAddr] = updates
+		}
+	}
+	k.BlockUpdates.Add(ctx.BlockHeight(), contractToUpdates)
+}
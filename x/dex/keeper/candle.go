@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// UpdateCandles folds the fills of a pair in the current block into the pair's
+// current candle of every interval. It is a no-op if candles are disabled.
+func (k Keeper) UpdateCandles(ctx sdk.Context, contractAddr string, pair types.Pair, trades []*types.Trade) {
+	if len(trades) == 0 || k.GetParams(ctx).CandleRetention == 0 {
+		return
+	}
+	timestamp := uint64(ctx.BlockTime().Unix())
+	for _, interval := range types.CandleIntervalsInSeconds {
+		begin := timestamp - timestamp%interval
+		candle, found := k.GetCandle(ctx, contractAddr, pair, interval, begin)
+		if !found {
+			candle = types.NewCandle(begin, interval, trades[0].Price)
+		}
+		for _, trade := range trades {
+			candle.AddTrade(trade.Price, trade.Quantity)
+		}
+		k.SetCandle(ctx, contractAddr, pair, candle)
+	}
+}
+
+func (k Keeper) SetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, candle types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, candle.IntervalInSeconds))
+	store.Set(GetKeyForTs(candle.BeginTimestamp), k.Cdc.MustMarshal(&candle))
+}
+
+func (k Keeper) GetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, beginTimestamp uint64) (val types.Candle, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, intervalInSeconds))
+	b := store.Get(GetKeyForTs(beginTimestamp))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetCandlesInRange returns the candles of the interval that begin within
+// [startTimestamp, endTimestamp), oldest first. Intervals without any fill have no
+// candle.
+func (k Keeper) GetCandlesInRange(
+	ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, startTimestamp uint64, endTimestamp uint64,
+) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, intervalInSeconds))
+	iterator := store.Iterator(GetKeyForTs(startTimestamp), GetKeyForTs(endTimestamp))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// GetLastCandleBefore returns the latest candle of the interval that begins before
+// `timestamp`.
+func (k Keeper) GetLastCandleBefore(
+	ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, timestamp uint64,
+) (val types.Candle, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, intervalInSeconds))
+	iterator := store.ReverseIterator(nil, GetKeyForTs(timestamp))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// DeleteCandlesBefore prunes candles of the interval that began before `timestamp`.
+func (k Keeper) DeleteCandlesBefore(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, timestamp uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, intervalInSeconds))
+	iterator := store.Iterator(nil, GetKeyForTs(timestamp))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) RemoveAllCandlesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.CandleKey, contractAddr))
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newCandleTrade(price int64, quantity int64) *types.Trade {
+	return &types.Trade{
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+		Price:      sdk.NewDec(price),
+		Quantity:   sdk.NewDec(quantity),
+	}
+}
+
+func TestUpdateCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	ctx = ctx.WithBlockTime(time.Unix(3610, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{newCandleTrade(10, 2), newCandleTrade(12, 1)})
+	ctx = ctx.WithBlockTime(time.Unix(3650, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{newCandleTrade(9, 1)})
+	ctx = ctx.WithBlockTime(time.Unix(3670, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{newCandleTrade(11, 3)})
+
+	minuteCandles := keeper.GetCandlesInRange(ctx, keepertest.TestContract, pair, 60, 0, 3720)
+	require.Equal(t, 2, len(minuteCandles))
+	require.Equal(t, uint64(3600), minuteCandles[0].BeginTimestamp)
+	require.Equal(t, sdk.NewDec(10), minuteCandles[0].Open)
+	require.Equal(t, sdk.NewDec(12), minuteCandles[0].High)
+	require.Equal(t, sdk.NewDec(9), minuteCandles[0].Low)
+	require.Equal(t, sdk.NewDec(9), minuteCandles[0].Close)
+	require.Equal(t, sdk.NewDec(4), minuteCandles[0].Volume)
+	require.Equal(t, sdk.NewDec(41), minuteCandles[0].VolumeNotional)
+	require.Equal(t, uint64(3660), minuteCandles[1].BeginTimestamp)
+	require.Equal(t, sdk.NewDec(11), minuteCandles[1].Open)
+
+	hourCandle, found := keeper.GetCandle(ctx, keepertest.TestContract, pair, 3600, 3600)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), hourCandle.Open)
+	require.Equal(t, sdk.NewDec(11), hourCandle.Close)
+	require.Equal(t, sdk.NewDec(7), hourCandle.Volume)
+	require.Equal(t, sdk.NewDec(74), hourCandle.VolumeNotional)
+
+	last, found := keeper.GetLastCandleBefore(ctx, keepertest.TestContract, pair, 60, 3660)
+	require.True(t, found)
+	require.Equal(t, uint64(3600), last.BeginTimestamp)
+
+	keeper.DeleteCandlesBefore(ctx, keepertest.TestContract, pair, 60, 3660)
+	minuteCandles = keeper.GetCandlesInRange(ctx, keepertest.TestContract, pair, 60, 0, 3720)
+	require.Equal(t, 1, len(minuteCandles))
+	require.Equal(t, uint64(3660), minuteCandles[0].BeginTimestamp)
+
+	keeper.RemoveAllCandlesForContract(ctx, keepertest.TestContract)
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, pair, 3600, 3600)
+	require.False(t, found)
+}
+
+func TestUpdateCandlesDisabled(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.CandleRetention = 0
+	keeper.SetParams(ctx, params)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	ctx = ctx.WithBlockTime(time.Unix(3610, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{newCandleTrade(10, 2)})
+	require.Empty(t, keeper.GetCandlesInRange(ctx, keepertest.TestContract, pair, 60, 0, 3720))
+}
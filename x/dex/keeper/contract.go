@@ -110,15 +110,28 @@ func (k Keeper) ChargeRentForGas(ctx sdk.Context, contractAddr string, gasUsed u
 		gasFeeDec = sdk.NewDecFromBigInt(new(big.Int).SetUint64(math.MaxUint64))
 	}
 	gasFee := gasFeeDec.RoundInt().Uint64()
+	if topUp, found := k.GetRentTopUp(ctx, contractAddr); found {
+		if gasFee > contract.RentBalance {
+			k.drawRentTopUp(ctx, &contract, &topUp, gasFee-contract.RentBalance)
+		} else if contract.RentBalance-gasFee < topUp.Threshold {
+			k.drawRentTopUp(ctx, &contract, &topUp, 0)
+		}
+	}
+	rentBalanceBefore := contract.RentBalance
 	if gasFee > contract.RentBalance {
 		contract.RentBalance = 0
 		if err := k.SetContract(ctx, &contract); err != nil {
 			return err
 		}
+		k.emitLowRentWarning(ctx, contractAddr, rentBalanceBefore, contract.RentBalance)
 		return types.ErrInsufficientRent
 	}
 	contract.RentBalance -= gasFee
-	return k.SetContract(ctx, &contract)
+	if err := k.SetContract(ctx, &contract); err != nil {
+		return err
+	}
+	k.emitLowRentWarning(ctx, contractAddr, rentBalanceBefore, contract.RentBalance)
+	return nil
 }
 
 func (k Keeper) GetRentsForContracts(ctx sdk.Context, contractAddrs []string) map[string]uint64 {
@@ -145,9 +158,16 @@ func (k Keeper) DoUnregisterContract(ctx sdk.Context, contract types.ContractInf
 	k.RemoveAllLongBooksForContract(ctx, contract.ContractAddr)
 	k.RemoveAllShortBooksForContract(ctx, contract.ContractAddr)
 	k.RemoveAllPricesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllTriggerOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllExpiryQueueForContract(ctx, contract.ContractAddr)
+	k.RemoveAllAccountOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllTradesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllCandlesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllContractExecutionStatsForContract(ctx, contract.ContractAddr)
 	k.DeleteMatchResultState(ctx, contract.ContractAddr)
 	k.DeleteNextOrderID(ctx, contract.ContractAddr)
 	k.DeleteAllRegisteredPairsForContract(ctx, contract.ContractAddr)
+	k.DeleteRentTopUp(ctx, contract.ContractAddr)
 }
 
 func (k Keeper) SuspendContract(ctx sdk.Context, contractAddress string, reason string) error {
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// AddContractExecutionStats merges `stats` into the stats of the contract for the
+// current block. It is a no-op if execution stats are disabled.
+func (k Keeper) AddContractExecutionStats(ctx sdk.Context, stats types.ContractExecutionStats) {
+	if k.GetParams(ctx).ExecutionStatsRetention == 0 {
+		return
+	}
+	stats.Height = ctx.BlockHeight()
+	if contract, err := k.GetContract(ctx, stats.ContractAddr); err == nil {
+		stats.CodeId = contract.CodeId
+	}
+	blockStats, found := k.GetContractExecutionStatsForBlock(ctx, stats.ContractAddr, ctx.BlockHeight())
+	if !found {
+		blockStats = types.ContractExecutionStats{ContractAddr: stats.ContractAddr}
+	}
+	blockStats.Merge(stats)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractExecutionStatsPrefix(stats.ContractAddr))
+	store.Set(GetKeyForHeight(blockStats.Height), k.Cdc.MustMarshal(&blockStats))
+}
+
+func (k Keeper) GetContractExecutionStatsForBlock(ctx sdk.Context, contractAddr string, height int64) (val types.ContractExecutionStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractExecutionStatsPrefix(contractAddr))
+	b := store.Get(GetKeyForHeight(height))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllContractExecutionStats returns the retained per-block stats of a contract,
+// oldest first.
+func (k Keeper) GetAllContractExecutionStats(ctx sdk.Context, contractAddr string) (list []types.ContractExecutionStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractExecutionStatsPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ContractExecutionStats
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// DeleteContractExecutionStatsBefore prunes stats of blocks before `height`.
+func (k Keeper) DeleteContractExecutionStatsBefore(ctx sdk.Context, contractAddr string, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractExecutionStatsPrefix(contractAddr))
+	iterator := store.Iterator(nil, GetKeyForHeight(height))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) RemoveAllContractExecutionStatsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractExecutionStatsPrefix(contractAddr))
+}
+
+func GetKeyForHeight(height int64) []byte {
+	return GetKeyForTs(uint64(height))
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newExecutionStats(msgType string, gasUsed uint64, orders uint64) types.ContractExecutionStats {
+	stats := types.ContractExecutionStats{ContractAddr: keepertest.TestContract, OrdersProcessed: orders}
+	stats.AddSudoCall(msgType, gasUsed, false)
+	return stats
+}
+
+func TestAddContractExecutionStats(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, CodeId: 3}))
+	ctx = ctx.WithBlockHeight(10)
+	keeper.AddContractExecutionStats(ctx, newExecutionStats("bulk_order_placements", 100, 2))
+	keeper.AddContractExecutionStats(ctx, newExecutionStats("settlement", 50, 0))
+	ctx = ctx.WithBlockHeight(11)
+	keeper.AddContractExecutionStats(ctx, newExecutionStats("bulk_order_placements", 30, 1))
+
+	stats, found := keeper.GetContractExecutionStatsForBlock(ctx, keepertest.TestContract, 10)
+	require.True(t, found)
+	require.Equal(t, int64(10), stats.Height)
+	require.Equal(t, uint64(3), stats.CodeId)
+	require.Equal(t, uint64(2), stats.OrdersProcessed)
+	require.Equal(t, []types.SudoCallStats{
+		{MsgType: "bulk_order_placements", Calls: 1, GasUsed: 100},
+		{MsgType: "settlement", Calls: 1, GasUsed: 50},
+	}, stats.SudoCallStats)
+	require.Equal(t, uint64(150), stats.TotalGasUsed())
+
+	all := keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract)
+	require.Equal(t, 2, len(all))
+	require.Equal(t, int64(10), all[0].Height)
+	require.Equal(t, int64(11), all[1].Height)
+
+	keeper.DeleteContractExecutionStatsBefore(ctx, keepertest.TestContract, 11)
+	all = keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract)
+	require.Equal(t, 1, len(all))
+	require.Equal(t, int64(11), all[0].Height)
+
+	keeper.RemoveAllContractExecutionStatsForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract))
+}
+
+func TestAddContractExecutionStatsDisabled(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.ExecutionStatsRetention = 0
+	keeper.SetParams(ctx, params)
+	keeper.AddContractExecutionStats(ctx, newExecutionStats("settlement", 50, 0))
+	require.Empty(t, keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract))
+}
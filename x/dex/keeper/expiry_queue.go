@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+var (
+	expiryByHeightPrefix    = []byte{0x00}
+	expiryByTimestampPrefix = []byte{0x01}
+)
+
+// AddOrderToExpiryQueue indexes a resting order by its expiry height and/or timestamp.
+// Orders without any expiry are not added.
+func (k Keeper) AddOrderToExpiryQueue(ctx sdk.Context, contractAddr string, order types.Order) {
+	if order.ExpiryHeight == 0 && order.ExpiryTimestamp == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiryQueuePrefix(contractAddr, order.PriceDenom, order.AssetDenom))
+	bz := k.Cdc.MustMarshal(&order)
+	if order.ExpiryHeight > 0 {
+		store.Set(getExpiryKey(expiryByHeightPrefix, order.ExpiryHeight, order.Id), bz)
+	}
+	if order.ExpiryTimestamp > 0 {
+		store.Set(getExpiryKey(expiryByTimestampPrefix, order.ExpiryTimestamp, order.Id), bz)
+	}
+}
+
+// RemoveOrderFromExpiryQueue drops the queue entries of an order that left the book
+// (filled or cancelled) before its expiry passed.
+func (k Keeper) RemoveOrderFromExpiryQueue(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiryQueuePrefix(contractAddr, order.PriceDenom, order.AssetDenom))
+	if order.ExpiryHeight > 0 {
+		store.Delete(getExpiryKey(expiryByHeightPrefix, order.ExpiryHeight, order.Id))
+	}
+	if order.ExpiryTimestamp > 0 {
+		store.Delete(getExpiryKey(expiryByTimestampPrefix, order.ExpiryTimestamp, order.Id))
+	}
+}
+
+// PopExpiredOrders removes and returns all queued orders of a pair whose expiry height
+// is at or below `height` or whose expiry timestamp is at or below `timestamp`. An
+// order with both expiries set may be returned twice if both have passed.
+func (k Keeper) PopExpiredOrders(ctx sdk.Context, contractAddr string, pair types.Pair, height uint64, timestamp uint64) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiryQueuePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	keysToDelete := [][]byte{}
+	for _, queue := range []struct {
+		prefix []byte
+		bound  uint64
+	}{
+		{expiryByHeightPrefix, height},
+		{expiryByTimestampPrefix, timestamp},
+	} {
+		// the end key is exclusive so iterate up to the first order ID of the next height/timestamp
+		iterator := store.Iterator(queue.prefix, getExpiryKey(queue.prefix, queue.bound+1, 0))
+		for ; iterator.Valid(); iterator.Next() {
+			var val types.Order
+			k.Cdc.MustUnmarshal(iterator.Value(), &val)
+			list = append(list, val)
+			keysToDelete = append(keysToDelete, iterator.Key())
+		}
+		iterator.Close()
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+	return
+}
+
+func (k Keeper) RemoveAllExpiryQueueForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.ExpiryQueueKey, contractAddr))
+}
+
+func getExpiryKey(queuePrefix []byte, expiry uint64, orderID uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, expiry)
+	binary.BigEndian.PutUint64(key[8:], orderID)
+	return append(append([]byte{}, queuePrefix...), key...)
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopExpiredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(1, withExpiry(5, 0)))
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(2, withExpiry(6, 0)))
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(3, withExpiry(0, 1000)))
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(4, withExpiry(0, 1001)))
+	// never expires so never queued
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(5))
+
+	expired := keeper.PopExpiredOrders(ctx, keepertest.TestContract, keepertest.TestPair, 5, 1000)
+	require.Equal(t, []types.Order{createOrder(1, withExpiry(5, 0)), createOrder(3, withExpiry(0, 1000))}, expired)
+	// popped orders are removed from the queue
+	require.Empty(t, keeper.PopExpiredOrders(ctx, keepertest.TestContract, keepertest.TestPair, 5, 1000))
+
+	expired = keeper.PopExpiredOrders(ctx, keepertest.TestContract, keepertest.TestPair, 100, 10000)
+	require.Equal(t, []types.Order{createOrder(2, withExpiry(6, 0)), createOrder(4, withExpiry(0, 1001))}, expired)
+}
+
+func TestRemoveOrderFromExpiryQueue(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := createOrder(1, withExpiry(5, 1000))
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, order)
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(2, withExpiry(5, 0)))
+	keeper.RemoveOrderFromExpiryQueue(ctx, keepertest.TestContract, order)
+	require.Equal(t, []types.Order{createOrder(2, withExpiry(5, 0))}, keeper.PopExpiredOrders(ctx, keepertest.TestContract, keepertest.TestPair, 5, 1000))
+}
+
+func TestRemoveAllExpiryQueueForContract(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddOrderToExpiryQueue(ctx, keepertest.TestContract, createOrder(1, withExpiry(1, 0)))
+	keeper.RemoveAllExpiryQueueForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.PopExpiredOrders(ctx, keepertest.TestContract, keepertest.TestPair, 100, 100))
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+const (
+	OrderEntryQuantityInvariantName = "order-entry-quantity"
+	OrderCountInvariantName         = "order-count"
+	RentEscrowInvariantName         = "rent-escrow"
+)
+
+// RegisterInvariants registers all dex invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, OrderEntryQuantityInvariantName, OrderEntryQuantityInvariant(k))
+	ir.RegisterRoute(types.ModuleName, OrderCountInvariantName, OrderCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, RentEscrowInvariantName, RentEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the dex module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			OrderEntryQuantityInvariant(k),
+			OrderCountInvariant(k),
+			RentEscrowInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// OrderEntryQuantityInvariant checks that the quantity of every order book entry
+// equals the sum of its allocations and that no allocation is negative.
+func OrderEntryQuantityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		for _, contract := range k.GetAllContractInfo(ctx) {
+			for _, entry := range k.getAllOrderBookEntries(ctx, contract.ContractAddr) {
+				total := sdk.ZeroDec()
+				for _, allocation := range entry.GetOrderEntry().Allocations {
+					if allocation.Quantity.IsNegative() {
+						count++
+						msg += fmt.Sprintf("\tcontract %s %s/%s at %s: order %d has negative allocation %s\n",
+							contract.ContractAddr, entry.GetOrderEntry().PriceDenom, entry.GetOrderEntry().AssetDenom,
+							entry.GetPrice(), allocation.OrderId, allocation.Quantity)
+					}
+					total = total.Add(allocation.Quantity)
+				}
+				if !total.Equal(entry.GetOrderEntry().Quantity) {
+					count++
+					msg += fmt.Sprintf("\tcontract %s %s/%s at %s: entry quantity %s, sum of allocations %s\n",
+						contract.ContractAddr, entry.GetOrderEntry().PriceDenom, entry.GetOrderEntry().AssetDenom,
+						entry.GetPrice(), entry.GetOrderEntry().Quantity, total)
+				}
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, OrderEntryQuantityInvariantName, fmt.Sprintf(
+			"found %d order book entries with mismatched allocations\n%s", count, msg)), broken
+	}
+}
+
+// OrderCountInvariant checks that the order count of every price level equals the
+// number of allocations resting at that level, and that levels without an order
+// book entry have no order count.
+func OrderCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		for _, contract := range k.GetAllContractInfo(ctx) {
+			for _, pair := range k.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+				for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+					expected := map[string]uint64{}
+					var entries []types.OrderBookEntry
+					if direction == types.PositionDirection_LONG {
+						entries = k.GetAllLongBookForPair(ctx, contract.ContractAddr, pair.PriceDenom, pair.AssetDenom)
+					} else {
+						entries = k.GetAllShortBookForPair(ctx, contract.ContractAddr, pair.PriceDenom, pair.AssetDenom)
+					}
+					for _, entry := range entries {
+						expected[entry.GetPrice().String()] = uint64(len(entry.GetOrderEntry().Allocations))
+					}
+					for price, actual := range k.getAllOrderCountsForPair(ctx, contract.ContractAddr, pair, direction) {
+						if actual != expected[price] {
+							count++
+							msg += fmt.Sprintf("\tcontract %s %s/%s %s at %s: order count %d, allocations %d\n",
+								contract.ContractAddr, pair.PriceDenom, pair.AssetDenom, direction, price, actual, expected[price])
+						}
+						delete(expected, price)
+					}
+					// remaining levels have an entry but no order count at all
+					for price, allocations := range expected {
+						if allocations != 0 {
+							count++
+							msg += fmt.Sprintf("\tcontract %s %s/%s %s at %s: order count 0, allocations %d\n",
+								contract.ContractAddr, pair.PriceDenom, pair.AssetDenom, direction, price, allocations)
+						}
+					}
+				}
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, OrderCountInvariantName, fmt.Sprintf(
+			"found %d price levels with mismatched order counts\n%s", count, msg)), broken
+	}
+}
+
+// RentEscrowInvariant checks that the dex module account holds at least the sum of
+// the rent balances of all registered contracts.
+func RentEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalRent := sdk.ZeroInt()
+		for _, contract := range k.GetAllContractInfo(ctx) {
+			totalRent = totalRent.Add(sdk.NewIntFromUint64(contract.RentBalance))
+		}
+		escrow := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName), appparams.BaseCoinUnit)
+		broken := escrow.Amount.LT(totalRent)
+		return sdk.FormatInvariant(types.ModuleName, RentEscrowInvariantName, fmt.Sprintf(
+			"\tdex module account balance: %s\n\tsum of contract rent balances: %s%s\n", escrow, totalRent, appparams.BaseCoinUnit)), broken
+	}
+}
+
+func (k Keeper) getAllOrderBookEntries(ctx sdk.Context, contractAddr string) []types.OrderBookEntry {
+	entries := []types.OrderBookEntry{}
+	for _, longBook := range k.GetAllLongBook(ctx, contractAddr) {
+		longBook := longBook
+		entries = append(entries, &longBook)
+	}
+	for _, shortBook := range k.GetAllShortBook(ctx, contractAddr) {
+		shortBook := shortBook
+		entries = append(entries, &shortBook)
+	}
+	return entries
+}
+
+// Returns the non-zero order counts of a pair and direction, keyed by price.
+func (k Keeper) getAllOrderCountsForPair(ctx sdk.Context, contractAddr string, pair types.Pair, direction types.PositionDirection) map[string]uint64 {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.OrderCountPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, direction == types.PositionDirection_LONG),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	counts := map[string]uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		count := binary.BigEndian.Uint64(iterator.Value())
+		if count == 0 {
+			continue
+		}
+		price := sdk.Dec{}
+		if err := price.Unmarshal(iterator.Key()); err != nil {
+			panic(err)
+		}
+		counts[price.String()] = count
+	}
+	return counts
+}
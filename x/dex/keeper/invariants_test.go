@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderEntryQuantityInvariant(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract}))
+	longBook := types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(1)},
+				{OrderId: 2, Account: "def", Quantity: sdk.NewDec(2)},
+			},
+		},
+	}
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, longBook)
+	_, broken := keeper.OrderEntryQuantityInvariant(*dexkeeper)(ctx)
+	require.False(t, broken)
+
+	longBook.Entry.Quantity = sdk.NewDec(4)
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, longBook)
+	_, broken = keeper.OrderEntryQuantityInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestOrderCountInvariant(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract}))
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	dexkeeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(10),
+			Quantity:    sdk.NewDec(1),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(1)}},
+		},
+	})
+	_, broken := keeper.OrderCountInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, dexkeeper.SetOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, types.PositionDirection_SHORT, sdk.NewDec(10), 1))
+	// a zero count without an order book entry is consistent
+	require.NoError(t, dexkeeper.SetOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, types.PositionDirection_LONG, sdk.NewDec(9), 0))
+	_, broken = keeper.OrderCountInvariant(*dexkeeper)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, dexkeeper.SetOrderCount(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, types.PositionDirection_LONG, sdk.NewDec(9), 2))
+	_, broken = keeper.OrderCountInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestRentEscrowInvariant(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, RentBalance: 100}))
+	_, broken := keeper.RentEscrowInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+
+	rent := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100)))
+	require.NoError(t, dexkeeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rent))
+	require.NoError(t, dexkeeper.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, rent))
+	_, broken = keeper.RentEscrowInvariant(*dexkeeper)(ctx)
+	require.False(t, broken)
+	_, broken = keeper.AllInvariants(*dexkeeper)(ctx)
+	require.False(t, broken)
+}
@@ -27,7 +27,9 @@ type (
 		EpochKeeper   epochkeeper.Keeper
 		BankKeeper    bankkeeper.Keeper
 		WasmKeeper    wasm.Keeper
+		OracleKeeper  types.OracleKeeper
 		MemState      *dexcache.MemState
+		BlockUpdates  *BlockUpdateBuffer
 	}
 )
 
@@ -53,6 +55,7 @@ func NewKeeper(
 		BankKeeper:    bankKeeper,
 		AccountKeeper: accountKeeper,
 		MemState:      dexcache.NewMemState(memKey),
+		BlockUpdates:  NewBlockUpdateBuffer(MaxBufferedBlockUpdateHeights),
 	}
 }
 
@@ -72,6 +75,10 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper *wasm.Keeper) {
 	k.WasmKeeper = *wasmKeeper
 }
 
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.OracleKeeper = oracleKeeper
+}
+
 func (k Keeper) CreateModuleAccount(ctx sdk.Context) {
 	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
 	k.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
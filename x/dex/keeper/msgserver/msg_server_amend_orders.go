@@ -0,0 +1,162 @@
+package msgserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// AmendOrders changes the price and/or quantity of resting orders. Reducing the
+// quantity at the same price is done in place at the end of the block so that the
+// order keeps its position in the queue; the contract is notified of the reduction
+// through a partial cancellation. Any price change is processed as a
+// cancellation of the order and the placement of a new one in the same block. If
+// the contract fails to place the new order, the original one is restored.
+func (k msgServer) AmendOrders(goCtx context.Context, msg *types.MsgAmendOrders) (*types.MsgAmendOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	memState := utils.GetMemState(ctx.Context())
+	contractAddr := types.ContractAddress(msg.GetContractAddr())
+	events := []sdk.Event{}
+	nextID := k.GetNextOrderID(ctx, msg.ContractAddr)
+	idsInResp := []uint64{}
+	for _, amendment := range msg.GetAmendments() {
+		if k.IsPairHalted(ctx, msg.ContractAddr, amendment.PriceDenom, amendment.AssetDenom) {
+			return nil, sdkerrors.Wrapf(types.ErrPairHalted, "{price:%s,asset:%s}", amendment.PriceDenom, amendment.AssetDenom)
+		}
+		var allocation *types.Allocation
+		var found bool
+		if amendment.PositionDirection == types.PositionDirection_LONG {
+			allocation, found = k.GetLongAllocationForOrderID(ctx, msg.ContractAddr, amendment.PriceDenom, amendment.AssetDenom, amendment.Price, amendment.Id)
+		} else {
+			allocation, found = k.GetShortAllocationForOrderID(ctx, msg.ContractAddr, amendment.PriceDenom, amendment.AssetDenom, amendment.Price, amendment.Id)
+		}
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "order %d is not resting at %s", amendment.Id, amendment.Price)
+		}
+		if allocation.Account != msg.Creator {
+			return nil, errors.New("cannot amend orders created by others")
+		}
+		pair := types.Pair{PriceDenom: amendment.PriceDenom, AssetDenom: amendment.AssetDenom}
+		pairBlockCancellations := memState.GetBlockCancels(ctx, contractAddr, pair)
+		if pairBlockCancellations.Has(&types.Cancellation{Id: amendment.Id}) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order %d is already cancelled in this block", amendment.Id)
+		}
+
+		if amendment.NewPrice.Equal(amendment.Price) {
+			if !amendment.NewQuantity.LT(allocation.Quantity) {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quantity of order %d can only be reduced in place", amendment.Id)
+			}
+			memState.GetBlockAmendments(ctx, contractAddr, pair).Add(&types.Amendment{
+				Id:                amendment.Id,
+				Creator:           msg.Creator,
+				ContractAddr:      msg.ContractAddr,
+				PriceDenom:        amendment.PriceDenom,
+				AssetDenom:        amendment.AssetDenom,
+				PositionDirection: amendment.PositionDirection,
+				Price:             amendment.Price,
+				NewPrice:          amendment.NewPrice,
+				NewQuantity:       amendment.NewQuantity,
+			})
+			idsInResp = append(idsInResp, amendment.Id)
+			events = append(events, sdk.NewEvent(
+				types.EventTypeAmendOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(amendment.Id)),
+				sdk.NewAttribute(types.AttributeKeyNewOrderID, fmt.Sprint(amendment.Id)),
+			))
+			continue
+		}
+
+		replacement, err := k.getReplacementOrder(ctx, msg, amendment, nextID)
+		if err != nil {
+			return nil, err
+		}
+		pairBlockCancellations.Add(&types.Cancellation{
+			Id:                amendment.Id,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           msg.Creator,
+			ContractAddr:      msg.ContractAddr,
+			Price:             amendment.Price,
+			AssetDenom:        amendment.AssetDenom,
+			PriceDenom:        amendment.PriceDenom,
+			PositionDirection: amendment.PositionDirection,
+		})
+		memState.GetBlockOrders(ctx, contractAddr, pair).Add(replacement)
+		memState.GetBlockAmendments(ctx, contractAddr, pair).Add(&types.Amendment{
+			Id:                amendment.Id,
+			Creator:           msg.Creator,
+			ContractAddr:      msg.ContractAddr,
+			PriceDenom:        amendment.PriceDenom,
+			AssetDenom:        amendment.AssetDenom,
+			PositionDirection: amendment.PositionDirection,
+			Price:             amendment.Price,
+			NewPrice:          amendment.NewPrice,
+			NewQuantity:       amendment.NewQuantity,
+			NewOrderId:        nextID,
+		})
+		idsInResp = append(idsInResp, nextID)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeCancelOrder,
+			sdk.NewAttribute(types.AttributeKeyCancellationID, fmt.Sprint(amendment.Id)),
+		), sdk.NewEvent(
+			types.EventTypePlaceOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(nextID)),
+		), sdk.NewEvent(
+			types.EventTypeAmendOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(amendment.Id)),
+			sdk.NewAttribute(types.AttributeKeyNewOrderID, fmt.Sprint(nextID)),
+		))
+		nextID++
+	}
+	k.SetNextOrderID(ctx, msg.ContractAddr, nextID)
+	ctx.EventManager().EmitEvents(events)
+
+	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
+	return &types.MsgAmendOrdersResponse{
+		OrderIds: idsInResp,
+	}, nil
+}
+
+// Builds the order that replaces an amended order whose price changed. Fields that
+// are not part of the amendment are carried over from the per-account order index.
+func (k msgServer) getReplacementOrder(ctx sdk.Context, msg *types.MsgAmendOrders, amendment *types.Amendment, id uint64) (*types.Order, error) {
+	pair := types.Pair{PriceDenom: amendment.PriceDenom, AssetDenom: amendment.AssetDenom}
+	if _, found := k.Keeper.GetPriceTickSizeForPair(ctx, msg.GetContractAddr(), pair); !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", amendment.PriceDenom, amendment.AssetDenom)
+	}
+	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
+	if k.GetOrderCountState(ctx, msg.GetContractAddr(), amendment.PriceDenom, amendment.AssetDenom, amendment.PositionDirection, amendment.NewPrice) >= maxOrderPerPrice {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, msg.GetContractAddr(), amendment.PriceDenom, amendment.AssetDenom, amendment.PositionDirection, amendment.NewPrice)
+	}
+	replacement := types.Order{
+		OrderType:    types.OrderType_LIMIT,
+		Nominal:      sdk.ZeroDec(),
+		TriggerPrice: sdk.ZeroDec(),
+	}
+	if indexed, found := k.GetAccountOrder(ctx, msg.ContractAddr, msg.Creator, amendment.PriceDenom, amendment.AssetDenom, amendment.Id); found {
+		replacement = indexed
+		if replacement.OrderType != types.OrderType_POSTONLY && replacement.OrderType != types.OrderType_POSTONLYREPRICE {
+			replacement.OrderType = types.OrderType_LIMIT
+		}
+	}
+	replacement.Id = id
+	replacement.Status = types.OrderStatus_PLACED
+	replacement.Account = msg.Creator
+	replacement.ContractAddr = msg.ContractAddr
+	replacement.PriceDenom = amendment.PriceDenom
+	replacement.AssetDenom = amendment.AssetDenom
+	replacement.PositionDirection = amendment.PositionDirection
+	replacement.Price = amendment.NewPrice
+	replacement.Quantity = amendment.NewQuantity
+	return &replacement, nil
+}
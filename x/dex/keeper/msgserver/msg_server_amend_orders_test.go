@@ -0,0 +1,134 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAmendableOrder(t *testing.T) (*keeper.Keeper, sdk.Context) {
+	// store a long limit order to the orderbook
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.OneDec(),
+		Entry: &types.OrderEntry{
+			Price:      sdk.OneDec(),
+			Quantity:   sdk.MustNewDecFromStr("2"),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{
+					Account:  keepertest.TestAccount,
+					OrderId:  1,
+					Quantity: sdk.MustNewDecFromStr("2"),
+				},
+			},
+		},
+	})
+	dexkeeper.SetNextOrderID(ctx, keepertest.TestContract, 2)
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	dexkeeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	dexkeeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	return dexkeeper, ctx
+}
+
+func newAmendOrdersMsg(creator string, newPrice sdk.Dec, newQuantity sdk.Dec) *types.MsgAmendOrders {
+	return &types.MsgAmendOrders{
+		Creator:      creator,
+		ContractAddr: keepertest.TestContract,
+		Amendments: []*types.Amendment{
+			{
+				Id:                1,
+				Price:             sdk.OneDec(),
+				PositionDirection: types.PositionDirection_LONG,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+				NewPrice:          newPrice,
+				NewQuantity:       newQuantity,
+			},
+		},
+	}
+}
+
+func TestAmendOrderReduceQuantity(t *testing.T) {
+	dexkeeper, ctx := setupAmendableOrder(t)
+	server := msgserver.NewMsgServerImpl(*dexkeeper)
+	res, err := server.AmendOrders(sdk.WrapSDKContext(ctx), newAmendOrdersMsg(keepertest.TestAccount, sdk.OneDec(), sdk.OneDec()))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1}, res.OrderIds)
+
+	memState := dexutils.GetMemState(ctx.Context())
+	amendments := memState.GetBlockAmendments(ctx, keepertest.TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 1, len(amendments))
+	require.Equal(t, uint64(1), amendments[0].Id)
+	require.Equal(t, sdk.OneDec(), amendments[0].NewQuantity)
+	require.Equal(t, keepertest.TestAccount, amendments[0].Creator)
+	// reducing in place neither cancels nor places any order
+	require.Equal(t, 0, len(memState.GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair).Get()))
+	require.Equal(t, 0, len(memState.GetBlockOrders(ctx, keepertest.TestContract, keepertest.TestPair).Get()))
+	require.Equal(t, uint64(2), dexkeeper.GetNextOrderID(ctx, keepertest.TestContract))
+}
+
+func TestAmendOrderChangePrice(t *testing.T) {
+	dexkeeper, ctx := setupAmendableOrder(t)
+	server := msgserver.NewMsgServerImpl(*dexkeeper)
+	newPrice := sdk.MustNewDecFromStr("1.5")
+	res, err := server.AmendOrders(sdk.WrapSDKContext(ctx), newAmendOrdersMsg(keepertest.TestAccount, newPrice, sdk.MustNewDecFromStr("3")))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{2}, res.OrderIds)
+
+	memState := dexutils.GetMemState(ctx.Context())
+	cancels := memState.GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	require.Equal(t, sdk.OneDec(), cancels[0].Price)
+	orders := memState.GetBlockOrders(ctx, keepertest.TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(2), orders[0].Id)
+	require.Equal(t, newPrice, orders[0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("3"), orders[0].Quantity)
+	require.Equal(t, types.OrderType_LIMIT, orders[0].OrderType)
+	require.Equal(t, keepertest.TestAccount, orders[0].Account)
+	// the amendment is kept to restore the original order if the replacement fails
+	amendments := memState.GetBlockAmendments(ctx, keepertest.TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 1, len(amendments))
+	require.Equal(t, uint64(1), amendments[0].Id)
+	require.Equal(t, uint64(2), amendments[0].NewOrderId)
+	require.Equal(t, uint64(3), dexkeeper.GetNextOrderID(ctx, keepertest.TestContract))
+}
+
+func TestInvalidAmends(t *testing.T) {
+	dexkeeper, ctx := setupAmendableOrder(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*dexkeeper)
+
+	// increasing the quantity at the same price
+	_, err := server.AmendOrders(wctx, newAmendOrdersMsg(keepertest.TestAccount, sdk.OneDec(), sdk.MustNewDecFromStr("3")))
+	require.NotNil(t, err)
+
+	// order created by another account
+	_, err = server.AmendOrders(wctx, newAmendOrdersMsg(keepertest.TestContract, sdk.OneDec(), sdk.OneDec()))
+	require.NotNil(t, err)
+
+	// order not resting at the given price
+	msg := newAmendOrdersMsg(keepertest.TestAccount, sdk.OneDec(), sdk.OneDec())
+	msg.Amendments[0].Price = sdk.MustNewDecFromStr("2")
+	msg.Amendments[0].NewPrice = sdk.MustNewDecFromStr("2")
+	_, err = server.AmendOrders(wctx, msg)
+	require.NotNil(t, err)
+
+	// zero quantity
+	_, err = server.AmendOrders(wctx, newAmendOrdersMsg(keepertest.TestAccount, sdk.OneDec(), sdk.ZeroDec()))
+	require.NotNil(t, err)
+
+	// order already cancelled in the same block
+	dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair).Add(&types.Cancellation{Id: 1})
+	_, err = server.AmendOrders(wctx, newAmendOrdersMsg(keepertest.TestAccount, sdk.OneDec(), sdk.OneDec()))
+	require.NotNil(t, err)
+}
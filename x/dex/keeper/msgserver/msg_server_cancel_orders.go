@@ -27,6 +27,9 @@ func (k msgServer) CancelOrders(goCtx context.Context, msg *types.MsgCancelOrder
 		} else {
 			allocation, found = k.GetShortAllocationForOrderID(ctx, msg.ContractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
 		}
+		if !found {
+			allocation, found = k.getTriggerAllocationForOrderID(ctx, msg.ContractAddr, cancellation)
+		}
 		if !found {
 			continue
 		}
@@ -58,3 +61,16 @@ func (k msgServer) CancelOrders(goCtx context.Context, msg *types.MsgCancelOrder
 	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
 	return &types.MsgCancelOrdersResponse{}, nil
 }
+
+// stop orders are not in the order book until they are triggered, so they are
+// looked up by ID in the trigger book instead
+func (k msgServer) getTriggerAllocationForOrderID(ctx sdk.Context, contractAddr string, cancellation *types.Cancellation) (*types.Allocation, bool) {
+	order, found := k.GetTriggerOrder(ctx, contractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Id)
+	if !found {
+		order, found = k.GetTriggeredOrder(ctx, contractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Id)
+	}
+	if !found {
+		return nil, false
+	}
+	return &types.Allocation{OrderId: order.Id, Quantity: order.Quantity, Account: order.Account}, true
+}
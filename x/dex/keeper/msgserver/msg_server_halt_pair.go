@@ -0,0 +1,37 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// HaltPair stops a registered pair from accepting new orders and amendments and
+// from being matched. Orders already placed in the current block are cancelled
+// at the end of it, while cancellations of resting orders are still processed.
+func (k msgServer) HaltPair(goCtx context.Context, msg *types.MsgHaltPair) (*types.MsgHaltPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contractInfo, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	// only the user who registered the contract can halt its pairs
+	if msg.Creator != contractInfo.Creator {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	if err := k.SetPairHalted(ctx, msg.ContractAddr, msg.PriceDenom, msg.AssetDenom, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgHaltPairResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaltAndResumePair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestContract,
+		Creator:      keepertest.TestAccount,
+	})
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	haltMsg := types.NewMsgHaltPair(keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	resumeMsg := types.NewMsgResumePair(keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+
+	_, err := server.ResumePair(wctx, resumeMsg)
+	require.ErrorIs(t, err, types.ErrPairNotHalted)
+
+	_, err = server.HaltPair(wctx, haltMsg)
+	require.NoError(t, err)
+	require.True(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	_, err = server.HaltPair(wctx, haltMsg)
+	require.ErrorIs(t, err, types.ErrPairHalted)
+
+	// new orders are rejected while the pair is halted
+	_, err = server.PlaceOrders(wctx, types.NewMsgPlaceOrders(keepertest.TestAccount, []*types.Order{{
+		Price:             sdk.OneDec(),
+		Quantity:          sdk.OneDec(),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+	}}, keepertest.TestContract, sdk.NewCoins()))
+	require.ErrorIs(t, err, types.ErrPairHalted)
+
+	_, err = server.ResumePair(wctx, resumeMsg)
+	require.NoError(t, err)
+	require.False(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+}
+
+func TestHaltPairUnauthorized(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestContract,
+		Creator:      keepertest.TestAccount,
+	})
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	_, err := server.HaltPair(wctx, types.NewMsgHaltPair(keepertest.TestContract, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.False(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	_, err = server.HaltPair(wctx, types.NewMsgHaltPair(keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, "unregistered"))
+	require.ErrorIs(t, err, types.ErrPairNotRegistered)
+}
@@ -11,32 +11,32 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
-func (k msgServer) transferFunds(goCtx context.Context, msg *types.MsgPlaceOrders) error {
-	if len(msg.Funds) == 0 {
+func (k msgServer) transferFunds(goCtx context.Context, creator string, contract string, funds sdk.Coins) error {
+	if len(funds) == 0 {
 		return nil
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	contractAddr := sdk.MustAccAddressFromBech32(msg.ContractAddr)
-	if err := k.BankKeeper.IsSendEnabledCoins(ctx, msg.Funds...); err != nil {
+	contractAddr := sdk.MustAccAddressFromBech32(contract)
+	if err := k.BankKeeper.IsSendEnabledCoins(ctx, funds...); err != nil {
 		return err
 	}
 	if k.BankKeeper.BlockedAddr(contractAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", contractAddr.String())
 	}
 
-	sender := sdk.MustAccAddressFromBech32(msg.Creator)
-	for _, fund := range msg.Funds {
+	sender := sdk.MustAccAddressFromBech32(creator)
+	for _, fund := range funds {
 		if fund.Amount.IsNil() || fund.IsNegative() {
 			return errors.New("fund deposits cannot be nil or negative")
 		}
-		utils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(msg.GetContractAddr())).Add(&types.DepositInfoEntry{
-			Creator: msg.Creator,
+		utils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contract)).Add(&types.DepositInfoEntry{
+			Creator: creator,
 			Denom:   fund.Denom,
 			Amount:  sdk.NewDec(fund.Amount.Int64()),
 		})
 	}
-	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Funds); err != nil {
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, funds); err != nil {
 		return fmt.Errorf("error sending coins to contract: %s", err)
 	}
 	return nil
@@ -50,7 +50,7 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 		return nil, err
 	}
 
-	if err := k.transferFunds(goCtx, msg); err != nil {
+	if err := k.transferFunds(goCtx, msg.Creator, msg.ContractAddr, msg.Funds); err != nil {
 		return nil, err
 	}
 	events := []sdk.Event{}
@@ -58,9 +58,18 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 	idsInResp := []uint64{}
 	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
 	for _, order := range msg.GetOrders() {
+		if k.IsPairHalted(ctx, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom) {
+			return nil, sdkerrors.Wrapf(types.ErrPairHalted, "{price:%s,asset:%s}", order.PriceDenom, order.AssetDenom)
+		}
 		if k.GetOrderCountState(ctx, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price) >= maxOrderPerPrice {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price)
 		}
+		if order.ExpiryHeight != 0 && order.ExpiryHeight <= uint64(ctx.BlockHeight()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order expiry height %d is not in the future", order.ExpiryHeight)
+		}
+		if order.ExpiryTimestamp != 0 && order.ExpiryTimestamp <= uint64(ctx.BlockTime().Unix()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order expiry timestamp %d is not in the future", order.ExpiryTimestamp)
+		}
 		priceTicksize, found := k.Keeper.GetPriceTickSizeForPair(ctx, msg.GetContractAddr(), types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom})
 		if !found {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
@@ -0,0 +1,79 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/utils/datastructures"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// PlaceRoutedOrder queues a swap through the hops of the message for the end of the
+// block. Since a hop can only be placed once the previous hop has been matched, the
+// contract of every hop needs to be a downstream dependency of the contract of the
+// previous hop, so that it runs after it in the contract DAG. For the same reason,
+// consecutive hops can't be on the same contract: all pairs of a contract are matched
+// in a single run per block, which the next hop would have to be placed before, and
+// running the contract a second time would mean matching the rest of its orders
+// twice. Such routes need a hop on another contract in between, or separate orders.
+func (k msgServer) PlaceRoutedOrder(goCtx context.Context, msg *types.MsgPlaceRoutedOrder) (*types.MsgPlaceRoutedOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	for i, hop := range msg.Hops {
+		contractInfo, err := k.GetContract(ctx, hop.ContractAddr)
+		if err != nil {
+			return nil, err
+		}
+		if contractInfo.Suspended {
+			return nil, types.ErrContractSuspended
+		}
+		if !contractInfo.NeedOrderMatching {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRoute, "contract %s of hop %d does not match orders", hop.ContractAddr, i)
+		}
+		if _, found := k.GetRegisteredPair(ctx, hop.ContractAddr, hop.PriceDenom, hop.AssetDenom); !found {
+			return nil, sdkerrors.Wrapf(types.ErrPairNotRegistered, "{price:%s,asset:%s} of hop %d", hop.PriceDenom, hop.AssetDenom, i)
+		}
+		if k.IsPairHalted(ctx, hop.ContractAddr, hop.PriceDenom, hop.AssetDenom) {
+			return nil, sdkerrors.Wrapf(types.ErrPairHalted, "{price:%s,asset:%s} of hop %d", hop.PriceDenom, hop.AssetDenom, i)
+		}
+		if i == 0 {
+			continue
+		}
+		previousContractAddr := msg.Hops[i-1].ContractAddr
+		if hop.ContractAddr == previousContractAddr {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRoute, "hops %d and %d are both on contract %s, which is only matched once per block", i-1, i, hop.ContractAddr)
+		}
+		downstreams := datastructures.NewSyncSet(dexutils.GetMemState(ctx.Context()).GetContractToDependencies(ctx, previousContractAddr, k.GetContractWithoutGasCharge))
+		if !downstreams.Contains(hop.ContractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRoute, "contract %s of hop %d is not a dependency of contract %s", hop.ContractAddr, i, previousContractAddr)
+		}
+	}
+
+	if err := k.transferFunds(goCtx, msg.Creator, msg.Hops[0].ContractAddr, msg.Funds); err != nil {
+		return nil, err
+	}
+	routedOrder := types.RoutedOrder{
+		Creator:       msg.Creator,
+		Hops:          msg.Hops,
+		InputDenom:    msg.InputDenom,
+		InputQuantity: msg.InputQuantity,
+		MinOutput:     msg.MinOutput,
+	}
+	dexutils.GetMemState(ctx.Context()).AddRoutedOrder(ctx, &routedOrder)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePlaceRoutedOrder,
+		sdk.NewAttribute(types.AttributeKeyRoutedOrderID, fmt.Sprint(routedOrder.Id)),
+		sdk.NewAttribute(types.AttributeKeyAccount, msg.Creator),
+	))
+
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.Hops[0].ContractAddr, k.GetContractWithoutGasCharge)
+	return &types.MsgPlaceRoutedOrderResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceRoutedOrder(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	// keepertest.TestContract depends on TestContract, so it runs first
+	keeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr:      keepertest.TestContract,
+		NeedOrderMatching: true,
+		Dependencies:      []*types.ContractDependencyInfo{{Dependency: TestContract}},
+	})
+	keeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr:            TestContract,
+		NeedOrderMatching:       true,
+		NumIncomingDependencies: 1,
+	})
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.AddRegisteredPair(ctx, TestContract, types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "sei"})
+	server := msgserver.NewMsgServerImpl(*keeper)
+	newMsg := func(firstContract string, secondContract string) *types.MsgPlaceRoutedOrder {
+		return types.NewMsgPlaceRoutedOrder(keepertest.TestAccount, []*types.RouteHop{
+			{ContractAddr: firstContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, Price: sdk.ZeroDec()},
+			{ContractAddr: secondContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: "sei", Price: sdk.OneDec()},
+		}, keepertest.TestAssetDenom, sdk.OneDec(), sdk.OneDec(), sdk.NewCoins())
+	}
+
+	// the second hop can't run before the first one
+	_, err := server.PlaceRoutedOrder(wctx, newMsg(TestContract, keepertest.TestContract))
+	require.ErrorIs(t, err, types.ErrPairNotRegistered)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "sei"})
+	_, err = server.PlaceRoutedOrder(wctx, newMsg(TestContract, keepertest.TestContract))
+	require.ErrorIs(t, err, types.ErrInvalidRoute)
+	// a contract is only matched once per block
+	_, err = server.PlaceRoutedOrder(wctx, newMsg(keepertest.TestContract, keepertest.TestContract))
+	require.ErrorIs(t, err, types.ErrInvalidRoute)
+	require.ErrorContains(t, err, "only matched once per block")
+	require.Empty(t, dexutils.GetMemState(ctx.Context()).GetAllRoutedOrders(ctx))
+
+	_, err = server.PlaceRoutedOrder(wctx, newMsg(keepertest.TestContract, TestContract))
+	require.NoError(t, err)
+	_, err = server.PlaceRoutedOrder(wctx, newMsg(keepertest.TestContract, TestContract))
+	require.NoError(t, err)
+	routedOrders := dexutils.GetMemState(ctx.Context()).GetAllRoutedOrders(ctx)
+	require.Equal(t, 2, len(routedOrders))
+	require.Equal(t, uint64(0), routedOrders[0].Id)
+	require.Equal(t, uint64(1), routedOrders[1].Id)
+	require.Equal(t, keepertest.TestAccount, routedOrders[0].Creator)
+	// both contracts are processed at the end of the block
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(keepertest.TestContract))
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(TestContract))
+
+	// no hop can be on a halted pair
+	require.NoError(t, keeper.SetPairHalted(ctx, TestContract, keepertest.TestPriceDenom, "sei", true))
+	_, err = server.PlaceRoutedOrder(wctx, newMsg(keepertest.TestContract, TestContract))
+	require.ErrorIs(t, err, types.ErrPairHalted)
+}
@@ -0,0 +1,35 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// ResumePair lets a halted pair accept orders and be matched again.
+func (k msgServer) ResumePair(goCtx context.Context, msg *types.MsgResumePair) (*types.MsgResumePairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contractInfo, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	// only the user who registered the contract can resume its pairs
+	if msg.Creator != contractInfo.Creator {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	if err := k.SetPairHalted(ctx, msg.ContractAddr, msg.PriceDenom, msg.AssetDenom, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumePairResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// RevokeRentTopUp removes the rent top-up of a contract. Either the creator of the
+// contract or the source account of the top-up can revoke it.
+func (k msgServer) RevokeRentTopUp(goCtx context.Context, msg *types.MsgRevokeRentTopUp) (*types.MsgRevokeRentTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contractInfo, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	topUp, found := k.GetRentTopUp(ctx, msg.ContractAddr)
+	if !found {
+		return nil, types.ErrRentTopUpNotFound
+	}
+	if msg.Sender != contractInfo.Creator && msg.Sender != topUp.Source {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	k.DeleteRentTopUp(ctx, msg.ContractAddr)
+
+	return &types.MsgRevokeRentTopUpResponse{}, nil
+}
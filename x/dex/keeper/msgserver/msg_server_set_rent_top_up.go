@@ -0,0 +1,45 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetRentTopUp lets the creator of a contract keep its rent balance above a
+// threshold by drawing from a source account, up to a total spend limit. Any
+// existing top-up of the contract is replaced.
+func (k msgServer) SetRentTopUp(goCtx context.Context, msg *types.MsgSetRentTopUp) (*types.MsgSetRentTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contractInfo, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	// only the user who registered the contract can set up its top-up
+	if msg.Creator != contractInfo.Creator {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+	if msg.Expiration != 0 && msg.Expiration <= uint64(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiration %d is not in the future", msg.Expiration)
+	}
+
+	k.Keeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: msg.ContractAddr,
+		Source:       msg.Source,
+		Threshold:    msg.Threshold,
+		Amount:       msg.Amount,
+		SpendLimit:   msg.SpendLimit,
+		Expiration:   msg.Expiration,
+	})
+
+	return &types.MsgSetRentTopUpResponse{}, nil
+}
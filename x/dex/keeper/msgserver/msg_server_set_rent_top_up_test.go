@@ -0,0 +1,73 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAndRevokeRentTopUp(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestContract,
+		Creator:      keepertest.TestAccount,
+	})
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	_, err := server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(keepertest.TestAccount2, keepertest.TestContract, keepertest.TestAccount2, 100, 200, 1000, 0))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(keepertest.TestAccount, keepertest.TestContract, keepertest.TestAccount2, 100, 200, 1000, uint64(ctx.BlockTime().Unix())))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(keepertest.TestAccount, keepertest.TestContract, keepertest.TestAccount2, 100, 200, 1000, 0))
+	require.NoError(t, err)
+	topUp, found := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.True(t, found)
+	require.Equal(t, types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Source:       keepertest.TestAccount2,
+		Threshold:    100,
+		Amount:       200,
+		SpendLimit:   1000,
+	}, topUp)
+
+	// the source account can revoke the top-up as well
+	_, err = server.RevokeRentTopUp(wctx, types.NewMsgRevokeRentTopUp(keepertest.TestAccount2, keepertest.TestContract))
+	require.NoError(t, err)
+	_, found = keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.False(t, found)
+	_, err = server.RevokeRentTopUp(wctx, types.NewMsgRevokeRentTopUp(keepertest.TestAccount, keepertest.TestContract))
+	require.ErrorIs(t, err, types.ErrRentTopUpNotFound)
+}
+
+func TestRevokeRentTopUpUnauthorized(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestContract,
+		Creator:      keepertest.TestAccount,
+	})
+	keeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Source:       keepertest.TestAccount,
+		Threshold:    100,
+		Amount:       200,
+		SpendLimit:   1000,
+	})
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	_, err := server.RevokeRentTopUp(wctx, types.NewMsgRevokeRentTopUp(keepertest.TestAccount2, keepertest.TestContract))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestSetRentTopUpSigners(t *testing.T) {
+	msg := types.NewMsgSetRentTopUp(keepertest.TestAccount, keepertest.TestContract, keepertest.TestAccount2, 100, 200, 1000, 0)
+	require.Len(t, msg.GetSigners(), 2)
+	msg = types.NewMsgSetRentTopUp(keepertest.TestAccount, keepertest.TestContract, keepertest.TestAccount, 100, 200, 1000, 0)
+	require.Len(t, msg.GetSigners(), 1)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetOraclePriceForPair returns the oracle price of the pair's asset in its price denom,
+// derived from the exchange rates of both denoms. It returns false if either rate is
+// missing, non-positive or too stale for the denom's oracle policy.
+func (k Keeper) GetOraclePriceForPair(ctx sdk.Context, pair types.Pair) (sdk.Dec, bool) {
+	assetRate, found := k.getFreshOracleExchangeRate(ctx, pair.AssetDenom)
+	if !found {
+		return sdk.ZeroDec(), false
+	}
+	priceRate, found := k.getFreshOracleExchangeRate(ctx, pair.PriceDenom)
+	if !found {
+		return sdk.ZeroDec(), false
+	}
+	return assetRate.Quo(priceRate), true
+}
+
+func (k Keeper) getFreshOracleExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	rate, _, lastUpdateTimestamp, err := k.OracleKeeper.GetBaseExchangeRate(ctx, denom)
+	if err != nil || !rate.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	if voteTarget, err := k.OracleKeeper.GetVoteTarget(ctx, denom); err == nil && voteTarget.IsStale(lastUpdateTimestamp, ctx.BlockTime().UnixMilli()) {
+		return sdk.ZeroDec(), false
+	}
+	return rate, true
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetDepthLevelsForPair aggregates up to `levels` price levels on each side of the pair's
+// order book. Entries are grouped into buckets of `bucketSize`, rounding long prices
+// down and short prices up to the nearest bucket boundary so that a level never
+// shows a better price than the orders it contains. No levels are returned for a
+// non-positive bucket size.
+func (k Keeper) GetDepthLevelsForPair(
+	ctx sdk.Context, contractAddr string, pair types.Pair, levels int, bucketSize sdk.Dec,
+) (longs []*types.DepthLevel, shorts []*types.DepthLevel) {
+	if !bucketSize.IsPositive() {
+		return
+	}
+	longStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookPrefix(true, contractAddr, pair.PriceDenom, pair.AssetDenom))
+	longIterator := sdk.KVStoreReversePrefixIterator(longStore, []byte{})
+	defer longIterator.Close()
+	longs = k.aggregateDepth(longIterator, levels, func() storedOrderBookEntry { return &types.LongBook{} }, func(price sdk.Dec) sdk.Dec {
+		return price.Quo(bucketSize).TruncateDec().Mul(bucketSize)
+	})
+
+	shortStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookPrefix(false, contractAddr, pair.PriceDenom, pair.AssetDenom))
+	shortIterator := sdk.KVStorePrefixIterator(shortStore, []byte{})
+	defer shortIterator.Close()
+	shorts = k.aggregateDepth(shortIterator, levels, func() storedOrderBookEntry { return &types.ShortBook{} }, func(price sdk.Dec) sdk.Dec {
+		return price.Quo(bucketSize).Ceil().Mul(bucketSize)
+	})
+	return
+}
+
+type storedOrderBookEntry interface {
+	types.OrderBookEntry
+	codec.ProtoMarshaler
+}
+
+// Iterates order book entries from the best price outwards and merges consecutive
+// entries that fall into the same bucket.
+func (k Keeper) aggregateDepth(
+	iterator sdk.Iterator, levels int, newEntry func() storedOrderBookEntry, bucketOf func(sdk.Dec) sdk.Dec,
+) (list []*types.DepthLevel) {
+	for ; iterator.Valid(); iterator.Next() {
+		entry := newEntry()
+		k.Cdc.MustUnmarshal(iterator.Value(), entry)
+		bucket := bucketOf(entry.GetPrice())
+		if len(list) == 0 || !list[len(list)-1].Price.Equal(bucket) {
+			if len(list) == levels {
+				break
+			}
+			list = append(list, &types.DepthLevel{Price: bucket, Quantity: sdk.ZeroDec()})
+		}
+		level := list[len(list)-1]
+		level.Quantity = level.Quantity.Add(entry.GetOrderEntry().Quantity)
+		level.OrderCount += uint64(len(entry.GetOrderEntry().Allocations))
+	}
+	return
+}
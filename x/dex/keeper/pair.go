@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
@@ -54,3 +55,93 @@ func (k Keeper) GetAllRegisteredPairs(ctx sdk.Context, contractAddr string) []ty
 func (k Keeper) DeleteAllRegisteredPairsForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.RegisteredPairPrefix(contractAddr))
 }
+
+// SetMatchingAlgorithmForPair updates how fills are allocated across resting orders
+// of a registered pair. Only the matching algorithm and pro-rata settings of `pair`
+// are applied; everything else about the registered pair, including its matching
+// mode, is left untouched.
+func (k Keeper) SetMatchingAlgorithmForPair(ctx sdk.Context, contractAddr string, pair types.Pair) error {
+	registeredPair, found := k.GetRegisteredPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return types.ErrPairNotRegistered
+	}
+	registeredPair.MatchingAlgorithm = pair.MatchingAlgorithm
+	registeredPair.ProRataMinAllocation = pair.ProRataMinAllocation
+	registeredPair.ProRataRemainderRule = pair.ProRataRemainderRule
+	if err := registeredPair.ValidateMatchingAlgorithm(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredPairPrefix(contractAddr))
+	store.Set(types.PairPrefix(registeredPair.PriceDenom, registeredPair.AssetDenom), k.Cdc.MustMarshal(&registeredPair))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetMatchingAlgorithm,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, registeredPair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, registeredPair.AssetDenom),
+		sdk.NewAttribute(types.AttributeKeyMatchingAlgorithm, registeredPair.MatchingAlgorithm.String()),
+	))
+	return nil
+}
+
+// SetMatchingModeForPair switches a registered pair between continuous matching and
+// batch auctions, leaving its matching algorithm and everything else untouched.
+func (k Keeper) SetMatchingModeForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, matchingMode types.MatchingMode) error {
+	registeredPair, found := k.GetRegisteredPair(ctx, contractAddr, priceDenom, assetDenom)
+	if !found {
+		return types.ErrPairNotRegistered
+	}
+	registeredPair.MatchingMode = matchingMode
+	if err := registeredPair.ValidateMatchingAlgorithm(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredPairPrefix(contractAddr))
+	store.Set(types.PairPrefix(registeredPair.PriceDenom, registeredPair.AssetDenom), k.Cdc.MustMarshal(&registeredPair))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetMatchingMode,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, registeredPair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, registeredPair.AssetDenom),
+		sdk.NewAttribute(types.AttributeKeyMatchingMode, registeredPair.MatchingMode.String()),
+	))
+	return nil
+}
+
+// IsPairHalted returns whether a registered pair currently only accepts cancellations.
+// Unregistered pairs are never considered halted.
+func (k Keeper) IsPairHalted(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) bool {
+	pair, found := k.GetRegisteredPair(ctx, contractAddr, priceDenom, assetDenom)
+	return found && pair.Halted
+}
+
+// SetPairHalted halts or resumes a registered pair. While halted, new orders and
+// amendments for the pair are rejected and the pair is skipped during matching;
+// cancellations are still processed.
+func (k Keeper) SetPairHalted(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, halted bool) error {
+	registeredPair, found := k.GetRegisteredPair(ctx, contractAddr, priceDenom, assetDenom)
+	if !found {
+		return types.ErrPairNotRegistered
+	}
+	if registeredPair.Halted == halted {
+		if halted {
+			return types.ErrPairHalted
+		}
+		return types.ErrPairNotHalted
+	}
+	registeredPair.Halted = halted
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredPairPrefix(contractAddr))
+	store.Set(types.PairPrefix(registeredPair.PriceDenom, registeredPair.AssetDenom), k.Cdc.MustMarshal(&registeredPair))
+
+	eventType := types.EventTypeHaltPair
+	if !halted {
+		eventType = types.EventTypeResumePair
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, registeredPair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, registeredPair.AssetDenom),
+	))
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/testutil/nullify"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
@@ -39,3 +40,80 @@ func TestAddGetPair(t *testing.T) {
 	require.True(t, hasPair)
 
 }
+
+func TestSetMatchingAlgorithmForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	minAllocation := sdk.NewDec(2)
+	update := types.Pair{
+		PriceDenom:           keepertest.TestPriceDenom,
+		AssetDenom:           keepertest.TestAssetDenom,
+		MatchingAlgorithm:    types.MatchingAlgorithm_PRO_RATA,
+		ProRataMinAllocation: &minAllocation,
+		ProRataRemainderRule: types.ProRataRemainderRule_REMAINDER_LARGEST_ALLOCATION,
+	}
+	require.ErrorIs(t, keeper.SetMatchingAlgorithmForPair(ctx, keepertest.TestContract, update), types.ErrPairNotRegistered)
+
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{
+		PriceDenom:              keepertest.TestPriceDenom,
+		AssetDenom:              keepertest.TestAssetDenom,
+		PriceTicksize:           &keepertest.TestTicksize,
+		QuantityTicksize:        &keepertest.TestTicksize,
+		SelfTradePreventionMode: types.SelfTradePreventionMode_CANCEL_OLDEST,
+	})
+	// pro-rata cannot be combined with self-trade prevention
+	require.Error(t, keeper.SetMatchingAlgorithmForPair(ctx, keepertest.TestContract, update))
+
+	keeper.DeleteAllRegisteredPairsForContract(ctx, keepertest.TestContract)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{
+		PriceDenom:       keepertest.TestPriceDenom,
+		AssetDenom:       keepertest.TestAssetDenom,
+		PriceTicksize:    &keepertest.TestTicksize,
+		QuantityTicksize: &keepertest.TestTicksize,
+	})
+	require.NoError(t, keeper.SetMatchingAlgorithmForPair(ctx, keepertest.TestContract, update))
+	pair, found := keeper.GetRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, types.Pair{
+		PriceDenom:           keepertest.TestPriceDenom,
+		AssetDenom:           keepertest.TestAssetDenom,
+		PriceTicksize:        &keepertest.TestTicksize,
+		QuantityTicksize:     &keepertest.TestTicksize,
+		MatchingAlgorithm:    types.MatchingAlgorithm_PRO_RATA,
+		ProRataMinAllocation: &minAllocation,
+		ProRataRemainderRule: types.ProRataRemainderRule_REMAINDER_LARGEST_ALLOCATION,
+	}, pair)
+}
+
+func TestSetMatchingModeForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{
+		PriceDenom:              keepertest.TestPriceDenom,
+		AssetDenom:              keepertest.TestAssetDenom,
+		SelfTradePreventionMode: types.SelfTradePreventionMode_CANCEL_NEWEST,
+	})
+	update := types.Pair{
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		MatchingMode: types.MatchingMode_BATCH_AUCTION,
+	}
+	// batch auctions cannot be combined with self-trade prevention
+	require.Error(t, keeper.SetMatchingModeForPair(ctx, keepertest.TestContract, update.PriceDenom, update.AssetDenom, update.MatchingMode))
+
+	keeper.DeleteAllRegisteredPairsForContract(ctx, keepertest.TestContract)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+	require.NoError(t, keeper.SetMatchingModeForPair(ctx, keepertest.TestContract, update.PriceDenom, update.AssetDenom, update.MatchingMode))
+	pair, found := keeper.GetRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, types.MatchingMode_BATCH_AUCTION, pair.MatchingMode)
+
+	// updating the matching algorithm keeps the pair in batch auction mode
+	require.NoError(t, keeper.SetMatchingAlgorithmForPair(ctx, keepertest.TestContract, types.Pair{
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		MatchingAlgorithm: types.MatchingAlgorithm_PRO_RATA,
+	}))
+	pair, found = keeper.GetRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, types.MatchingMode_BATCH_AUCTION, pair.MatchingMode)
+	require.Equal(t, types.MatchingAlgorithm_PRO_RATA, pair.MatchingAlgorithm)
+}
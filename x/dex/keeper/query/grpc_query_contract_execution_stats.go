@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetContractExecutionStats(c context.Context, req *types.QueryGetContractExecutionStatsRequest) (*types.QueryGetContractExecutionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats := types.ContractExecutionStats{ContractAddr: req.ContractAddr, SudoCallStats: []types.SudoCallStats{}}
+	blockStats := []types.ContractExecutionStats{}
+	for _, s := range k.Keeper.GetAllContractExecutionStats(ctx, req.ContractAddr) {
+		if req.CodeId != 0 && s.CodeId != req.CodeId {
+			continue
+		}
+		stats.Merge(s)
+		blockStats = append(blockStats, s)
+	}
+
+	return &types.QueryGetContractExecutionStatsResponse{Stats: stats, BlockStats: blockStats}, nil
+}
@@ -0,0 +1,45 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContractExecutionStatsQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	contract := types.ContractInfoV2{ContractAddr: keepertest.TestContract, CodeId: 1}
+	require.Nil(t, keeper.SetContract(ctx, &contract))
+	stats := types.ContractExecutionStats{ContractAddr: keepertest.TestContract, OrdersProcessed: 2}
+	stats.AddSudoCall("bulk_order_placements", 100, false)
+	keeper.AddContractExecutionStats(ctx.WithBlockHeight(1), stats)
+	// contract is migrated to a new code ID
+	contract.CodeId = 2
+	require.Nil(t, keeper.SetContract(ctx, &contract))
+	stats = types.ContractExecutionStats{ContractAddr: keepertest.TestContract, OrdersProcessed: 1, Failures: 1}
+	stats.AddSudoCall("bulk_order_placements", 300, true)
+	keeper.AddContractExecutionStats(ctx.WithBlockHeight(2), stats)
+
+	response, err := wrapper.GetContractExecutionStats(sdk.WrapSDKContext(ctx), &types.QueryGetContractExecutionStatsRequest{ContractAddr: keepertest.TestContract})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.BlockStats))
+	require.Equal(t, int64(2), response.Stats.Height)
+	require.Equal(t, uint64(2), response.Stats.CodeId)
+	require.Equal(t, uint64(3), response.Stats.OrdersProcessed)
+	require.Equal(t, uint64(1), response.Stats.Failures)
+	require.Equal(t, []types.SudoCallStats{{MsgType: "bulk_order_placements", Calls: 2, GasUsed: 400, Failures: 1}}, response.Stats.SudoCallStats)
+
+	response, err = wrapper.GetContractExecutionStats(sdk.WrapSDKContext(ctx), &types.QueryGetContractExecutionStatsRequest{ContractAddr: keepertest.TestContract, CodeId: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.BlockStats))
+	require.Equal(t, uint64(1), response.Stats.CodeId)
+	require.Equal(t, uint64(100), response.Stats.TotalGasUsed())
+
+	_, err = wrapper.GetContractExecutionStats(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
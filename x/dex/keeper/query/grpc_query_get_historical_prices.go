@@ -18,8 +18,16 @@ func (k KeeperWrapper) GetHistoricalPrices(goCtx context.Context, req *types.Que
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair := types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}
+	if k.GetParams(ctx).CandleRetention > 0 {
+		if interval, ok := candleIntervalForPeriod(req.PeriodLengthInSeconds); ok {
+			return &types.QueryGetHistoricalPricesResponse{
+				Prices: k.getCandlesticksFromCandles(ctx, req.ContractAddr, pair, interval, req.PeriodLengthInSeconds, req.NumOfPeriods),
+			}, nil
+		}
+	}
 
-	prices := k.GetAllPrices(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom})
+	prices := k.GetAllPrices(ctx, req.ContractAddr, pair)
 	currentTimeStamp := uint64(ctx.BlockTime().Unix())
 	beginTimestamp := currentTimeStamp - req.NumOfPeriods*req.PeriodLengthInSeconds
 	// sort descending
@@ -117,3 +125,70 @@ func (k KeeperWrapper) GetHistoricalPrices(goCtx context.Context, req *types.Que
 		Prices: candlesticks,
 	}, nil
 }
+
+// Returns the coarsest candle interval that evenly divides the period, if any.
+func candleIntervalForPeriod(periodLengthInSeconds uint64) (uint64, bool) {
+	for i := len(types.CandleIntervalsInSeconds) - 1; i >= 0; i-- {
+		interval := types.CandleIntervalsInSeconds[i]
+		if periodLengthInSeconds > 0 && periodLengthInSeconds%interval == 0 {
+			return interval, true
+		}
+	}
+	return 0, false
+}
+
+// Builds candlesticks by merging stored candles. Periods are aligned to the candle
+// interval, with the latest period ending at the end of the in-progress candle.
+// Periods without any fill are flat at the previous close.
+func (k KeeperWrapper) getCandlesticksFromCandles(
+	ctx sdk.Context, contractAddr string, pair types.Pair, interval uint64, periodLengthInSeconds uint64, numOfPeriods uint64,
+) []*types.PriceCandlestick {
+	now := uint64(ctx.BlockTime().Unix())
+	endTimestamp := now - now%interval + interval
+	beginTimestamp := uint64(0)
+	if endTimestamp > numOfPeriods*periodLengthInSeconds {
+		beginTimestamp = endTimestamp - numOfPeriods*periodLengthInSeconds
+	}
+	lastClose := sdk.ZeroDec()
+	if candle, found := k.GetLastCandleBefore(ctx, contractAddr, pair, interval, beginTimestamp); found {
+		lastClose = candle.Close
+	}
+	candles := k.GetCandlesInRange(ctx, contractAddr, pair, interval, beginTimestamp, endTimestamp)
+
+	// build oldest first so that empty periods can carry over the previous close
+	candlesticks := make([]*types.PriceCandlestick, numOfPeriods)
+	candlePtr := 0
+	for i := int(numOfPeriods) - 1; i >= 0; i-- {
+		end := endTimestamp - uint64(i)*periodLengthInSeconds
+		begin := end - periodLengthInSeconds
+		open, high, low, close, volume := lastClose, lastClose, lastClose, lastClose, sdk.ZeroDec()
+		set := false
+		for candlePtr < len(candles) && candles[candlePtr].BeginTimestamp < end {
+			candle := candles[candlePtr]
+			if !set {
+				open, high, low = candle.Open, candle.High, candle.Low
+				set = true
+			}
+			if candle.High.GT(high) {
+				high = candle.High
+			}
+			if candle.Low.LT(low) {
+				low = candle.Low
+			}
+			close = candle.Close
+			volume = volume.Add(candle.Volume)
+			candlePtr++
+		}
+		lastClose = close
+		candlesticks[i] = &types.PriceCandlestick{
+			BeginTimestamp: begin,
+			EndTimestamp:   end,
+			Open:           &open,
+			High:           &high,
+			Low:            &low,
+			Close:          &close,
+			Volume:         &volume,
+		}
+	}
+	return candlesticks
+}
@@ -183,3 +183,41 @@ func TestDataPointsNotEarlyEnoughPartialBar(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("100"), *resp.Prices[1].Low)
 	require.Equal(t, sdk.MustNewDecFromStr("100"), *resp.Prices[1].Close)
 }
+
+func TestHistoricalPricesFromCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	pair := types.Pair{PriceDenom: keepertest.TestPair.PriceDenom, AssetDenom: keepertest.TestPair.AssetDenom}
+	trade := func(price string, quantity string) *types.Trade {
+		return &types.Trade{Price: sdk.MustNewDecFromStr(price), Quantity: sdk.MustNewDecFromStr(quantity)}
+	}
+	ctx = ctx.WithBlockTime(time.Unix(30, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{trade("100", "1")})
+	ctx = ctx.WithBlockTime(time.Unix(130, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{trade("102", "2"), trade("101", "1")})
+
+	ctx = ctx.WithBlockTime(time.Unix(250, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetHistoricalPrices(wctx, &types.QueryGetHistoricalPricesRequest{
+		ContractAddr:          keepertest.TestContract,
+		PriceDenom:            keepertest.TestPair.PriceDenom,
+		AssetDenom:            keepertest.TestPair.AssetDenom,
+		PeriodLengthInSeconds: 120,
+		NumOfPeriods:          2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Prices))
+	// the latest period ends at the end of the in-progress minute and has no fill
+	require.Equal(t, uint64(180), resp.Prices[0].BeginTimestamp)
+	require.Equal(t, uint64(300), resp.Prices[0].EndTimestamp)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[0].Open)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[0].Close)
+	require.Equal(t, sdk.ZeroDec(), *resp.Prices[0].Volume)
+	require.Equal(t, uint64(60), resp.Prices[1].BeginTimestamp)
+	require.Equal(t, uint64(180), resp.Prices[1].EndTimestamp)
+	require.Equal(t, sdk.MustNewDecFromStr("102"), *resp.Prices[1].Open)
+	require.Equal(t, sdk.MustNewDecFromStr("102"), *resp.Prices[1].High)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[1].Low)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[1].Close)
+	require.Equal(t, sdk.MustNewDecFromStr("3"), *resp.Prices[1].Volume)
+}
@@ -15,9 +15,61 @@ func (k KeeperWrapper) GetMarketSummary(goCtx context.Context, req *types.QueryG
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair := types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}
+	if retention := k.GetParams(ctx).CandleRetention; retention > 0 {
+		return k.getMarketSummaryFromCandles(ctx, req.ContractAddr, pair, req.LookbackInSeconds, retention), nil
+	}
+	return k.getMarketSummaryFromPriceSnapshots(ctx, req.ContractAddr, pair, req.LookbackInSeconds), nil
+}
+
+// Summarizes the candles of the finest interval that is still retained for the whole
+// lookback window. The oldest candle is counted in full, so the summary may include
+// up to one interval worth of fills from before the window.
+func (k KeeperWrapper) getMarketSummaryFromCandles(
+	ctx sdk.Context, contractAddr string, pair types.Pair, lookbackInSeconds uint64, retention uint64,
+) *types.QueryGetMarketSummaryResponse {
+	interval := types.CandleIntervalsInSeconds[len(types.CandleIntervalsInSeconds)-1]
+	for _, candidate := range types.CandleIntervalsInSeconds {
+		if candidate*retention >= lookbackInSeconds {
+			interval = candidate
+			break
+		}
+	}
+	now := uint64(ctx.BlockTime().Unix())
+	cutoff := uint64(0)
+	if now > lookbackInSeconds {
+		cutoff = now - lookbackInSeconds
+	}
+
+	totalVolume, totalVolumeNotional := sdk.ZeroDec(), sdk.ZeroDec()
+	maxPrice, minPrice, lastPrice := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for _, candle := range k.GetCandlesInRange(ctx, contractAddr, pair, interval, cutoff-cutoff%interval, now+1) {
+		totalVolume = totalVolume.Add(candle.Volume)
+		totalVolumeNotional = totalVolumeNotional.Add(candle.VolumeNotional)
+		if maxPrice.IsZero() || candle.High.GT(maxPrice) {
+			maxPrice = candle.High
+		}
+		if minPrice.IsZero() || candle.Low.LT(minPrice) {
+			minPrice = candle.Low
+		}
+		lastPrice = candle.Close
+	}
 
-	prices := k.GetAllPrices(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom})
-	cutoff := ctx.BlockTime().Unix() - int64(req.LookbackInSeconds)
+	return &types.QueryGetMarketSummaryResponse{
+		TotalVolume:         &totalVolume,
+		TotalVolumeNotional: &totalVolumeNotional,
+		HighPrice:           &maxPrice,
+		LowPrice:            &minPrice,
+		LastPrice:           &lastPrice,
+	}
+}
+
+// Volume is not available without candles, so only prices are summarized.
+func (k KeeperWrapper) getMarketSummaryFromPriceSnapshots(
+	ctx sdk.Context, contractAddr string, pair types.Pair, lookbackInSeconds uint64,
+) *types.QueryGetMarketSummaryResponse {
+	prices := k.GetAllPrices(ctx, contractAddr, pair)
+	cutoff := ctx.BlockTime().Unix() - int64(lookbackInSeconds)
 	maxPrice := sdk.ZeroDec()
 	minPrice := sdk.ZeroDec()
 	latestTimestamp := 0
@@ -40,10 +92,10 @@ func (k KeeperWrapper) GetMarketSummary(goCtx context.Context, req *types.QueryG
 
 	zero := sdk.ZeroDec()
 	return &types.QueryGetMarketSummaryResponse{
-		TotalVolume:         &zero, // TODO: replace once we start tracking volume
-		TotalVolumeNotional: &zero, // TODO: replace once we start tracking volume
+		TotalVolume:         &zero,
+		TotalVolumeNotional: &zero,
 		HighPrice:           &maxPrice,
 		LowPrice:            &minPrice,
 		LastPrice:           &lastPrice,
-	}, nil
+	}
 }
@@ -13,6 +13,10 @@ import (
 
 func TestGetMarketSummary(t *testing.T) {
 	keeper, ctx := keepertest.DexKeeper(t)
+	// without candles the summary falls back to price snapshots
+	params := keeper.GetParams(ctx)
+	params.CandleRetention = 0
+	keeper.SetParams(ctx, params)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "101", 2)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "99", 3)
@@ -31,3 +35,34 @@ func TestGetMarketSummary(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("99"), *resp.LastPrice)
 	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.HighPrice)
 }
+
+func TestGetMarketSummaryFromCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	pair := types.Pair{PriceDenom: keepertest.TestPair.PriceDenom, AssetDenom: keepertest.TestPair.AssetDenom}
+	trade := func(price string, quantity string) *types.Trade {
+		return &types.Trade{Price: sdk.MustNewDecFromStr(price), Quantity: sdk.MustNewDecFromStr(quantity)}
+	}
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{trade("100", "2")})
+	ctx = ctx.WithBlockTime(time.Unix(200, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{trade("101", "1"), trade("99", "3")})
+	ctx = ctx.WithBlockTime(time.Unix(250, 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, pair, []*types.Trade{trade("98", "1")})
+
+	ctx = ctx.WithBlockTime(time.Unix(260, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetMarketSummary(wctx, &types.QueryGetMarketSummaryRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		LookbackInSeconds: 120,
+	})
+	require.Nil(t, err)
+	// the fill at 100 is outside of the lookback window
+	require.Equal(t, sdk.MustNewDecFromStr("5"), *resp.TotalVolume)
+	require.Equal(t, sdk.MustNewDecFromStr("496"), *resp.TotalVolumeNotional)
+	require.Equal(t, sdk.MustNewDecFromStr("98"), *resp.LowPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("98"), *resp.LastPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.HighPrice)
+}
@@ -0,0 +1,26 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetHaltedPairs(c context.Context, req *types.QueryGetHaltedPairsRequest) (*types.QueryGetHaltedPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	haltedPairs := []types.Pair{}
+	for _, pair := range k.GetAllRegisteredPairs(ctx, req.ContractAddr) {
+		if pair.Halted {
+			haltedPairs = append(haltedPairs, pair)
+		}
+	}
+
+	return &types.QueryGetHaltedPairsResponse{Pairs: haltedPairs}, nil
+}
@@ -0,0 +1,32 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaltedPairsQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "uatom"})
+
+	request := types.QueryGetHaltedPairsRequest{ContractAddr: keepertest.TestContract}
+	response, err := wrapper.GetHaltedPairs(wctx, &request)
+	require.NoError(t, err)
+	require.Empty(t, response.Pairs)
+
+	require.NoError(t, keeper.SetPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, "uatom", true))
+	response, err = wrapper.GetHaltedPairs(wctx, &request)
+	require.NoError(t, err)
+	require.Equal(t, []types.Pair{{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "uatom", Halted: true}}, response.Pairs)
+
+	_, err = wrapper.GetHaltedPairs(wctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultOrderBookDepthLevels     = 10
+	MaxOrderBookDepthLevels         = 100
+	MaxOrderBookDepthTickMultiplier = 1000000
+)
+
+func (k KeeperWrapper) GetOrderBookDepth(c context.Context, req *types.QueryGetOrderBookDepthRequest) (*types.QueryGetOrderBookDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Levels > MaxOrderBookDepthLevels {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d levels can be queried", MaxOrderBookDepthLevels)
+	}
+	if req.TickMultiplier > MaxOrderBookDepthTickMultiplier {
+		return nil, status.Errorf(codes.InvalidArgument, "tick multiplier can be at most %d", MaxOrderBookDepthTickMultiplier)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair := types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}
+	tickSize, found := k.GetPriceTickSizeForPair(ctx, req.ContractAddr, pair)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrPairNotRegistered.Error())
+	}
+	levels := int(req.Levels)
+	if levels == 0 {
+		levels = DefaultOrderBookDepthLevels
+	}
+	bucketSize := tickSize
+	if req.TickMultiplier > 1 {
+		bucketSize = tickSize.MulInt64(int64(req.TickMultiplier))
+	}
+	if !bucketSize.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "the pair has no positive price tick size to aggregate by")
+	}
+
+	longs, shorts := k.GetDepthLevelsForPair(ctx, req.ContractAddr, pair, levels, bucketSize)
+	return &types.QueryGetOrderBookDepthResponse{
+		Longs:  longs,
+		Shorts: shorts,
+		Height: ctx.BlockHeight(),
+	}, nil
+}
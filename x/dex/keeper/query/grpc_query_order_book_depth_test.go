@@ -0,0 +1,121 @@
+package query_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetOrderBookDepth(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, sdk.MustNewDecFromStr("0.5"))
+	newEntry := func(price string, quantities ...int64) *types.OrderEntry {
+		entry := &types.OrderEntry{
+			Price:      sdk.MustNewDecFromStr(price),
+			Quantity:   sdk.ZeroDec(),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+		}
+		for i, quantity := range quantities {
+			entry.Quantity = entry.Quantity.Add(sdk.NewDec(quantity))
+			entry.Allocations = append(entry.Allocations, &types.Allocation{OrderId: uint64(i), Account: keepertest.TestAccount, Quantity: sdk.NewDec(quantity)})
+		}
+		return entry
+	}
+	for _, entry := range []*types.OrderEntry{newEntry("9", 1), newEntry("9.5", 2, 3), newEntry("10", 4), newEntry("8", 5)} {
+		keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: entry.Price, Entry: entry})
+	}
+	for _, entry := range []*types.OrderEntry{newEntry("11", 1), newEntry("11.5", 2), newEntry("13", 3)} {
+		keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{Price: entry.Price, Entry: entry})
+	}
+
+	// one level per tick
+	resp, err := wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		Levels:       2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.DepthLevel{
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(4), OrderCount: 1},
+		{Price: sdk.MustNewDecFromStr("9.5"), Quantity: sdk.NewDec(5), OrderCount: 2},
+	}, resp.Longs)
+	require.Equal(t, []*types.DepthLevel{
+		{Price: sdk.NewDec(11), Quantity: sdk.NewDec(1), OrderCount: 1},
+		{Price: sdk.MustNewDecFromStr("11.5"), Quantity: sdk.NewDec(2), OrderCount: 1},
+	}, resp.Shorts)
+	require.Equal(t, ctx.BlockHeight(), resp.Height)
+
+	// levels of 2 ticks, longs are rounded down and shorts up
+	resp, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr:   keepertest.TestContract,
+		PriceDenom:     keepertest.TestPriceDenom,
+		AssetDenom:     keepertest.TestAssetDenom,
+		TickMultiplier: 2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.DepthLevel{
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(4), OrderCount: 1},
+		{Price: sdk.NewDec(9), Quantity: sdk.NewDec(6), OrderCount: 3},
+		{Price: sdk.NewDec(8), Quantity: sdk.NewDec(5), OrderCount: 1},
+	}, resp.Longs)
+	require.Equal(t, []*types.DepthLevel{
+		{Price: sdk.NewDec(11), Quantity: sdk.NewDec(1), OrderCount: 1},
+		{Price: sdk.NewDec(12), Quantity: sdk.NewDec(2), OrderCount: 1},
+		{Price: sdk.NewDec(13), Quantity: sdk.NewDec(3), OrderCount: 1},
+	}, resp.Shorts)
+}
+
+func TestGetOrderBookDepthInvalidRequests(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// unregistered pair
+	_, err := wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.NotNil(t, err)
+
+	// too many levels
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, sdk.OneDec())
+	_, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		Levels:       query.MaxOrderBookDepthLevels + 1,
+	})
+	require.NotNil(t, err)
+
+	// tick multiplier too large
+	_, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr:   keepertest.TestContract,
+		PriceDenom:     keepertest.TestPriceDenom,
+		AssetDenom:     keepertest.TestAssetDenom,
+		TickMultiplier: math.MaxUint64,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// zero tick size
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, sdk.ZeroDec())
+	_, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -20,57 +20,148 @@ type priceQuantity struct {
 // Note that this simulation is only accurate if it's called as part of the main Sei process (e.g. in Begin/EndBlock, transaction handler
 // or contract querier), because it needs to access dex's in-memory state.
 func (k KeeperWrapper) GetOrderSimulation(c context.Context, req *types.QueryOrderSimulationRequest) (*types.QueryOrderSimulationResponse, error) {
-	if req == nil {
+	if req == nil || req.Order == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	matchedPriceQuantities := k.getMatchedPriceQuantities(ctx, req)
-	executedQuantity := sdk.ZeroDec()
-	for _, pq := range matchedPriceQuantities {
-		if executedQuantity.Add(pq.quantity).GTE(req.Order.Quantity) {
-			executedQuantity = req.Order.Quantity
-			break
+	levels := k.getMatchablePriceQuantities(ctx, req)
+	taken, executable := matchPriceQuantities(req.Order, levels)
+
+	fills := []types.SimulatedFill{}
+	executedQuantity, executedNotional, worstPrice := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	if executable {
+		for i, quantity := range taken {
+			if !quantity.IsPositive() {
+				continue
+			}
+			fills = append(fills, types.SimulatedFill{Price: levels[i].price, Quantity: quantity})
+			executedQuantity = executedQuantity.Add(quantity)
+			executedNotional = executedNotional.Add(quantity.Mul(levels[i].price))
+			worstPrice = levels[i].price
 		}
-		executedQuantity = executedQuantity.Add(pq.quantity)
+	}
+	averagePrice := sdk.ZeroDec()
+	if executedQuantity.IsPositive() {
+		averagePrice = executedNotional.Quo(executedQuantity)
+	}
+	remainingQuantity := req.Order.Quantity.Sub(executedQuantity)
+	if req.Order.OrderType == types.OrderType_FOKMARKETBYVALUE && executedQuantity.IsPositive() {
+		// the quantity of a by-value order only caps how much it may buy or sell
+		remainingQuantity = sdk.ZeroDec()
 	}
 	return &types.QueryOrderSimulationResponse{
-		ExecutedQuantity: &executedQuantity,
+		ExecutedQuantity:  &executedQuantity,
+		Fills:             fills,
+		AveragePrice:      averagePrice,
+		WorstPrice:        worstPrice,
+		RemainingQuantity: sdk.MaxDec(remainingQuantity, sdk.ZeroDec()),
+		ExecutedNotional:  executedNotional,
 	}, nil
 }
 
-func (k KeeperWrapper) getMatchedPriceQuantities(ctx sdk.Context, req *types.QueryOrderSimulationRequest) []priceQuantity {
+// Returns the opposite side of the book, sorted from the best price to the worst, as it would look
+// when the simulated order gets matched at the end of the current block.
+func (k KeeperWrapper) getMatchablePriceQuantities(ctx sdk.Context, req *types.QueryOrderSimulationRequest) []priceQuantity {
 	orderDirection := req.Order.PositionDirection
+	oppositeDirection := types.PositionDirection_LONG
+	if orderDirection == types.PositionDirection_LONG {
+		oppositeDirection = types.PositionDirection_SHORT
+	}
+	priceQuantities := k.getBlockPriceQuantities(ctx, req, oppositeDirection)
+
+	// exclude liquidity to be taken by orders of this block that get matched before the simulated order
+	pair := types.Pair{PriceDenom: req.Order.PriceDenom, AssetDenom: req.Order.AssetDenom}
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(req.ContractAddr), pair)
+	ordersMatchedBefore := blockOrders.GetSortedMarketOrders(orderDirection)
+	if isMarketOrderType(req.Order.OrderType) {
+		ordersMatchedBefore = getMarketOrdersMatchedBefore(req.Order, ordersMatchedBefore)
+	} else {
+		// Market orders are matched before limit orders, which are then matched by price and time against
+		// the opposite side. The simulated order is the newest, so it comes last among equal prices.
+		for _, pq := range k.getBlockPriceQuantities(ctx, req, orderDirection) {
+			order := &types.Order{
+				Price:             pq.price,
+				Quantity:          pq.quantity,
+				PositionDirection: orderDirection,
+				OrderType:         types.OrderType_LIMIT,
+			}
+			if !isWithinWorstPrice(order, req.Order.Price) {
+				break
+			}
+			ordersMatchedBefore = append(ordersMatchedBefore, order)
+		}
+	}
+	for _, order := range ordersMatchedBefore {
+		taken, executable := matchPriceQuantities(order, priceQuantities)
+		if !executable {
+			continue
+		}
+		for i, quantity := range taken {
+			priceQuantities[i].quantity = priceQuantities[i].quantity.Sub(quantity)
+		}
+	}
+	return priceQuantities
+}
+
+// Returns one side of the book, sorted from the best price to the worst, after the cancellations,
+// amendments and limit orders of the current block have been applied to it.
+func (k KeeperWrapper) getBlockPriceQuantities(ctx sdk.Context, req *types.QueryOrderSimulationRequest, direction types.PositionDirection) []priceQuantity {
 	// get existing liquidity
 	eligibleOrderBookPriceToQuantity := map[string]sdk.Dec{}
-	if orderDirection == types.PositionDirection_SHORT {
+	getAllocation := k.GetLongAllocationForOrderID
+	if direction == types.PositionDirection_LONG {
 		for _, lb := range k.GetAllLongBookForPair(ctx, req.ContractAddr, req.Order.PriceDenom, req.Order.AssetDenom) {
-			if req.Order.Price.IsZero() || req.Order.Price.LTE(lb.GetPrice()) {
-				eligibleOrderBookPriceToQuantity[lb.GetPrice().String()] = lb.GetOrderEntry().Quantity
-			}
+			eligibleOrderBookPriceToQuantity[lb.GetPrice().String()] = lb.GetOrderEntry().Quantity
 		}
 	} else {
 		for _, sb := range k.GetAllShortBookForPair(ctx, req.ContractAddr, req.Order.PriceDenom, req.Order.AssetDenom) {
-			if req.Order.Price.IsZero() || req.Order.Price.GTE(sb.GetPrice()) {
-				eligibleOrderBookPriceToQuantity[sb.GetPrice().String()] = sb.GetOrderEntry().Quantity
-			}
+			eligibleOrderBookPriceToQuantity[sb.GetPrice().String()] = sb.GetOrderEntry().Quantity
 		}
+		getAllocation = k.GetShortAllocationForOrderID
 	}
 
 	// exclude liquidity to be cancelled
 	pair := types.Pair{PriceDenom: req.Order.PriceDenom, AssetDenom: req.Order.AssetDenom}
-	for _, cancel := range dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(req.ContractAddr), pair).Get() {
-		var cancelledAllocation *types.Allocation
-		var found bool
-		if cancel.PositionDirection == types.PositionDirection_LONG {
-			cancelledAllocation, found = k.GetLongAllocationForOrderID(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
-		} else {
-			cancelledAllocation, found = k.GetShortAllocationForOrderID(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
+	memState := dexutils.GetMemState(ctx.Context())
+	cancelledIDs := map[uint64]struct{}{}
+	for _, cancel := range memState.GetBlockCancels(ctx, types.ContractAddress(req.ContractAddr), pair).Get() {
+		if cancel.PositionDirection != direction {
+			continue
 		}
+		cancelledAllocation, found := getAllocation(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
 		if !found {
 			continue
 		}
 		if q, ok := eligibleOrderBookPriceToQuantity[cancel.Price.String()]; ok {
 			eligibleOrderBookPriceToQuantity[cancel.Price.String()] = q.Sub(cancelledAllocation.Quantity)
+			cancelledIDs[cancel.Id] = struct{}{}
+		}
+	}
+
+	// exclude liquidity to be amended away, which happens after cancellations
+	for _, amendment := range memState.GetBlockAmendments(ctx, types.ContractAddress(req.ContractAddr), pair).Get() {
+		if amendment.PositionDirection != direction {
+			continue
+		}
+		if _, cancelled := cancelledIDs[amendment.Id]; cancelled {
+			continue
+		}
+		allocation, found := getAllocation(ctx, req.ContractAddr, amendment.PriceDenom, amendment.AssetDenom, amendment.Price, amendment.Id)
+		if !found || allocation.Account != amendment.Creator || !amendment.NewQuantity.LT(allocation.Quantity) {
+			continue
+		}
+		if q, ok := eligibleOrderBookPriceToQuantity[amendment.Price.String()]; ok {
+			eligibleOrderBookPriceToQuantity[amendment.Price.String()] = q.Sub(allocation.Quantity.Sub(amendment.NewQuantity))
+		}
+	}
+
+	// include liquidity added by limit orders placed in this block, which are added to the book before
+	// any matching happens. Post-only orders are left out since they may be rejected or repriced.
+	for _, order := range memState.GetBlockOrders(ctx, types.ContractAddress(req.ContractAddr), pair).GetLimitOrders(direction) {
+		if q, ok := eligibleOrderBookPriceToQuantity[order.Price.String()]; ok {
+			eligibleOrderBookPriceToQuantity[order.Price.String()] = q.Add(order.Quantity)
+		} else {
+			eligibleOrderBookPriceToQuantity[order.Price.String()] = order.Quantity
 		}
 	}
 
@@ -81,40 +172,106 @@ func (k KeeperWrapper) getMatchedPriceQuantities(ctx sdk.Context, req *types.Que
 		}
 	}
 	sort.Slice(priceQuantities, func(i int, j int) bool {
-		if orderDirection == types.PositionDirection_SHORT {
-			// short order corresponds to long book which needs to be in descending order
+		if direction == types.PositionDirection_LONG {
+			// long book needs to be in descending order
 			return priceQuantities[i].price.GT(priceQuantities[j].price)
 		}
-		// long order corresponds to long book which needs to be in ascending order
+		// short book needs to be in ascending order
 		return priceQuantities[i].price.LT(priceQuantities[j].price)
 	})
+	return priceQuantities
+}
 
-	// exclude liquidity to be taken
-	ptr := 0
-	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(req.ContractAddr), pair).GetSortedMarketOrders(orderDirection) {
+// Returns the market orders of the block that take liquidity before the simulated market order.
+func getMarketOrdersMatchedBefore(simulated *types.Order, sortedMarketOrders []*types.Order) []*types.Order {
+	res := []*types.Order{}
+	for _, order := range sortedMarketOrders {
 		// If existing market order has price zero, it means it doesn't specify a worst price and will always have precedence over the simulated
 		// order
 		if !order.Price.IsZero() {
 			// If the simulated order doesn't specify a worst price, no existing order with a worst price will take liquidity from it
-			if req.Order.Price.IsZero() {
-				break
-			}
-			if orderDirection == types.PositionDirection_LONG && order.Price.LT(req.Order.Price) {
-				break
-			}
-			if orderDirection == types.PositionDirection_SHORT && order.Price.GT(req.Order.Price) {
+			if simulated.Price.IsZero() || !isWithinWorstPrice(order, simulated.Price) {
 				break
 			}
 		}
-		remainingQuantity := order.Quantity
-		for ptr < len(priceQuantities) {
-			if remainingQuantity.LTE(priceQuantities[ptr].quantity) {
-				priceQuantities[ptr].quantity = priceQuantities[ptr].quantity.Sub(remainingQuantity)
-				break
-			}
-			remainingQuantity = remainingQuantity.Sub(priceQuantities[ptr].quantity)
-			ptr++
+		res = append(res, order)
+	}
+	return res
+}
+
+func isMarketOrderType(orderType types.OrderType) bool {
+	switch orderType {
+	case types.OrderType_MARKET, types.OrderType_FOKMARKET, types.OrderType_FOKMARKETBYVALUE, types.OrderType_IOC:
+		return true
+	default:
+		return false
+	}
+}
+
+// Returns the quantity the order would take from each of the sorted price levels, and whether the order
+// would be executed at all. Fill-or-kill orders are only executed if they can be filled in full, and orders
+// that never match upon placement (i.e. post-only and stop orders) are never executed.
+func matchPriceQuantities(order *types.Order, priceQuantities []priceQuantity) ([]sdk.Dec, bool) {
+	taken := make([]sdk.Dec, len(priceQuantities))
+	for i := range taken {
+		taken[i] = sdk.ZeroDec()
+	}
+	switch order.OrderType {
+	case types.OrderType_LIMIT, types.OrderType_MARKET, types.OrderType_FOKMARKET, types.OrderType_IOC:
+	case types.OrderType_FOKMARKETBYVALUE:
+		return matchPriceQuantitiesByValue(order, priceQuantities, taken)
+	default:
+		return taken, false
+	}
+
+	remainingQuantity := order.Quantity
+	for i, pq := range priceQuantities {
+		if remainingQuantity.IsZero() || !isWithinWorstPrice(order, pq.price) {
+			break
+		}
+		if !pq.quantity.IsPositive() {
+			continue
+		}
+		taken[i] = sdk.MinDec(remainingQuantity, pq.quantity)
+		remainingQuantity = remainingQuantity.Sub(taken[i])
+	}
+	if order.OrderType == types.OrderType_FOKMARKET && remainingQuantity.IsPositive() {
+		return taken, false
+	}
+	return taken, true
+}
+
+// FOKMARKETBYVALUE orders spend their nominal on the book and are killed if the nominal can't be spent in
+// full, or if doing so would buy or sell more than the order's quantity.
+func matchPriceQuantitiesByValue(order *types.Order, priceQuantities []priceQuantity, taken []sdk.Dec) ([]sdk.Dec, bool) {
+	remainingFund := order.Nominal
+	remainingQuantity := order.Quantity
+	for i, pq := range priceQuantities {
+		if !remainingFund.IsPositive() || !remainingQuantity.IsPositive() || !isWithinWorstPrice(order, pq.price) {
+			break
 		}
+		if !pq.quantity.IsPositive() {
+			continue
+		}
+		if remainingFund.LTE(pq.quantity.Mul(pq.price)) {
+			taken[i] = remainingFund.Quo(pq.price)
+			remainingFund = sdk.ZeroDec()
+		} else {
+			taken[i] = pq.quantity
+			remainingFund = remainingFund.Sub(pq.quantity.Mul(pq.price))
+		}
+		remainingQuantity = remainingQuantity.Sub(taken[i])
+	}
+	return taken, remainingFund.IsZero() && !remainingQuantity.IsNegative()
+}
+
+// A price of zero means the order doesn't specify a worst price
+func isWithinWorstPrice(order *types.Order, price sdk.Dec) bool {
+	if order.Price.IsZero() {
+		return true
+	}
+	if order.PositionDirection == types.PositionDirection_LONG {
+		return order.Price.GTE(price)
 	}
-	return priceQuantities[ptr:]
+	return order.Price.LTE(price)
 }
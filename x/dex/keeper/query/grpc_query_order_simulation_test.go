@@ -99,3 +99,131 @@ func TestGetOrderSimulation(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.ZeroDec(), *res.ExecutedQuantity)
 }
+
+func TestGetOrderSimulationFills(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	for _, pq := range []struct {
+		price    string
+		quantity string
+	}{{"8", "1"}, {"9", "2"}, {"11", "5"}} {
+		keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+			Price: sdk.MustNewDecFromStr(pq.price),
+			Entry: &types.OrderEntry{
+				Price:      sdk.MustNewDecFromStr(pq.price),
+				Quantity:   sdk.MustNewDecFromStr(pq.quantity),
+				PriceDenom: keepertest.TestPriceDenom,
+				AssetDenom: keepertest.TestAssetDenom,
+				Allocations: []*types.Allocation{
+					{Account: keepertest.TestAccount, Quantity: sdk.MustNewDecFromStr(pq.quantity), OrderId: 1},
+				},
+			},
+		})
+	}
+	simulate := func(order types.Order) *types.QueryOrderSimulationResponse {
+		order.Account = keepertest.TestAccount
+		order.ContractAddr = keepertest.TestContract
+		order.PriceDenom = keepertest.TestPriceDenom
+		order.AssetDenom = keepertest.TestAssetDenom
+		order.PositionDirection = types.PositionDirection_LONG
+		res, err := wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &order, ContractAddr: keepertest.TestContract})
+		require.Nil(t, err)
+		return res
+	}
+
+	// limit order walks the book up to its price
+	res := simulate(types.Order{Price: sdk.MustNewDecFromStr("10"), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_LIMIT})
+	require.Equal(t, []types.SimulatedFill{
+		{Price: sdk.MustNewDecFromStr("8"), Quantity: sdk.MustNewDecFromStr("1")},
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.MustNewDecFromStr("2")},
+	}, res.Fills)
+	require.Equal(t, sdk.MustNewDecFromStr("3"), *res.ExecutedQuantity)
+	require.Equal(t, sdk.MustNewDecFromStr("26"), res.ExecutedNotional)
+	require.Equal(t, sdk.MustNewDecFromStr("26").Quo(sdk.MustNewDecFromStr("3")), res.AveragePrice)
+	require.Equal(t, sdk.MustNewDecFromStr("9"), res.WorstPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("2"), res.RemainingQuantity)
+
+	// market order without a worst price walks the whole book
+	res = simulate(types.Order{Price: sdk.ZeroDec(), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_MARKET})
+	require.Equal(t, 3, len(res.Fills))
+	require.Equal(t, sdk.MustNewDecFromStr("11"), res.WorstPrice)
+	require.True(t, res.RemainingQuantity.IsZero())
+
+	// fill-or-kill order that can't be filled in full is not executed
+	res = simulate(types.Order{Price: sdk.MustNewDecFromStr("10"), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_FOKMARKET})
+	require.Empty(t, res.Fills)
+	require.True(t, res.ExecutedQuantity.IsZero())
+	require.True(t, res.AveragePrice.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("5"), res.RemainingQuantity)
+
+	// by-value order spends its nominal
+	res = simulate(types.Order{Price: sdk.ZeroDec(), Quantity: sdk.MustNewDecFromStr("10"), Nominal: sdk.MustNewDecFromStr("17"), OrderType: types.OrderType_FOKMARKETBYVALUE})
+	require.Equal(t, []types.SimulatedFill{
+		{Price: sdk.MustNewDecFromStr("8"), Quantity: sdk.MustNewDecFromStr("1")},
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.MustNewDecFromStr("1")},
+	}, res.Fills)
+	require.Equal(t, sdk.MustNewDecFromStr("17"), res.ExecutedNotional)
+	require.True(t, res.RemainingQuantity.IsZero())
+
+	// by-value order is killed if its nominal can't be spent, or if it would exceed its quantity
+	res = simulate(types.Order{Price: sdk.ZeroDec(), Quantity: sdk.MustNewDecFromStr("10"), Nominal: sdk.MustNewDecFromStr("100"), OrderType: types.OrderType_FOKMARKETBYVALUE})
+	require.Empty(t, res.Fills)
+	res = simulate(types.Order{Price: sdk.ZeroDec(), Quantity: sdk.MustNewDecFromStr("1"), Nominal: sdk.MustNewDecFromStr("17"), OrderType: types.OrderType_FOKMARKETBYVALUE})
+	require.Empty(t, res.Fills)
+
+	// post-only orders never take liquidity
+	res = simulate(types.Order{Price: sdk.MustNewDecFromStr("10"), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_POSTONLY})
+	require.Empty(t, res.Fills)
+
+	// limit orders placed in this block add liquidity, and amendments remove it
+	pair := keepertest.TestPair
+	memState := dexutils.GetMemState(ctx.Context())
+	memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(&types.Order{
+		Id:                2,
+		Account:           keepertest.TestAccount,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		Price:             sdk.MustNewDecFromStr("9.5"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PositionDirection: types.PositionDirection_SHORT,
+		OrderType:         types.OrderType_LIMIT,
+	})
+	memState.GetBlockAmendments(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(&types.Amendment{
+		Id:                1,
+		Creator:           keepertest.TestAccount,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		PositionDirection: types.PositionDirection_SHORT,
+		Price:             sdk.MustNewDecFromStr("9"),
+		NewPrice:          sdk.MustNewDecFromStr("9"),
+		NewQuantity:       sdk.MustNewDecFromStr("1.5"),
+	})
+	res = simulate(types.Order{Price: sdk.MustNewDecFromStr("10"), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_LIMIT})
+	require.Equal(t, []types.SimulatedFill{
+		{Price: sdk.MustNewDecFromStr("8"), Quantity: sdk.MustNewDecFromStr("1")},
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.MustNewDecFromStr("1.5")},
+		{Price: sdk.MustNewDecFromStr("9.5"), Quantity: sdk.MustNewDecFromStr("1")},
+	}, res.Fills)
+
+	// limit orders of this block with a better price are matched first
+	memState.GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(&types.Order{
+		Id:                3,
+		Account:           keepertest.TestAccount,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		Price:             sdk.MustNewDecFromStr("10.5"),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_LIMIT,
+	})
+	res = simulate(types.Order{Price: sdk.MustNewDecFromStr("10"), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_LIMIT})
+	require.Equal(t, []types.SimulatedFill{
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.MustNewDecFromStr("0.5")},
+		{Price: sdk.MustNewDecFromStr("9.5"), Quantity: sdk.MustNewDecFromStr("1")},
+	}, res.Fills)
+	// but not before market orders
+	res = simulate(types.Order{Price: sdk.MustNewDecFromStr("10"), Quantity: sdk.MustNewDecFromStr("5"), OrderType: types.OrderType_MARKET})
+	require.Equal(t, 3, len(res.Fills))
+}
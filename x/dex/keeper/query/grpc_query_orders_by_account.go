@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetOrdersByAccount(c context.Context, req *types.QueryGetOrdersByAccountRequest) (*types.QueryGetOrdersByAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders, pageRes, err := k.GetAccountOrdersPaginated(ctx, req.ContractAddr, req.Account, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the index only tracks which orders are open; the remaining quantity lives on the book
+	for _, order := range orders {
+		var allocation *types.Allocation
+		var found bool
+		if order.PositionDirection == types.PositionDirection_LONG {
+			allocation, found = k.GetLongAllocationForOrderID(ctx, req.ContractAddr, order.PriceDenom, order.AssetDenom, order.Price, order.Id)
+		} else {
+			allocation, found = k.GetShortAllocationForOrderID(ctx, req.ContractAddr, order.PriceDenom, order.AssetDenom, order.Price, order.Id)
+		}
+		if found {
+			order.Quantity = allocation.Quantity
+		}
+	}
+
+	return &types.QueryGetOrdersByAccountResponse{Orders: orders, Pagination: pageRes}, nil
+}
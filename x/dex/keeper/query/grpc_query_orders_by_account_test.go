@@ -0,0 +1,49 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrdersByAccount(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+				{OrderId: 2, Account: "other", Quantity: sdk.NewDec(2)},
+			},
+		},
+	})
+	keeper.IndexAllRestingOrdersForContract(ctx, keepertest.TestContract)
+	// partially filled since it was indexed
+	allocation, _ := keeper.GetLongAllocationForOrderID(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, sdk.NewDec(10), 1)
+	allocation.Quantity = sdk.MustNewDecFromStr("0.5")
+	longBook, _ := keeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(10), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	longBook.Entry.Allocations[0] = allocation
+	keeper.SetLongBook(ctx, keepertest.TestContract, longBook)
+
+	resp, err := wrapper.GetOrdersByAccount(wctx, &types.QueryGetOrdersByAccountRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Orders))
+	require.Equal(t, uint64(1), resp.Orders[0].Id)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), resp.Orders[0].Quantity)
+
+	_, err = wrapper.GetOrdersByAccount(wctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetTradesByPair(c context.Context, req *types.QueryGetTradesByPairRequest) (*types.QueryGetTradesByPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndHeight > 0 && req.StartHeight > req.EndHeight {
+		return nil, status.Error(codes.InvalidArgument, "start height must not be greater than end height")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	trades, pageRes, err := k.GetTradesByPairPaginated(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, req.StartHeight, req.EndHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetTradesByPairResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+func (k KeeperWrapper) GetTradesByAccount(c context.Context, req *types.QueryGetTradesByAccountRequest) (*types.QueryGetTradesByAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndHeight > 0 && req.StartHeight > req.EndHeight {
+		return nil, status.Error(codes.InvalidArgument, "start height must not be greater than end height")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	trades, pageRes, err := k.GetTradesByAccountPaginated(ctx, req.ContractAddr, req.Account, req.StartHeight, req.EndHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetTradesByAccountResponse{Trades: trades, Pagination: pageRes}, nil
+}
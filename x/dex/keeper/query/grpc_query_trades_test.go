@@ -0,0 +1,62 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrades(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	params := keeper.GetParams(ctx)
+	params.TradeLogRetention = 100
+	keeper.SetParams(ctx, params)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	for height := uint64(1); height <= 3; height++ {
+		keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{{
+			PriceDenom:   pair.PriceDenom,
+			AssetDenom:   pair.AssetDenom,
+			Height:       height,
+			TakerOrderId: height,
+			TakerAccount: keepertest.TestAccount,
+			MakerAccount: "other",
+			Price:        sdk.NewDec(10),
+			Quantity:     sdk.NewDec(1),
+		}})
+	}
+
+	pairResp, err := wrapper.GetTradesByPair(wctx, &types.QueryGetTradesByPairRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   pair.PriceDenom,
+		AssetDenom:   pair.AssetDenom,
+		StartHeight:  2,
+		Pagination:   &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pairResp.Trades))
+	require.Equal(t, uint64(2), pairResp.Trades[0].TakerOrderId)
+	require.Equal(t, uint64(2), pairResp.Pagination.Total)
+
+	accountResp, err := wrapper.GetTradesByAccount(wctx, &types.QueryGetTradesByAccountRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      "other",
+		EndHeight:    2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(accountResp.Trades))
+
+	_, err = wrapper.GetTradesByAccount(wctx, &types.QueryGetTradesByAccountRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      "other",
+		StartHeight:  3,
+		EndHeight:    2,
+	})
+	require.Error(t, err)
+}
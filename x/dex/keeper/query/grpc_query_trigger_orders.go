@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetUntriggeredOrders(c context.Context, req *types.QueryGetUntriggeredOrdersRequest) (*types.QueryGetUntriggeredOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders, pageRes, err := k.GetAllTriggerOrdersForPairPaginated(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetUntriggeredOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (k KeeperWrapper) GetTriggeredOrders(c context.Context, req *types.QueryGetTriggeredOrdersRequest) (*types.QueryGetTriggeredOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders, pageRes, err := k.GetAllTriggeredOrdersForPairPaginated(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetTriggeredOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
@@ -0,0 +1,54 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTriggerOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	untriggered := types.Order{
+		Id:           1,
+		Account:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		Price:        sdk.NewDec(10),
+		Quantity:     sdk.NewDec(1),
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		OrderType:    types.OrderType_STOPLOSS,
+		TriggerPrice: sdk.NewDec(11),
+		Nominal:      sdk.ZeroDec(),
+	}
+	triggered := untriggered
+	triggered.Id = 2
+	triggered.OrderType = types.OrderType_MARKET
+	triggered.TriggerStatus = true
+	keeper.SetTriggerOrder(ctx, keepertest.TestContract, untriggered)
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, triggered)
+
+	untriggeredResp, err := wrapper.GetUntriggeredOrders(wctx, &types.QueryGetUntriggeredOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.Order{&untriggered}, untriggeredResp.Orders)
+
+	triggeredResp, err := wrapper.GetTriggeredOrders(wctx, &types.QueryGetTriggeredOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*types.Order{&triggered}, triggeredResp.Orders)
+
+	_, err = wrapper.GetTriggeredOrders(wctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k Keeper) SetRentTopUp(ctx sdk.Context, topUp types.RentTopUp) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RentTopUpPrefix(topUp.ContractAddr), k.Cdc.MustMarshal(&topUp))
+}
+
+func (k Keeper) GetRentTopUp(ctx sdk.Context, contractAddr string) (types.RentTopUp, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RentTopUpPrefix(contractAddr))
+	topUp := types.RentTopUp{}
+	if bz == nil {
+		return topUp, false
+	}
+	k.Cdc.MustUnmarshal(bz, &topUp)
+	return topUp, true
+}
+
+func (k Keeper) DeleteRentTopUp(ctx sdk.Context, contractAddr string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RentTopUpPrefix(contractAddr))
+}
+
+func (k Keeper) GetAllRentTopUps(ctx sdk.Context) []types.RentTopUp {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RentTopUpKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	list := []types.RentTopUp{}
+	for ; iterator.Valid(); iterator.Next() {
+		var topUp types.RentTopUp
+		k.Cdc.MustUnmarshal(iterator.Value(), &topUp)
+		list = append(list, topUp)
+	}
+	return list
+}
+
+// Credits the rent balance of `contract` from its top-up allowance, drawing at
+// least `shortfall` if the allowance permits. The drawn amount is only recorded as
+// pending on the allowance since this may run in a context where bank state cannot
+// be written; it is transferred from the source account by `CollectRentTopUp`.
+// The updated contract is not persisted.
+func (k Keeper) drawRentTopUp(ctx sdk.Context, contract *types.ContractInfoV2, topUp *types.RentTopUp, shortfall uint64) uint64 {
+	if topUp.Expiration != 0 && uint64(ctx.BlockTime().Unix()) >= topUp.Expiration {
+		return 0
+	}
+	amount := topUp.Amount
+	if shortfall > amount {
+		amount = shortfall
+	}
+	if amount > topUp.SpendLimit {
+		amount = topUp.SpendLimit
+	}
+	if amount > math.MaxUint64-contract.RentBalance {
+		amount = math.MaxUint64 - contract.RentBalance
+	}
+	if amount == 0 {
+		return 0
+	}
+	topUp.SpendLimit -= amount
+	topUp.Pending += amount
+	contract.RentBalance += amount
+	k.SetRentTopUp(ctx, *topUp)
+	return amount
+}
+
+// CollectRentTopUp transfers the rent drawn for a contract during the block from
+// the source account of its top-up into the dex module, and returns the amount
+// transferred. If the source account cannot cover it, the drawn rent is taken back
+// from the contract's rent balance and returned to the allowance.
+func (k Keeper) CollectRentTopUp(ctx sdk.Context, contractAddr string) uint64 {
+	topUp, found := k.GetRentTopUp(ctx, contractAddr)
+	if !found || topUp.Pending == 0 {
+		return 0
+	}
+	pending := topUp.Pending
+	topUp.Pending = 0
+	source := sdk.MustAccAddressFromBech32(topUp.Source)
+	amount := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(pending)))
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, source, types.ModuleName, amount); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to top up rent of %s from %s: %s", contractAddr, topUp.Source, err))
+		topUp.SpendLimit += pending
+		k.SetRentTopUp(ctx, topUp)
+		contract, err := k.GetContract(ctx, contractAddr)
+		if err != nil {
+			return 0
+		}
+		if pending > contract.RentBalance {
+			contract.RentBalance = 0
+		} else {
+			contract.RentBalance -= pending
+		}
+		if err := k.SetContract(ctx, &contract); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to take back rent top-up of %s: %s", contractAddr, err))
+		}
+		return 0
+	}
+	k.SetRentTopUp(ctx, topUp)
+
+	rentBalance := uint64(0)
+	if contract, err := k.GetContract(ctx, contractAddr); err == nil {
+		rentBalance = contract.RentBalance
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRentTopUp{
+		ContractAddr:        contractAddr,
+		Source:              topUp.Source,
+		Amount:              pending,
+		RentBalance:         rentBalance,
+		RemainingSpendLimit: topUp.SpendLimit,
+	}); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit rent top-up event for %s: %s", contractAddr, err))
+	}
+	return pending
+}
+
+// TopUpRents tops up every contract whose rent balance is already below the
+// threshold of its top-up, so that contracts that ran low outside of rent charges
+// (e.g. below `min_processable_rent`) are processed again.
+func (k Keeper) TopUpRents(ctx sdk.Context) {
+	for _, topUp := range k.GetAllRentTopUps(ctx) {
+		topUp := topUp
+		contract, err := k.GetContract(ctx, topUp.ContractAddr)
+		if err != nil || contract.Suspended || contract.RentBalance >= topUp.Threshold {
+			continue
+		}
+		if k.drawRentTopUp(ctx, &contract, &topUp, topUp.Threshold-contract.RentBalance) == 0 {
+			continue
+		}
+		if err := k.SetContract(ctx, &contract); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to top up rent of %s: %s", topUp.ContractAddr, err))
+			continue
+		}
+		k.CollectRentTopUp(ctx, topUp.ContractAddr)
+	}
+}
+
+// Emits a low rent warning if the rent balance of a contract went from at or above
+// the warning level to below it.
+func (k Keeper) emitLowRentWarning(ctx sdk.Context, contractAddr string, before uint64, after uint64) {
+	params := k.GetParams(ctx)
+	if params.LowRentWarningFraction.IsNil() {
+		return
+	}
+	warningLevel := params.LowRentWarningFraction.MulInt(sdk.NewIntFromUint64(params.MinRentDeposit)).TruncateInt()
+	if !warningLevel.IsUint64() {
+		return
+	}
+	level := warningLevel.Uint64()
+	if before < level || after >= level {
+		return
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLowRentBalance{
+		ContractAddr:       contractAddr,
+		RentBalance:        after,
+		WarningLevel:       level,
+		MinProcessableRent: params.MinProcessableRent,
+	}); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit low rent event for %s: %s", contractAddr, err))
+	}
+}
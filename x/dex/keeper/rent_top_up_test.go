@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestChargeRentForGasWithTopUp(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	keeper := testApp.DexKeeper
+	params := keeper.GetParams(ctx)
+	params.SudoCallGasPrice = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+	source := sdk.MustAccAddressFromBech32(keepertest.TestAccount2)
+	amount := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, source, amount))
+
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  100000,
+	}))
+	keeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Source:       keepertest.TestAccount2,
+		Threshold:    50000,
+		Amount:       200000,
+		SpendLimit:   300000,
+	})
+
+	// balance stays above the threshold, nothing is drawn
+	require.Nil(t, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 400000, 0))
+	contract, err := keeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(60000), contract.RentBalance)
+
+	// balance would fall below the threshold, so the top-up amount is drawn first
+	require.Nil(t, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 400000, 0))
+	contract, err = keeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(220000), contract.RentBalance)
+	topUp, found := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.True(t, found)
+	require.Equal(t, uint64(200000), topUp.Pending)
+	require.Equal(t, uint64(100000), topUp.SpendLimit)
+
+	// the fee exceeds the balance and the top-up amount, so the shortfall is drawn, capped by the spend limit
+	require.Nil(t, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 3000000, 0))
+	contract, err = keeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(20000), contract.RentBalance)
+	topUp, _ = keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(300000), topUp.Pending)
+	require.Equal(t, uint64(0), topUp.SpendLimit)
+
+	// the allowance is exhausted
+	require.Equal(t, types.ErrInsufficientRent, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 400000, 0))
+
+	require.Equal(t, uint64(300000), keeper.CollectRentTopUp(ctx, keepertest.TestContract))
+	topUp, _ = keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(0), topUp.Pending)
+	require.Equal(t, int64(700000), testApp.BankKeeper.GetBalance(ctx, source, "usei").Amount.Int64())
+	require.Equal(t, uint64(0), keeper.CollectRentTopUp(ctx, keepertest.TestContract))
+}
+
+func TestChargeRentForGasWithExpiredTopUp(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+	keeper := testApp.DexKeeper
+	params := keeper.GetParams(ctx)
+	params.SudoCallGasPrice = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  100000,
+	}))
+	keeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Source:       keepertest.TestAccount2,
+		Threshold:    50000,
+		Amount:       200000,
+		SpendLimit:   300000,
+		Expiration:   1000,
+	})
+
+	require.Equal(t, types.ErrInsufficientRent, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 2000000, 0))
+	topUp, _ := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(0), topUp.Pending)
+	require.Equal(t, uint64(300000), topUp.SpendLimit)
+}
+
+func TestCollectRentTopUpInsufficientFunds(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	keeper := testApp.DexKeeper
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  250000,
+	}))
+	keeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Source:       keepertest.TestAccount2,
+		Threshold:    50000,
+		Amount:       200000,
+		SpendLimit:   100000,
+		Pending:      200000,
+	})
+
+	require.Equal(t, uint64(0), keeper.CollectRentTopUp(ctx, keepertest.TestContract))
+	contract, err := keeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(50000), contract.RentBalance)
+	topUp, _ := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(0), topUp.Pending)
+	require.Equal(t, uint64(300000), topUp.SpendLimit)
+}
+
+func TestTopUpRents(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	keeper := testApp.DexKeeper
+	source := sdk.MustAccAddressFromBech32(keepertest.TestAccount2)
+	amount := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, source, amount))
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  10000,
+	}))
+	keeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Source:       keepertest.TestAccount2,
+		Threshold:    50000,
+		Amount:       20000,
+		SpendLimit:   300000,
+	})
+
+	keeper.TopUpRents(ctx)
+	contract, err := keeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(50000), contract.RentBalance)
+	topUp, _ := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(0), topUp.Pending)
+	require.Equal(t, uint64(260000), topUp.SpendLimit)
+	require.Equal(t, int64(960000), testApp.BankKeeper.GetBalance(ctx, source, "usei").Amount.Int64())
+	require.Equal(t, int64(40000), testApp.BankKeeper.GetBalance(ctx, testApp.AccountKeeper.GetModuleAddress(types.ModuleName), "usei").Amount.Int64())
+
+	// balance is at the threshold now
+	keeper.TopUpRents(ctx)
+	contract, _ = keeper.GetContract(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(50000), contract.RentBalance)
+}
+
+func TestLowRentWarningEvent(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := types.DefaultParams()
+	params.SudoCallGasPrice = sdk.NewDecWithPrec(1, 1)
+	params.MinRentDeposit = 1000000
+	params.LowRentWarningFraction = sdk.NewDecWithPrec(2, 1)
+	keeper.SetParams(ctx, params)
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  300000,
+	}))
+
+	countLowRentEvents := func(ctx sdk.Context) int {
+		cnt := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventLowRentBalance{}) {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	require.Nil(t, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 1000000, 0))
+	require.Equal(t, 0, countLowRentEvents(ctx))
+	require.Nil(t, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 1000000, 0))
+	require.Equal(t, 1, countLowRentEvents(ctx))
+	// only emitted when crossing the warning level
+	require.Nil(t, keeper.ChargeRentForGas(ctx, keepertest.TestContract, 1000000, 0))
+	require.Equal(t, 1, countLowRentEvents(ctx))
+}
@@ -0,0 +1,112 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPollInterval  = 200 * time.Millisecond
+	MaxConcurrentStreams = 100
+)
+
+var _ types.StreamServer = Server{}
+
+// Server streams per-block order book updates that were recorded during the dex
+// EndBlock. Updates are served from the keeper's in-memory buffer, so a stream can
+// only start from a height that is still buffered.
+type Server struct {
+	keeper       *keeper.Keeper
+	latestHeight func() int64
+	pollInterval time.Duration
+	// holds one slot per open stream
+	streams chan struct{}
+}
+
+func NewServer(
+	keeper *keeper.Keeper,
+	latestHeight func() int64,
+) Server {
+	return Server{
+		keeper:       keeper,
+		latestHeight: latestHeight,
+		pollInterval: DefaultPollInterval,
+		streams:      make(chan struct{}, MaxConcurrentStreams),
+	}
+}
+
+func (s Server) WithPollInterval(pollInterval time.Duration) Server {
+	s.pollInterval = pollInterval
+	return s
+}
+
+func (s Server) WithMaxConcurrentStreams(maxStreams int) Server {
+	s.streams = make(chan struct{}, maxStreams)
+	return s
+}
+
+// StreamBlockUpdates sends one BlockUpdate per committed block, starting at the
+// requested height, until the client cancels the stream. Blocks in which none of
+// the requested pairs had activity are sent without pair updates so that clients
+// can resume from the last height they received.
+func (s Server) StreamBlockUpdates(req *types.StreamBlockUpdatesRequest, stream types.Stream_StreamBlockUpdatesServer) error {
+	if req == nil || req.ContractAddr == "" {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartHeight < 0 {
+		return status.Error(codes.InvalidArgument, "start height cannot be negative")
+	}
+	select {
+	case s.streams <- struct{}{}:
+		defer func() { <-s.streams }()
+	default:
+		return status.Errorf(codes.ResourceExhausted, "at most %d streams can be open at once", cap(s.streams))
+	}
+	next := req.StartHeight
+	if next == 0 {
+		next = s.latestHeight() + 1
+	}
+	for {
+		for latest := s.latestHeight(); next <= latest; next++ {
+			update, err := s.getBlockUpdate(req, next)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+func (s Server) getBlockUpdate(req *types.StreamBlockUpdatesRequest, height int64) (*types.BlockUpdate, error) {
+	pairUpdates, found := s.keeper.BlockUpdates.Get(height, req.ContractAddr)
+	if !found {
+		if oldest, ok := s.keeper.BlockUpdates.OldestHeight(); ok && height < oldest {
+			return nil, status.Errorf(codes.OutOfRange, "updates before height %d are no longer available", oldest)
+		}
+		return nil, status.Errorf(codes.Unavailable, "updates at height %d are not available", height)
+	}
+	requested := map[types.PairString]struct{}{}
+	for _, pair := range req.Pairs {
+		requested[types.GetPairString(pair)] = struct{}{}
+	}
+	update := &types.BlockUpdate{Height: height, ContractAddr: req.ContractAddr}
+	for _, pairUpdate := range pairUpdates {
+		pair := types.Pair{PriceDenom: pairUpdate.PriceDenom, AssetDenom: pairUpdate.AssetDenom}
+		if _, ok := requested[types.GetPairString(&pair)]; len(requested) > 0 && !ok {
+			continue
+		}
+		update.PairUpdates = append(update.PairUpdates, pairUpdate)
+	}
+	return update, nil
+}
@@ -0,0 +1,120 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/stream"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	cancel  context.CancelFunc
+	updates []*types.BlockUpdate
+	limit   int
+}
+
+func (m *mockStream) Context() context.Context { return m.ctx }
+
+func (m *mockStream) Send(update *types.BlockUpdate) error {
+	m.updates = append(m.updates, update)
+	if len(m.updates) == m.limit {
+		m.cancel()
+	}
+	return nil
+}
+
+func newMockStream(limit int) *mockStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &mockStream{ctx: ctx, cancel: cancel, limit: limit}
+}
+
+func TestStreamBlockUpdates(t *testing.T) {
+	keeper, _ := keepertest.DexKeeper(t)
+	keeper.BlockUpdates.Add(1, map[string][]*types.PairBlockUpdate{})
+	keeper.BlockUpdates.Add(2, map[string][]*types.PairBlockUpdate{
+		keepertest.TestContract: {{
+			Height:     2,
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Longs:      []*types.OrderBookLevelUpdate{{Price: sdk.OneDec(), Quantity: sdk.NewDec(3)}},
+		}, {
+			Height:     2,
+			PriceDenom: "SEI",
+			AssetDenom: keepertest.TestAssetDenom,
+		}},
+	})
+	keeper.BlockUpdates.Add(3, map[string][]*types.PairBlockUpdate{})
+	server := stream.NewServer(keeper, func() int64 { return 3 }).WithPollInterval(time.Millisecond)
+
+	mock := newMockStream(3)
+	err := server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract, StartHeight: 1}, mock)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(mock.updates))
+	for i, update := range mock.updates {
+		require.Equal(t, int64(i+1), update.Height)
+		require.Equal(t, keepertest.TestContract, update.ContractAddr)
+	}
+	require.Equal(t, 0, len(mock.updates[0].PairUpdates))
+	require.Equal(t, 2, len(mock.updates[1].PairUpdates))
+	require.Equal(t, 0, len(mock.updates[2].PairUpdates))
+
+	// only the requested pairs are sent
+	mock = newMockStream(3)
+	err = server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract, StartHeight: 1, Pairs: []*types.Pair{&keepertest.TestPair}}, mock)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mock.updates[1].PairUpdates))
+	require.Equal(t, sdk.NewDec(3), mock.updates[1].PairUpdates[0].Longs[0].Quantity)
+}
+
+func TestStreamBlockUpdatesUnavailableHeight(t *testing.T) {
+	keeper, _ := keepertest.DexKeeper(t)
+	keeper.BlockUpdates = dexkeeper.NewBlockUpdateBuffer(2)
+	for height := int64(1); height <= 3; height++ {
+		keeper.BlockUpdates.Add(height, map[string][]*types.PairBlockUpdate{})
+	}
+	server := stream.NewServer(keeper, func() int64 { return 3 }).WithPollInterval(time.Millisecond)
+
+	// height 1 was evicted from the buffer
+	err := server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract, StartHeight: 1}, newMockStream(1))
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	err = server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract, StartHeight: -1}, newMockStream(1))
+	require.Error(t, err)
+
+	mock := newMockStream(2)
+	err = server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract, StartHeight: 2}, mock)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(mock.updates))
+}
+
+func TestStreamBlockUpdatesMaxConcurrentStreams(t *testing.T) {
+	keeper, _ := keepertest.DexKeeper(t)
+	server := stream.NewServer(keeper, func() int64 { return 3 }).WithPollInterval(time.Millisecond).WithMaxConcurrentStreams(1)
+
+	// the first stream waits for blocks after the latest height until it is cancelled
+	open := newMockStream(1)
+	done := make(chan error)
+	go func() {
+		done <- server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract}, open)
+	}()
+	require.Eventually(t, func() bool {
+		// returns right away if it gets the slot before the first stream
+		cancelled := newMockStream(1)
+		cancelled.cancel()
+		err := server.StreamBlockUpdates(&types.StreamBlockUpdatesRequest{ContractAddr: keepertest.TestContract}, cancelled)
+		return status.Code(err) == codes.ResourceExhausted
+	}, time.Second, time.Millisecond)
+	open.cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetTrades records the fills of a pair in the current block in the trade log,
+// along with the per-account index entries of their takers and makers. It is a
+// no-op if the trade log is disabled.
+func (k Keeper) SetTrades(ctx sdk.Context, contractAddr string, pair types.Pair, trades []*types.Trade) {
+	if k.GetParams(ctx).TradeLogRetention == 0 {
+		return
+	}
+	for i, trade := range trades {
+		trade.Sequence = uint64(i)
+		k.SetTrade(ctx, contractAddr, *trade)
+	}
+}
+
+func (k Keeper) SetTrade(ctx sdk.Context, contractAddr string, trade types.Trade) {
+	bz := k.Cdc.MustMarshal(&trade)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TradePrefix(contractAddr, trade.PriceDenom, trade.AssetDenom))
+	store.Set(GetKeyForTrade(trade), bz)
+	for _, account := range []string{trade.TakerAccount, trade.MakerAccount} {
+		accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradePrefix(contractAddr, account))
+		accountStore.Set(GetAccountKeyForTrade(trade), bz)
+	}
+}
+
+func (k Keeper) GetTradesByPairPaginated(
+	ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, startHeight uint64, endHeight uint64, page *query.PageRequest,
+) ([]*types.Trade, *query.PageResponse, error) {
+	return k.getTradesForPrefixPaginated(ctx, types.TradePrefix(contractAddr, priceDenom, assetDenom), startHeight, endHeight, page)
+}
+
+func (k Keeper) GetTradesByAccountPaginated(
+	ctx sdk.Context, contractAddr string, account string, startHeight uint64, endHeight uint64, page *query.PageRequest,
+) ([]*types.Trade, *query.PageResponse, error) {
+	return k.getTradesForPrefixPaginated(ctx, types.AccountTradePrefix(contractAddr, account), startHeight, endHeight, page)
+}
+
+// DeleteTradesBefore prunes trades of a pair, and their per-account index entries,
+// that were recorded before `timestamp`.
+func (k Keeper) DeleteTradesBefore(ctx sdk.Context, contractAddr string, timestamp uint64, pair types.Pair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TradePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	toDelete := []types.Trade{}
+	// Since height is encoded in big endian, the first trade being iterated is the oldest.
+	for ; iterator.Valid(); iterator.Next() {
+		var trade types.Trade
+		k.Cdc.MustUnmarshal(iterator.Value(), &trade)
+		if trade.Timestamp >= timestamp {
+			break
+		}
+		toDelete = append(toDelete, trade)
+	}
+	iterator.Close()
+
+	for _, trade := range toDelete {
+		store.Delete(GetKeyForTrade(trade))
+		for _, account := range []string{trade.TakerAccount, trade.MakerAccount} {
+			accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradePrefix(contractAddr, account))
+			accountStore.Delete(GetAccountKeyForTrade(trade))
+		}
+	}
+}
+
+func (k Keeper) RemoveAllTradesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.TradeKey, contractAddr))
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.AccountTradeKey, contractAddr))
+}
+
+// Trades are keyed by height first under both prefixes, so filtering by height only
+// needs to look at the first 8 bytes of the key. An `endHeight` of 0 means no upper bound.
+func (k Keeper) getTradesForPrefixPaginated(
+	ctx sdk.Context, storePrefix []byte, startHeight uint64, endHeight uint64, page *query.PageRequest,
+) (list []*types.Trade, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+
+	pageRes, err = query.FilteredPaginate(store, page, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		height := binary.BigEndian.Uint64(key[:8])
+		if height < startHeight || (endHeight > 0 && height > endHeight) {
+			return false, nil
+		}
+		if accumulate {
+			var trade types.Trade
+			if err := k.Cdc.Unmarshal(value, &trade); err != nil {
+				return false, err
+			}
+			list = append(list, &trade)
+		}
+		return true, nil
+	})
+
+	return
+}
+
+// height + sequence
+func GetKeyForTrade(trade types.Trade) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, trade.Height)
+	binary.BigEndian.PutUint64(key[8:], trade.Sequence)
+	return key
+}
+
+// height + price denom + asset denom + sequence
+func GetAccountKeyForTrade(trade types.Trade) []byte {
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, trade.Height)
+	sequence := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequence, trade.Sequence)
+	return append(append(height, types.PairPrefix(trade.PriceDenom, trade.AssetDenom)...), sequence...)
+}
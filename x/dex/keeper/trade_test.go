@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrade(height uint64, taker string, maker string) *types.Trade {
+	return &types.Trade{
+		PriceDenom:     keepertest.TestPriceDenom,
+		AssetDenom:     keepertest.TestAssetDenom,
+		Height:         height,
+		Timestamp:      height * 10,
+		TakerOrderId:   height*2 + 1,
+		TakerAccount:   taker,
+		MakerOrderId:   height * 2,
+		MakerAccount:   maker,
+		TakerDirection: types.PositionDirection_LONG,
+		Price:          sdk.NewDec(10),
+		Quantity:       sdk.NewDec(1),
+	}
+}
+
+func TestSetTradesDisabled(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{createTrade(1, "taker", "maker")})
+	trades, _, err := keeper.GetTradesByPairPaginated(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, 0, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+}
+
+func TestGetTradesPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.TradeLogRetention = 100
+	keeper.SetParams(ctx, params)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{createTrade(1, "taker", "maker"), createTrade(1, "maker", "other")})
+	keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{createTrade(2, "taker", "other")})
+	keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{createTrade(3, "other", "maker")})
+
+	trades, _, err := keeper.GetTradesByPairPaginated(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(trades))
+	require.Equal(t, uint64(0), trades[0].Sequence)
+	require.Equal(t, uint64(1), trades[1].Sequence)
+
+	trades, _, err = keeper.GetTradesByPairPaginated(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, 2, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(2), trades[0].Height)
+
+	// an account is indexed both as a taker and as a maker
+	trades, _, err = keeper.GetTradesByAccountPaginated(ctx, keepertest.TestContract, "maker", 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(trades))
+	trades, _, err = keeper.GetTradesByAccountPaginated(ctx, keepertest.TestContract, "taker", 2, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(2), trades[0].Height)
+}
+
+func TestDeleteTradesBefore(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.TradeLogRetention = 100
+	keeper.SetParams(ctx, params)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{createTrade(1, "taker", "maker")})
+	keeper.SetTrades(ctx, keepertest.TestContract, pair, []*types.Trade{createTrade(2, "taker", "other")})
+
+	keeper.DeleteTradesBefore(ctx, keepertest.TestContract, 20, pair)
+	trades, _, err := keeper.GetTradesByPairPaginated(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(2), trades[0].Height)
+	trades, _, err = keeper.GetTradesByAccountPaginated(ctx, keepertest.TestContract, "maker", 0, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+	trades, _, err = keeper.GetTradesByAccountPaginated(ctx, keepertest.TestContract, "taker", 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trades))
+
+	keeper.RemoveAllTradesForContract(ctx, keepertest.TestContract)
+	trades, _, err = keeper.GetTradesByAccountPaginated(ctx, keepertest.TestContract, "taker", 0, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+}
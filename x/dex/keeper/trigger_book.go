@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+var (
+	triggerByLongPricePrefix  = []byte{0x00}
+	triggerByShortPricePrefix = []byte{0x01}
+)
+
+// SetTriggerOrder stores a stop order that has not been triggered yet, and indexes it
+// by its direction and trigger price
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerBookPrefix(contractAddr, order.PriceDenom, order.AssetDenom))
+	store.Set(GetKeyForOrderID(order.Id), k.Cdc.MustMarshal(&order))
+	priceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerBookPricePrefix(contractAddr, order.PriceDenom, order.AssetDenom))
+	priceStore.Set(getTriggerPriceKey(order.PositionDirection, order.TriggerPrice, order.Id), GetKeyForOrderID(order.Id))
+}
+
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, orderID uint64) (val types.Order, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerBookPrefix(contractAddr, priceDenom, assetDenom))
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveTriggerOrder(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, orderID uint64) {
+	order, found := k.GetTriggerOrder(ctx, contractAddr, priceDenom, assetDenom, orderID)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerBookPrefix(contractAddr, priceDenom, assetDenom))
+	store.Delete(GetKeyForOrderID(orderID))
+	priceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerBookPricePrefix(contractAddr, priceDenom, assetDenom))
+	priceStore.Delete(getTriggerPriceKey(order.PositionDirection, order.TriggerPrice, orderID))
+}
+
+// GetTriggerOrdersCrossedByPrice returns the stop orders of a pair whose trigger price
+// has been crossed by `price`, ordered by order ID. LONG stop orders are crossed when
+// the price is at or above their trigger price and SHORT ones when it is at or below
+// it. Only the crossed range of the trigger price index is visited.
+func (k Keeper) GetTriggerOrdersCrossedByPrice(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, price sdk.Dec) (list []types.Order) {
+	priceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerBookPricePrefix(contractAddr, priceDenom, assetDenom))
+	priceKey := append(append([]byte{}, triggerByLongPricePrefix...), GetKeyForPrice(price)...)
+	longIterator := priceStore.Iterator(triggerByLongPricePrefix, sdk.PrefixEndBytes(priceKey))
+	orderIDs := collectTriggerOrderIDs(longIterator)
+	priceKey = append(append([]byte{}, triggerByShortPricePrefix...), GetKeyForPrice(price)...)
+	shortIterator := priceStore.Iterator(priceKey, sdk.PrefixEndBytes(triggerByShortPricePrefix))
+	orderIDs = append(orderIDs, collectTriggerOrderIDs(shortIterator)...)
+	sort.Slice(orderIDs, func(i, j int) bool { return orderIDs[i] < orderIDs[j] })
+	for _, orderID := range orderIDs {
+		if order, found := k.GetTriggerOrder(ctx, contractAddr, priceDenom, assetDenom, orderID); found {
+			list = append(list, order)
+		}
+	}
+	return
+}
+
+func collectTriggerOrderIDs(iterator sdk.Iterator) (orderIDs []uint64) {
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		orderIDs = append(orderIDs, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	return
+}
+
+func (k Keeper) GetAllTriggerOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	return k.getAllOrdersForPrefix(ctx, types.TriggerBookPrefix(contractAddr, priceDenom, assetDenom))
+}
+
+func (k Keeper) GetAllTriggerOrdersForPairPaginated(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, page *query.PageRequest) ([]*types.Order, *query.PageResponse, error) {
+	return k.getAllOrdersForPrefixPaginated(ctx, types.TriggerBookPrefix(contractAddr, priceDenom, assetDenom), page)
+}
+
+// SetTriggeredOrder stores a stop order that has been triggered and is waiting
+// to be injected into the next matching round
+func (k Keeper) SetTriggeredOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggeredOrderPrefix(contractAddr, order.PriceDenom, order.AssetDenom))
+	store.Set(GetKeyForOrderID(order.Id), k.Cdc.MustMarshal(&order))
+}
+
+func (k Keeper) GetTriggeredOrder(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, orderID uint64) (val types.Order, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom))
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveTriggeredOrder(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, orderID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom))
+	store.Delete(GetKeyForOrderID(orderID))
+}
+
+func (k Keeper) GetAllTriggeredOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	return k.getAllOrdersForPrefix(ctx, types.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom))
+}
+
+func (k Keeper) GetAllTriggeredOrdersForPairPaginated(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, page *query.PageRequest) ([]*types.Order, *query.PageResponse, error) {
+	return k.getAllOrdersForPrefixPaginated(ctx, types.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom), page)
+}
+
+// HasTriggeredOrders returns true if the contract has any triggered order pending injection
+func (k Keeper) HasTriggeredOrders(ctx sdk.Context, contractAddr string) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ContractKeyPrefix(types.TriggeredOrderKey, contractAddr))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+func (k Keeper) RemoveAllTriggerOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.TriggerBookKey, contractAddr))
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.TriggerBookPriceKey, contractAddr))
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.TriggeredOrderKey, contractAddr))
+}
+
+func (k Keeper) getAllOrdersForPrefix(ctx sdk.Context, storePrefix []byte) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) getAllOrdersForPrefixPaginated(ctx sdk.Context, storePrefix []byte, page *query.PageRequest) (list []*types.Order, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var order types.Order
+		if err := k.Cdc.Unmarshal(value, &order); err != nil {
+			return err
+		}
+
+		list = append(list, &order)
+		return nil
+	})
+
+	return
+}
+
+// the price key is prefixed by its length so orders of the same trigger price are
+// grouped together and ordered by ID
+func getTriggerPriceKey(direction types.PositionDirection, triggerPrice sdk.Dec, orderID uint64) []byte {
+	directionPrefix := triggerByLongPricePrefix
+	if direction == types.PositionDirection_SHORT {
+		directionPrefix = triggerByShortPricePrefix
+	}
+	return append(append(append([]byte{}, directionPrefix...), GetKeyForPrice(triggerPrice)...), GetKeyForOrderID(orderID)...)
+}
+
+func GetKeyForOrderID(orderID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, orderID)
+	return key
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggerOrderGetSetRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := createOrder(1, withStopLimit(11))
+	keeper.SetTriggerOrder(ctx, keepertest.TestContract, order)
+	got, found := keeper.GetTriggerOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.True(t, found)
+	require.Equal(t, order, got)
+	require.Equal(t, []types.Order{order}, keeper.GetAllTriggerOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	keeper.RemoveTriggerOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	_, found = keeper.GetTriggerOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.False(t, found)
+}
+
+func TestGetTriggerOrdersCrossedByPrice(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for i, triggerPrice := range []int64{9, 11, 12, 11} {
+		order := createOrder(uint64(i+1), withStopLimit(triggerPrice))
+		keeper.SetTriggerOrder(ctx, keepertest.TestContract, order)
+	}
+	for i, triggerPrice := range []int64{8, 11, 10} {
+		order := createOrder(uint64(i+5), withStopLimit(triggerPrice))
+		order.PositionDirection = types.PositionDirection_SHORT
+		keeper.SetTriggerOrder(ctx, keepertest.TestContract, order)
+	}
+	getIDs := func(price sdk.Dec) []uint64 {
+		ids := []uint64{}
+		for _, order := range keeper.GetTriggerOrdersCrossedByPrice(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, price) {
+			ids = append(ids, order.Id)
+		}
+		return ids
+	}
+	// longs at or below 11 and shorts at or above 11
+	require.Equal(t, []uint64{1, 2, 4, 6}, getIDs(sdk.NewDec(11)))
+	require.Equal(t, []uint64{1, 6, 7}, getIDs(sdk.NewDecWithPrec(95, 1)))
+	require.Equal(t, []uint64{5, 6, 7}, getIDs(sdk.NewDec(5)))
+
+	// removed orders are dropped from the index as well
+	keeper.RemoveTriggerOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 2)
+	keeper.RemoveTriggerOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 6)
+	require.Equal(t, []uint64{1, 4}, getIDs(sdk.NewDec(11)))
+}
+
+func TestTriggeredOrderGetSetRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	require.False(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+	order := createOrder(1, withStopLimit(11))
+	order.TriggerStatus = true
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, order)
+	require.True(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+	got, found := keeper.GetTriggeredOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.True(t, found)
+	require.Equal(t, order, got)
+	require.Equal(t, []types.Order{order}, keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.False(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+}
+
+func TestRemoveAllTriggerOrdersForContract(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetTriggerOrder(ctx, keepertest.TestContract, createOrder(1, withStopLimit(11)))
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, createOrder(2, withStopLimit(11)))
+	keeper.RemoveAllTriggerOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllTriggerOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.Empty(t, keeper.GetTriggerOrdersCrossedByPrice(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, sdk.NewDec(100)))
+	require.Empty(t, keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+}
@@ -58,11 +58,16 @@ func PopulateOrderbook(
 			ctx.Logger().Error(fmt.Sprintf("error setting order count: %s", err))
 		}
 	}
+	longs := types.NewCachedSortedOrderBookEntries(longLoader, longSetter, longDeleter)
+	shorts := types.NewCachedSortedOrderBookEntries(shortLoader, shortSetter, shortDeleter)
+	allocationRule := types.AllocationRuleForPair(pair)
+	longs.SetAllocationRule(allocationRule)
+	shorts.SetAllocationRule(allocationRule)
 	return &types.OrderBook{
 		Contract: contractAddr,
 		Pair:     pair,
-		Longs:    types.NewCachedSortedOrderBookEntries(longLoader, longSetter, longDeleter),
-		Shorts:   types.NewCachedSortedOrderBookEntries(shortLoader, shortSetter, shortDeleter),
+		Longs:    longs,
+		Shorts:   shorts,
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/sei-protocol/sei-chain/utils/metrics"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
 const ErrWasmModuleInstCPUFeatureLiteral = "Error instantiating module: CpuFeature"
@@ -31,6 +32,19 @@ func getMsgType(msg interface{}) string {
 	}
 }
 
+// Deposits are sent as order placements without orders, but are reported separately
+// in execution stats. Also returns the number of orders placed.
+func getExecutionStatsMsgType(msg interface{}, msgType string) (string, int) {
+	placement, ok := msg.(types.SudoOrderPlacementMsg)
+	if !ok {
+		return msgType, 0
+	}
+	if len(placement.OrderPlacements.Orders) == 0 && len(placement.OrderPlacements.Deposits) > 0 {
+		return "deposit", 0
+	}
+	return msgType, len(placement.OrderPlacements.Orders)
+}
+
 func sudo(sdkCtx sdk.Context, k *keeper.Keeper, contractAddress sdk.AccAddress, wasmMsg []byte, msgType string) ([]byte, uint64, error) {
 	defer utils.PanicHandler(func(err any) {
 		utils.MetricsPanicCallback(err, sdkCtx, fmt.Sprintf("%s|%s", contractAddress, msgType))
@@ -102,7 +116,15 @@ func CallContractSudo(sdkCtx sdk.Context, k *keeper.Keeper, contractAddr string,
 	}
 	msgType := getMsgType(msg)
 	data, gasUsed, suderr := sudo(sdkCtx, k, contractAddress, wasmMsg, msgType)
-	if err := k.ChargeRentForGas(sdkCtx, contractAddr, gasUsed, gasAllowance); err != nil {
+	rentErr := k.ChargeRentForGas(sdkCtx, contractAddr, gasUsed, gasAllowance)
+	if collector := dexutils.GetExecutionStatsCollector(sdkCtx.Context()); collector != nil {
+		statsMsgType, numOrders := getExecutionStatsMsgType(msg, msgType)
+		collector.RecordSudoCall(contractAddr, statsMsgType, gasUsed, numOrders, suderr != nil || rentErr != nil)
+	}
+	if recorder := dexutils.GetReplayRecorder(sdkCtx.Context()); recorder != nil {
+		recordSudoCall(recorder, contractAddr, msgType, wasmMsg, data, gasUsed, gasAllowance, suderr, rentErr)
+	}
+	if err := rentErr; err != nil {
 		metrics.IncrementSudoFailCount(msgType)
 		sdkCtx.Logger().Error(err.Error())
 		return []byte{}, err
@@ -114,3 +136,25 @@ func CallContractSudo(sdkCtx sdk.Context, k *keeper.Keeper, contractAddr string,
 	}
 	return data, nil
 }
+
+func recordSudoCall(recorder *dexutils.ReplayRecorder, contractAddr string, msgType string, wasmMsg []byte, data []byte, gasUsed uint64, gasAllowance uint64, errs ...error) {
+	call := dexutils.SudoCallRecord{
+		Type:         msgType,
+		Request:      wasmMsg,
+		GasUsed:      gasUsed,
+		GasAllowance: gasAllowance,
+	}
+	if json.Valid(data) {
+		call.Response = data
+	} else if len(data) > 0 {
+		call.Response, _ = json.Marshal(data)
+	}
+	errMsgs := []string{}
+	for _, err := range errs {
+		if err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%+v", err))
+		}
+	}
+	call.Error = strings.Join(errMsgs, "\n")
+	recorder.RecordSudoCall(contractAddr, call)
+}
@@ -0,0 +1,15 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+)
+
+// V17ToV18 backfills the per-account open-order index with the orders that are
+// already resting on the books.
+func V17ToV18(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	for _, c := range dexkeeper.GetAllContractInfo(ctx) {
+		dexkeeper.IndexAllRestingOrdersForContract(ctx, c.ContractAddr)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate17to18(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract})
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+				{OrderId: 2, Account: "other", Quantity: sdk.NewDec(2)},
+			},
+		},
+	})
+	dexkeeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(11),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(11),
+			Quantity:   sdk.NewDec(1),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 3, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+			},
+		},
+	})
+
+	require.NoError(t, migrations.V17ToV18(ctx, *dexkeeper))
+
+	orders, _, err := dexkeeper.GetAccountOrdersPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, uint64(1), orders[0].Id)
+	require.Equal(t, types.PositionDirection_LONG, orders[0].PositionDirection)
+	require.Equal(t, uint64(3), orders[1].Id)
+	require.Equal(t, types.PositionDirection_SHORT, orders[1].PositionDirection)
+	order, found := dexkeeper.GetAccountOrder(ctx, keepertest.TestContract, "other", keepertest.TestPriceDenom, keepertest.TestAssetDenom, 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), order.Price)
+}
@@ -0,0 +1,14 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V19ToV20 adds the candle retention param. Candles start being built from the
+// first fills after the upgrade.
+func V19ToV20(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyCandleRetention, uint64(types.DefaultCandleRetention))
+	return nil
+}
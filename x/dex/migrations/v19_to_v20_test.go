@@ -0,0 +1,26 @@
+package migrations_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate19to20(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.TradeLogRetention = 100
+	prevParams.CandleRetention = 0
+	dexkeeper.SetParams(ctx, prevParams)
+
+	err := migrations.V19ToV20(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params := dexkeeper.GetParams(ctx)
+	require.Equal(t, uint64(types.DefaultCandleRetention), params.CandleRetention)
+	// existing params are left untouched
+	require.Equal(t, uint64(100), params.TradeLogRetention)
+}
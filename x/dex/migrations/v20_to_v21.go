@@ -0,0 +1,14 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V20ToV21 adds the low rent warning fraction param. Contracts start without a
+// rent top-up.
+func V20ToV21(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyLowRentWarningFraction, types.DefaultLowRentWarningFraction)
+	return nil
+}
@@ -0,0 +1,25 @@
+package migrations_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate20to21(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.CandleRetention = 100
+	dexkeeper.SetParams(ctx, prevParams)
+
+	err := migrations.V20ToV21(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params := dexkeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultLowRentWarningFraction, params.LowRentWarningFraction)
+	// existing params are left untouched
+	require.Equal(t, uint64(100), params.CandleRetention)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/client/cli/query"
 	"github.com/sei-protocol/sei-chain/x/dex/client/cli/tx"
 	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	dexkeeperabci "github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
@@ -209,10 +210,30 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
 		return migrations.V15ToV16(ctx, am.keeper)
 	})
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.V16ToV17(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 17, func(ctx sdk.Context) error {
+		return migrations.V17ToV18(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 18, func(ctx sdk.Context) error {
+		return migrations.V18ToV19(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 19, func(ctx sdk.Context) error {
+		return migrations.V19ToV20(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 20, func(ctx sdk.Context) error {
+		return migrations.V20ToV21(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 21, func(ctx sdk.Context) error {
+		return migrations.V21ToV22(ctx, am.keeper)
+	})
 }
 
-// RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the dex module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -233,7 +254,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 16 }
+func (AppModule) ConsensusVersion() uint64 { return 22 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -280,6 +301,56 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	}
 	// only write if all contracts have been processed
 	cachedStore.Write()
+
+	if tradeRetention := am.keeper.GetParams(ctx).TradeLogRetention; tradeRetention > 0 && uint64(ctx.BlockTime().Unix()) > tradeRetention {
+		tradeCutOffTime := uint64(ctx.BlockTime().Unix()) - tradeRetention
+		for _, contract := range allContracts {
+			for _, pair := range am.keeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+				am.keeper.DeleteTradesBefore(ctx, contract.ContractAddr, tradeCutOffTime, pair)
+			}
+		}
+	}
+
+	if candleRetention := am.keeper.GetParams(ctx).CandleRetention; candleRetention > 0 {
+		now := uint64(ctx.BlockTime().Unix())
+		for _, contract := range allContracts {
+			for _, pair := range am.keeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+				for _, interval := range types.CandleIntervalsInSeconds {
+					if now > candleRetention*interval {
+						am.keeper.DeleteCandlesBefore(ctx, contract.ContractAddr, pair, interval, now-candleRetention*interval)
+					}
+				}
+			}
+		}
+	}
+
+	if statsRetention := am.keeper.GetParams(ctx).ExecutionStatsRetention; statsRetention > 0 && uint64(ctx.BlockHeight()) > statsRetention {
+		statsCutOffHeight := ctx.BlockHeight() - int64(statsRetention)
+		for _, contract := range allContracts {
+			am.keeper.DeleteContractExecutionStatsBefore(ctx, contract.ContractAddr, statsCutOffHeight)
+		}
+	}
+
+	// stop orders of pairs triggered by the oracle price are checked against the latest
+	// oracle price here since the oracle store is not readable while contracts are run
+	for _, contract := range allContracts {
+		for _, pair := range am.keeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+			if !pair.TriggerOnOraclePrice || pair.Halted {
+				continue
+			}
+			if oraclePrice, found := am.keeper.GetOraclePriceForPair(ctx, pair); found {
+				exchange.TriggerOrders(ctx, &am.keeper, types.ContractAddress(contract.ContractAddr), pair, oraclePrice)
+			}
+		}
+	}
+
+	// stop orders triggered in the previous block (or by the oracle price above) need
+	// to be matched in this block even if the contract receives no new messages
+	for _, contract := range allContracts {
+		if am.keeper.HasTriggeredOrders(ctx, contract.ContractAddr) {
+			dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contract.ContractAddr, am.keeper.GetContractWithoutGasCharge)
+		}
+	}
 }
 
 func (am AppModule) getPriceToDelete(
@@ -314,12 +385,15 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 	defer span.End()
 	defer dexutils.GetMemState(ctx.Context()).Clear(ctx)
 
+	am.keeper.TopUpRents(ctx)
 	validContractsInfo := am.keeper.GetAllProcessableContractInfo(ctx)
+	processableContractAddrs := utils.Map(validContractsInfo, func(c types.ContractInfoV2) string { return c.ContractAddr })
 	// Each iteration is atomic. If an iteration finishes without any error, it will return,
-	// otherwise it will rollback any state change, filter out contracts that cause the error,
-	// and proceed to the next iteration. The loop is guaranteed to finish since
-	// `validContractAddresses` will always decrease in size every iteration.
-	iterCounter := len(validContractsInfo)
+	// otherwise it will rollback any state change, filter out contracts that cause the error
+	// or routed orders that didn't meet their minimum output, and proceed to the next iteration.
+	// The loop is guaranteed to finish since either `validContractAddresses` or the routed
+	// orders of the block will decrease in size every iteration.
+	iterCounter := len(validContractsInfo) + len(dexutils.GetMemState(ctx.Context()).GetAllRoutedOrders(ctx))
 	endBlockerStartTime := time.Now()
 	for len(validContractsInfo) > 0 {
 		newValidContractsInfo, newOutOfRentContractsInfo, failedContractToReasons, ctx, ok := contract.EndBlockerAtomic(ctx, &am.keeper, validContractsInfo, am.tracingInfo)
@@ -341,8 +415,8 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 		if len(failedContractToReasons) != 0 {
 			dexutils.GetMemState(ctx.Context()).ClearContractToDependencies()
 		}
-		// technically we don't really need this if `EndBlockerAtomic` guarantees that `validContractsInfo` size will
-		// always shrink if not `ok`, but just in case, we decided to have an explicit termination criteria here to
+		// technically we don't really need this if `EndBlockerAtomic` guarantees that `validContractsInfo` or the
+		// routed orders will always shrink if not `ok`, but just in case, we decided to have an explicit termination criteria here to
 		// prevent the chain from being stuck.
 		iterCounter--
 		if iterCounter == 0 {
@@ -351,6 +425,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 		}
 	}
 	telemetry.MeasureSince(endBlockerStartTime, am.Name(), "total_end_blocker_atomic")
+	am.keeper.BufferBlockUpdates(ctx, processableContractAddrs)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -2,6 +2,7 @@ package dex_test
 
 import (
 	"context"
+	"encoding/json"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -11,9 +12,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/utils/tracing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/sei-protocol/sei-chain/app"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	dexkeeperabci "github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
@@ -49,6 +54,19 @@ const (
 	}}`
 )
 
+// Sets the contract and escrows its rent in the dex module account, as contract
+// registration would, so that the rent escrow invariant holds.
+func setContractWithEscrowedRent(ctx sdk.Context, testApp *app.App, contract *types.ContractInfoV2) error {
+	rent := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewIntFromUint64(contract.RentBalance)))
+	if err := testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rent); err != nil {
+		return err
+	}
+	if err := testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, rent); err != nil {
+		return err
+	}
+	return testApp.DexKeeper.SetContract(ctx, contract)
+}
+
 func TestEndBlockMarketOrder(t *testing.T) {
 	testApp := keepertest.TestApp()
 	dexkeeper := testApp.DexKeeper
@@ -79,7 +97,7 @@ func TestEndBlockMarketOrder(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	err = dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	err = setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	if err != nil {
 		panic(err)
 	}
@@ -126,6 +144,11 @@ func TestEndBlockMarketOrder(t *testing.T) {
 	_, found := dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.MustNewDecFromStr("1"), pair.PriceDenom, pair.AssetDenom)
 	// Long book should be populated
 	require.True(t, found)
+	// the new levels are buffered for streaming
+	pairUpdates, found := dexkeeper.BlockUpdates.Get(1, contractAddr.String())
+	require.True(t, found)
+	require.Equal(t, 1, len(pairUpdates))
+	require.Equal(t, 2, len(pairUpdates[0].Longs))
 
 	dexutils.GetMemState(ctx.Context()).Clear(ctx)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
@@ -215,7 +238,7 @@ func TestEndBlockLimitOrder(t *testing.T) {
 		panic(err)
 	}
 
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
 		&types.Order{
@@ -363,7 +386,7 @@ func TestEndBlockRollback(t *testing.T) {
 	dexkeeper := testApp.DexKeeper
 	pair := TEST_PAIR()
 	// register contract and pair
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
 	// place one order to a nonexistent contract
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(
@@ -399,7 +422,7 @@ func TestEndBlockPartialRollback(t *testing.T) {
 	dexkeeper := testApp.DexKeeper
 	pair := TEST_PAIR()
 	// register contract and pair
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
 	// place one order to a nonexistent contract
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(
@@ -440,7 +463,7 @@ func TestEndBlockPartialRollback(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	// place one order to the good contract
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
@@ -486,7 +509,6 @@ func TestBeginBlock(t *testing.T) {
 	testApp := keepertest.TestApp()
 	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
 	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
-	dexkeeper := testApp.DexKeeper
 
 	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
 	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)))
@@ -509,12 +531,60 @@ func TestBeginBlock(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 
 	// right now just make sure it doesn't crash since it doesn't register any state to be checked against
 	testApp.BeginBlocker(ctx, abci.RequestBeginBlock{})
 }
 
+func TestBeginBlockOracleTriggerOrders(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	dexkeeper := testApp.DexKeeper
+	require.Nil(t, setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 1, ContractAddr: keepertest.TestContract, NeedOrderMatching: true, RentBalance: 100000000}))
+	oraclePair := types.Pair{PriceDenom: "uusdc", AssetDenom: "uatom", TriggerOnOraclePrice: true}
+	lastTradePair := types.Pair{PriceDenom: "uusdc", AssetDenom: "ueth"}
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, oraclePair)
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, lastTradePair)
+	for i, pair := range []types.Pair{oraclePair, lastTradePair} {
+		dexkeeper.SetTriggerOrder(ctx, keepertest.TestContract, types.Order{
+			Id:                uint64(i + 1),
+			Account:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			Price:             sdk.NewDec(12),
+			Quantity:          sdk.NewDec(1),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_STOPLIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			TriggerPrice:      sdk.NewDec(11),
+			Nominal:           sdk.ZeroDec(),
+		})
+	}
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uusdc", sdk.NewDecWithPrec(5, 1))
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "ueth", sdk.NewDec(6))
+
+	// the oracle price of ATOM is unknown so nothing is triggered
+	testApp.BeginBlocker(ctx, abci.RequestBeginBlock{})
+	require.False(t, dexkeeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+
+	// 5.4 / 0.5 is below the trigger price
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDecWithPrec(54, 1))
+	testApp.BeginBlocker(ctx, abci.RequestBeginBlock{})
+	require.False(t, dexkeeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+
+	// 5.5 / 0.5 reaches the trigger price of the oracle pair's order, while the order of
+	// the other pair is not triggered by its oracle price of 12
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDecWithPrec(55, 1))
+	testApp.BeginBlocker(ctx, abci.RequestBeginBlock{})
+	_, found := dexkeeper.GetTriggeredOrder(ctx, keepertest.TestContract, "uusdc", "uatom", 1)
+	require.True(t, found)
+	_, found = dexkeeper.GetTriggerOrder(ctx, keepertest.TestContract, "uusdc", "ueth", 2)
+	require.True(t, found)
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(keepertest.TestContract))
+}
+
 // Note that once the bug that causes EndBlock to panic is fixed, this test will need to be
 // updated to trigger the next bug that causes panics, if any.
 func TestEndBlockPanicHandling(t *testing.T) {
@@ -547,7 +617,7 @@ func TestEndBlockPanicHandling(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
 		&types.Order{
@@ -606,7 +676,7 @@ func TestEndBlockRollbackWithRentCharge(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 1})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 1})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	// place one order to a nonexistent contract
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
@@ -654,6 +724,11 @@ func TestEndBlockRollbackWithRentCharge(t *testing.T) {
 	).Amount.Int64()) // bad contract rent should be sent to fee collector
 	creatorBalanceAfter := bankkeeper.GetBalance(ctx, testAccount, "usei")
 	require.Equal(t, creatorBalanceBefore, creatorBalanceAfter)
+	// execution stats of the failed run should still be recorded
+	stats, found := dexkeeper.GetContractExecutionStatsForBlock(ctx, contractAddr.String(), 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.Failures)
+	require.NotEmpty(t, stats.SudoCallStats)
 }
 
 func TestEndBlockContractWithoutPair(t *testing.T) {
@@ -688,7 +763,7 @@ func TestEndBlockContractWithoutPair(t *testing.T) {
 
 	// no pair registered
 	contractInfo := types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000}
-	dexkeeper.SetContract(ctx, &contractInfo)
+	setContractWithEscrowedRent(ctx, testApp, &contractInfo)
 
 	tp := trace.NewNoopTracerProvider()
 	otel.SetTracerProvider(trace.NewNoopTracerProvider())
@@ -735,7 +810,7 @@ func TestOrderCountUpdate(t *testing.T) {
 		panic(err)
 	}
 
-	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
 		&types.Order{
@@ -833,3 +908,441 @@ func TestOrderCountUpdate(t *testing.T) {
 	require.Equal(t, uint64(0), dexkeeper.GetOrderCountState(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(3)))
 	require.Equal(t, uint64(1), dexkeeper.GetOrderCountState(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom, types.PositionDirection_SHORT, sdk.NewDec(3)))
 }
+
+func TestEndBlockWithReplayRecorder(t *testing.T) {
+	testApp := keepertest.TestApp()
+	dexkeeper := testApp.DexKeeper
+	recorder := dexutils.NewReplayRecorder()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(dexkeeper.GetMemStoreKey())))
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexReplayRecorderContextKey, recorder))
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM"}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	require.Nil(t, err)
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	require.Nil(t, err)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+		sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+	require.Nil(t, err)
+	err = setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	require.Nil(t, err)
+	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
+		&types.Order{
+			Id:                1,
+			Account:           testAccount.String(),
+			ContractAddr:      contractAddr.String(),
+			Price:             sdk.MustNewDecFromStr("1"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+		},
+	)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	_, found := dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.MustNewDecFromStr("1"), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+
+	attempts := recorder.Attempts()
+	require.Len(t, attempts, 1)
+	record, ok := attempts[0].Contracts[contractAddr.String()]
+	require.True(t, ok)
+	require.NotEmpty(t, record.SudoCalls)
+	require.Equal(t, "bulk_order_placements", record.SudoCalls[0].Type)
+	require.True(t, record.GasUsed > 0)
+	require.Empty(t, record.Errors)
+
+	stats, found := dexkeeper.GetContractExecutionStatsForBlock(ctx, contractAddr.String(), 1)
+	require.True(t, found)
+	require.Equal(t, uint64(123), stats.CodeId)
+	require.Equal(t, uint64(1), stats.OrdersProcessed)
+	require.Equal(t, uint64(0), stats.Failures)
+	require.Equal(t, record.GasUsed, stats.TotalGasUsed())
+}
+
+func TestEndBlockRoutedOrder(t *testing.T) {
+	testApp := keepertest.TestApp()
+	dexkeeper := testApp.DexKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(dexkeeper.GetMemStoreKey())))
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM"}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	dexAmounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(5000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper.SendCoinsFromAccountToModule(ctx, testAccount, types.ModuleName, dexAmounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	require.Nil(t, err)
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	require.Nil(t, err)
+	instantiate := func(label string) string {
+		contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), label,
+			sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+		require.Nil(t, err)
+		return contractAddr.String()
+	}
+	// the first hop's contract depends on the second hop's contract so that it runs first
+	firstContract, secondContract := instantiate("first"), instantiate("second")
+	// a contract that no routed order goes through
+	unrelatedContract := instantiate("unrelated")
+	require.Nil(t, setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{
+		CodeId: 123, ContractAddr: unrelatedContract, NeedOrderMatching: true, RentBalance: 100000000,
+	}))
+	dexkeeper.AddRegisteredPair(ctx, unrelatedContract, pair)
+	require.Nil(t, setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{
+		CodeId: 123, ContractAddr: firstContract, NeedOrderMatching: true, RentBalance: 100000000,
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: secondContract}},
+	}))
+	require.Nil(t, setContractWithEscrowedRent(ctx, testApp, &types.ContractInfoV2{
+		CodeId: 123, ContractAddr: secondContract, NeedOrderMatching: true, RentBalance: 100000000, NumIncomingDependencies: 1,
+	}))
+	dexkeeper.AddRegisteredPair(ctx, firstContract, pair)
+	dexkeeper.AddRegisteredPair(ctx, secondContract, pair)
+	data := "{\"position_effect\":\"Open\",\"leverage\":\"1\"}"
+	// the first contract holds the SEI its bidders deposited
+	firstContractFunds := sdk.NewCoins(sdk.NewCoin(pair.PriceDenom, sdk.NewInt(4)))
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, firstContractFunds)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.MustAccAddressFromBech32(firstContract), firstContractFunds)
+
+	// rest a bid on the first contract and an ask on the second one
+	placeRestingOrders := func(startID uint64) {
+		dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(firstContract), pair).Add(&types.Order{
+			Id: startID, Account: testAccount.String(), ContractAddr: firstContract, Price: sdk.NewDec(2), Quantity: sdk.NewDec(1),
+			PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, OrderType: types.OrderType_LIMIT, PositionDirection: types.PositionDirection_LONG, Data: data,
+		})
+		dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(secondContract), pair).Add(&types.Order{
+			Id: startID, Account: testAccount.String(), ContractAddr: secondContract, Price: sdk.NewDec(1), Quantity: sdk.NewDec(5),
+			PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, OrderType: types.OrderType_LIMIT, PositionDirection: types.PositionDirection_SHORT, Data: data,
+		})
+		for _, contractAddr := range []string{firstContract, secondContract} {
+			dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contractAddr)).Add(
+				&types.DepositInfoEntry{Creator: testAccount.String(), Denom: "uusdc", Amount: sdk.MustNewDecFromStr("1000000")},
+			)
+			dexkeeper.SetNextOrderID(ctx, contractAddr, startID+1)
+		}
+		dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, firstContract, dexkeeper.GetContractWithoutGasCharge)
+	}
+	placeRestingOrders(1)
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+
+	// ATOM is sold for 2 SEI on the first contract, which buy 2 ATOM on the second contract
+	server := msgserver.NewMsgServerImpl(dexkeeper)
+	placeRoutedOrder := func(minOutput sdk.Dec) {
+		_, err := server.PlaceRoutedOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceRoutedOrder{
+			Creator: testAccount.String(),
+			Hops: []*types.RouteHop{
+				{ContractAddr: firstContract, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, Price: sdk.ZeroDec(), Data: data},
+				{ContractAddr: secondContract, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, Price: sdk.NewDec(1), Data: data},
+			},
+			InputDenom:    pair.AssetDenom,
+			InputQuantity: sdk.NewDec(1),
+			MinOutput:     minOutput,
+		})
+		require.Nil(t, err)
+		for _, contractAddr := range []string{firstContract, secondContract} {
+			dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contractAddr)).Add(
+				&types.DepositInfoEntry{Creator: testAccount.String(), Denom: "uusdc", Amount: sdk.MustNewDecFromStr("1000000")},
+			)
+		}
+	}
+	placeRoutedOrder(sdk.NewDec(2))
+	ctx = ctx.WithBlockHeight(2)
+	recorder := dexutils.NewReplayRecorder()
+	testApp.EndBlocker(ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexReplayRecorderContextKey, recorder)), abci.RequestEndBlock{})
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+
+	// the 2 SEI out of the first hop are deposited to the second contract before its hop
+	// is placed, and moved there from the first contract
+	attempts := recorder.Attempts()
+	require.Len(t, attempts, 1)
+	// the block's regular deposit comes first
+	sudoCalls := attempts[0].Contracts[secondContract].SudoCalls
+	require.True(t, len(sudoCalls) > 2)
+	deposit := types.SudoOrderPlacementMsg{}
+	require.Nil(t, json.Unmarshal(sudoCalls[1].Request, &deposit))
+	require.Empty(t, deposit.OrderPlacements.Orders)
+	require.Equal(t, []types.ContractDepositInfo{{Account: testAccount.String(), Denom: pair.PriceDenom, Amount: sdk.NewDec(2)}}, deposit.OrderPlacements.Deposits)
+	placement := types.SudoOrderPlacementMsg{}
+	require.Nil(t, json.Unmarshal(sudoCalls[2].Request, &placement))
+	require.Len(t, placement.OrderPlacements.Orders, 1)
+	require.Equal(t, sdk.NewDec(2), placement.OrderPlacements.Orders[0].Quantity)
+	require.Equal(t, sdk.NewInt(2), bankkeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(firstContract), pair.PriceDenom).Amount)
+	require.Equal(t, sdk.NewInt(2), bankkeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(secondContract), pair.PriceDenom).Amount)
+
+	_, found := dexkeeper.GetLongBookByPrice(ctx, firstContract, sdk.NewDec(2), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	shortBook, found := dexkeeper.GetShortBookByPrice(ctx, secondContract, sdk.NewDec(1), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), shortBook.Entry.Quantity)
+	matchResult, _ := dexkeeper.GetMatchResultState(ctx, secondContract)
+	require.Equal(t, 2, len(matchResult.Settlements))
+	for _, settlement := range matchResult.Settlements {
+		require.Equal(t, sdk.NewDec(2), settlement.Quantity)
+	}
+
+	// the same route can't meet a minimum output of 3, so neither hop is executed
+	placeRestingOrders(10)
+	ctx = ctx.WithBlockHeight(3)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+	placeRoutedOrder(sdk.NewDec(3))
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(unrelatedContract), pair).Add(&types.Order{
+		Id: 1, Account: testAccount.String(), ContractAddr: unrelatedContract, Price: sdk.NewDec(1), Quantity: sdk.NewDec(1),
+		PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, OrderType: types.OrderType_LIMIT, PositionDirection: types.PositionDirection_LONG, Data: data,
+	})
+	dexkeeper.SetNextOrderID(ctx, unrelatedContract, 2)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, unrelatedContract, dexkeeper.GetContractWithoutGasCharge)
+	ctx = ctx.WithBlockHeight(4)
+	balanceBefore := bankkeeper.GetBalance(ctx, testAccount, "usei").Amount
+	recorder = dexutils.NewReplayRecorder()
+	res := testApp.EndBlocker(ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexReplayRecorderContextKey, recorder)), abci.RequestEndBlock{})
+	// only the gas of the route's contracts in the discarded attempt is charged for
+	attempts = recorder.Attempts()
+	require.True(t, len(attempts) > 1)
+	require.True(t, attempts[0].Contracts[unrelatedContract].GasUsed > 0)
+	routeGasUsed := attempts[0].Contracts[firstContract].GasUsed + attempts[0].Contracts[secondContract].GasUsed
+	expectedFee := sdk.NewDec(int64(routeGasUsed)).Mul(dexkeeper.GetParams(ctx).SudoCallGasPrice).Ceil().RoundInt()
+
+	_, found = dexkeeper.GetLongBookByPrice(ctx, firstContract, sdk.NewDec(2), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	shortBook, found = dexkeeper.GetShortBookByPrice(ctx, secondContract, sdk.NewDec(1), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(8), shortBook.Entry.Quantity)
+	for _, contractAddr := range []string{firstContract, secondContract} {
+		matchResult, _ := dexkeeper.GetMatchResultState(ctx, contractAddr)
+		require.Empty(t, matchResult.Settlements)
+	}
+	reverted, charged := false, false
+	for _, event := range res.Events {
+		if event.Type != types.EventTypeRevertRoutedOrder {
+			continue
+		}
+		reverted = true
+		// the creator paid for the discarded run
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyRevertFee {
+				fee, err := sdk.ParseCoinNormalized(string(attr.Value))
+				require.Nil(t, err)
+				require.True(t, fee.IsPositive())
+				require.Equal(t, expectedFee, fee.Amount)
+				require.Equal(t, balanceBefore.Sub(fee.Amount), bankkeeper.GetBalance(ctx, testAccount, "usei").Amount)
+				charged = true
+			}
+		}
+	}
+	require.True(t, reverted)
+	require.True(t, charged)
+	// neither contract is suspended because of the revert, and no output was moved
+	for _, contractAddr := range []string{firstContract, secondContract} {
+		contract, err := dexkeeper.GetContract(ctx, contractAddr)
+		require.Nil(t, err)
+		require.False(t, contract.Suspended)
+		require.Equal(t, sdk.NewInt(2), bankkeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(contractAddr), pair.PriceDenom).Amount)
+	}
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+
+	// the route is reverted as well if the first contract doesn't hold its output
+	bankkeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(firstContract), testAccount, sdk.NewCoins(sdk.NewCoin(pair.PriceDenom, sdk.NewInt(2))))
+	placeRoutedOrder(sdk.NewDec(2))
+	ctx = ctx.WithBlockHeight(5)
+	res = testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	reverted = false
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeRevertRoutedOrder {
+			reverted = true
+		}
+	}
+	require.True(t, reverted)
+	shortBook, found = dexkeeper.GetShortBookByPrice(ctx, secondContract, sdk.NewDec(1), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(8), shortBook.Entry.Quantity)
+}
+
+func TestAmendOrderInPlaceNotifiesContract(t *testing.T) {
+	testApp := keepertest.TestApp()
+	dexkeeper := testApp.DexKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(dexkeeper.GetMemStoreKey())))
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM", PriceTicksize: &keepertest.TestTicksize, QuantityTicksize: &keepertest.TestTicksize}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	dexAmounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(5000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper.SendCoinsFromAccountToModule(ctx, testAccount, types.ModuleName, dexAmounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	require.Nil(t, err)
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	require.Nil(t, err)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+		sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+	require.Nil(t, err)
+	contractInfo := types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedOrderMatching: true, RentBalance: 100000000}
+	require.Nil(t, setContractWithEscrowedRent(ctx, testApp, &contractInfo))
+	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+	dexkeeper.SetPriceTickSizeForPair(ctx, contractAddr.String(), pair, *pair.PriceTicksize)
+	dexkeeper.SetQuantityTickSizeForPair(ctx, contractAddr.String(), pair, *pair.QuantityTicksize)
+	typedContractAddr := types.ContractAddress(contractAddr.String())
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Add(&types.Order{
+		Id: 1, Account: testAccount.String(), ContractAddr: contractAddr.String(), Price: sdk.NewDec(1), Quantity: sdk.NewDec(2),
+		PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, OrderType: types.OrderType_LIMIT, PositionDirection: types.PositionDirection_LONG,
+		Data: "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+	})
+	dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, typedContractAddr).Add(
+		&types.DepositInfoEntry{Creator: testAccount.String(), Denom: "uusdc", Amount: sdk.MustNewDecFromStr("2000000")},
+	)
+	dexkeeper.SetNextOrderID(ctx, contractAddr.String(), 2)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+
+	server := msgserver.NewMsgServerImpl(dexkeeper)
+	_, err = server.AmendOrders(sdk.WrapSDKContext(ctx), &types.MsgAmendOrders{
+		Creator:      testAccount.String(),
+		ContractAddr: contractAddr.String(),
+		Amendments: []*types.Amendment{{
+			Id: 1, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, PositionDirection: types.PositionDirection_LONG,
+			Price: sdk.NewDec(1), NewPrice: sdk.NewDec(1), NewQuantity: sdk.NewDec(1),
+		}},
+	})
+	require.Nil(t, err)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+	recorder := dexutils.NewReplayRecorder()
+	ctx = ctx.WithBlockHeight(2).WithContext(context.WithValue(ctx.Context(), dexutils.DexReplayRecorderContextKey, recorder))
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+
+	longBook, found := dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.NewDec(1), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1), longBook.Entry.Quantity)
+	// the reduction is sent to the contract as a partial cancellation
+	attempts := recorder.Attempts()
+	require.Len(t, attempts, 1)
+	var cancelCall *dexutils.SudoCallRecord
+	for i, call := range attempts[0].Contracts[contractAddr.String()].SudoCalls {
+		if call.Type == "bulk_order_cancellations" {
+			cancelCall = &attempts[0].Contracts[contractAddr.String()].SudoCalls[i]
+		}
+	}
+	require.NotNil(t, cancelCall)
+	require.Empty(t, cancelCall.Error)
+	msg := types.SudoOrderCancellationMsg{}
+	require.Nil(t, json.Unmarshal(cancelCall.Request, &msg))
+	require.Empty(t, msg.OrderCancellations.IdsToCancel)
+	require.Equal(t, []types.PartialCancellation{{ID: 1, Quantity: sdk.NewDec(1)}}, msg.OrderCancellations.PartialCancellations)
+	matchResult, found := dexkeeper.GetMatchResultState(ctx, contractAddr.String())
+	require.True(t, found)
+	require.Equal(t, 1, len(matchResult.Cancellations))
+	require.Equal(t, types.CancellationInitiator_AMENDED, matchResult.Cancellations[0].Initiator)
+	require.Equal(t, sdk.NewDec(1), matchResult.Cancellations[0].Quantity)
+}
+
+func TestAmendOrderRestoresOriginalOnFailedReplacement(t *testing.T) {
+	testApp := keepertest.TestApp()
+	dexkeeper := testApp.DexKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(dexkeeper.GetMemStoreKey())))
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM", PriceTicksize: &keepertest.TestTicksize, QuantityTicksize: &keepertest.TestTicksize}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	dexAmounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(5000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper.SendCoinsFromAccountToModule(ctx, testAccount, types.ModuleName, dexAmounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	require.Nil(t, err)
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	require.Nil(t, err)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+		sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+	require.Nil(t, err)
+	contractInfo := types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedOrderMatching: true, RentBalance: 100000000}
+	require.Nil(t, setContractWithEscrowedRent(ctx, testApp, &contractInfo))
+	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+	dexkeeper.SetPriceTickSizeForPair(ctx, contractAddr.String(), pair, *pair.PriceTicksize)
+	dexkeeper.SetQuantityTickSizeForPair(ctx, contractAddr.String(), pair, *pair.QuantityTicksize)
+	typedContractAddr := types.ContractAddress(contractAddr.String())
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Add(&types.Order{
+		Id: 1, Account: testAccount.String(), ContractAddr: contractAddr.String(), Price: sdk.NewDec(1), Quantity: sdk.NewDec(2),
+		PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, OrderType: types.OrderType_LIMIT, PositionDirection: types.PositionDirection_LONG,
+		Data: "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+	})
+	dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, typedContractAddr).Add(
+		&types.DepositInfoEntry{Creator: testAccount.String(), Denom: "uusdc", Amount: sdk.MustNewDecFromStr("2000000")},
+	)
+	dexkeeper.SetNextOrderID(ctx, contractAddr.String(), 2)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+
+	server := msgserver.NewMsgServerImpl(dexkeeper)
+	_, err = server.AmendOrders(sdk.WrapSDKContext(ctx), &types.MsgAmendOrders{
+		Creator:      testAccount.String(),
+		ContractAddr: contractAddr.String(),
+		Amendments: []*types.Amendment{{
+			Id: 1, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, PositionDirection: types.PositionDirection_LONG,
+			Price: sdk.NewDec(1), NewPrice: sdk.NewDec(3), NewQuantity: sdk.NewDec(1),
+		}},
+	})
+	require.Nil(t, err)
+
+	// the contract rejects the replacement when placing orders
+	tp := trace.NewNoopTracerProvider()
+	tr := tp.Tracer("component-main")
+	abciWrapper := dexkeeperabci.KeeperWrapper{Keeper: &dexkeeper}
+	require.Nil(t, abciWrapper.HandleEBCancelOrders(ctx.Context(), ctx, &tr, contractAddr.String(), []types.Pair{pair}))
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).MarkFailedToPlace([]types.UnsuccessfulOrder{{ID: 2, Reason: "rejected"}})
+	require.Nil(t, abciWrapper.HandleEBRestoreAmendedOrders(ctx.Context(), ctx, &tr, contractAddr.String(), []types.Pair{pair}))
+
+	// the original order is placed again and no longer cancelled
+	require.Equal(t, 0, len(dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()))
+	restored := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRestoreOrder {
+			restored = true
+		}
+	}
+	require.True(t, restored)
+	orderbook := dexkeeperutils.PopulateOrderbook(ctx, &dexkeeper, typedContractAddr, pair)
+	settlements, _ := contract.ExecutePair(ctx, contractAddr.String(), pair, &dexkeeper, orderbook)
+	require.Equal(t, 0, len(settlements))
+	longBook, found := dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.NewDec(1), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), longBook.Entry.Quantity)
+	require.Equal(t, uint64(1), longBook.Entry.Allocations[0].OrderId)
+	_, found = dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.NewDec(3), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+}
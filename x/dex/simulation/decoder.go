@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding dex type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case hasPrefix(kvA.Key, keeper.ContractPrefixKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.ContractInfoV2{}, &types.ContractInfoV2{})
+		case hasPrefix(kvA.Key, types.LongBookKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.LongBook{}, &types.LongBook{})
+		case hasPrefix(kvA.Key, types.ShortBookKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.ShortBook{}, &types.ShortBook{})
+		case hasPrefix(kvA.Key, types.PriceKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.Price{}, &types.Price{})
+		case hasPrefix(kvA.Key, types.RegisteredPairKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.Pair{}, &types.Pair{})
+		case hasPrefix(kvA.Key, types.AssetListKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.AssetMetadata{}, &types.AssetMetadata{})
+		case hasPrefix(kvA.Key, types.MatchResultKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.MatchResult{}, &types.MatchResult{})
+		case hasPrefix(kvA.Key, types.TriggerBookKey),
+			hasPrefix(kvA.Key, types.TriggeredOrderKey),
+			hasPrefix(kvA.Key, types.ExpiryQueueKey),
+			hasPrefix(kvA.Key, types.AccountOrderKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.Order{}, &types.Order{})
+		case hasPrefix(kvA.Key, types.TradeKey), hasPrefix(kvA.Key, types.AccountTradeKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.Trade{}, &types.Trade{})
+		case hasPrefix(kvA.Key, types.CandleKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.Candle{}, &types.Candle{})
+		case hasPrefix(kvA.Key, types.RentTopUpKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.RentTopUp{}, &types.RentTopUp{})
+		case hasPrefix(kvA.Key, types.ContractExecutionStatsKey):
+			return decodeProtoPair(cdc, kvA, kvB, &types.ContractExecutionStats{}, &types.ContractExecutionStats{})
+		case hasPrefix(kvA.Key, keeper.EpochKey),
+			hasPrefix(kvA.Key, types.NextOrderIDKey),
+			hasPrefix(kvA.Key, types.TriggerBookPriceKey),
+			hasPrefix(kvA.Key, types.LongOrderCountKey),
+			hasPrefix(kvA.Key, types.ShortOrderCountKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid dex key prefix %X", kvA.Key))
+		}
+	}
+}
+
+func hasPrefix(key []byte, prefix string) bool {
+	return bytes.HasPrefix(key, types.KeyPrefix(prefix))
+}
+
+func decodeProtoPair(cdc codec.BinaryCodec, kvA, kvB kv.Pair, valA, valB codec.ProtoMarshaler) string {
+	cdc.MustUnmarshal(kvA.Value, valA)
+	cdc.MustUnmarshal(kvB.Value, valB)
+	return fmt.Sprintf("%v\n%v", valA, valB)
+}
@@ -0,0 +1,98 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	sim "github.com/sei-protocol/sei-chain/x/dex/simulation"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+const (
+	TestContract = "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+	TestAccount  = "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+)
+
+func TestDecodeDexStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := sim.NewDecodeStore(cdc)
+
+	contract := types.ContractInfoV2{CodeId: 1, ContractAddr: TestContract, NeedOrderMatching: true, RentBalance: 100}
+	longBook := types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(10),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 1, Account: TestAccount, Quantity: sdk.NewDec(5)}},
+			PriceDenom:  "USDC",
+			AssetDenom:  "ATOM",
+		},
+	}
+	priceTicksize := sdk.NewDecWithPrec(1, 2)
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", PriceTicksize: &priceTicksize, QuantityTicksize: &priceTicksize}
+	order := types.Order{
+		Id:                1,
+		Account:           TestAccount,
+		ContractAddr:      TestContract,
+		Price:             sdk.NewDec(10),
+		Quantity:          sdk.NewDec(5),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		PositionDirection: types.PositionDirection_LONG,
+	}
+	candle := types.Candle{BeginTimestamp: 60, Open: sdk.NewDec(1), High: sdk.NewDec(2), Low: sdk.NewDec(1), Close: sdk.NewDec(2), Volume: sdk.NewDec(3), VolumeNotional: sdk.NewDec(5)}
+	rentTopUp := types.RentTopUp{ContractAddr: TestContract, Source: TestAccount, Threshold: 100, Amount: 200, SpendLimit: 1000}
+	executionStats := types.ContractExecutionStats{ContractAddr: TestContract, Height: 5, CodeId: 1, SudoCallStats: []types.SudoCallStats{{MsgType: "settlement", Calls: 1, GasUsed: 100}}}
+	orderCount := make([]byte, 8)
+	binary.BigEndian.PutUint64(orderCount, 3)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append([]byte(keeper.ContractPrefixKey), types.ContractKey(TestContract)...), Value: cdc.MustMarshal(&contract)},
+			{Key: append(types.OrderBookPrefix(true, TestContract, "USDC", "ATOM"), keeper.GetKeyForLongBook(longBook)...), Value: cdc.MustMarshal(&longBook)},
+			{Key: append(types.RegisteredPairPrefix(TestContract), types.PairPrefix("USDC", "ATOM")...), Value: cdc.MustMarshal(&pair)},
+			{Key: append(types.AccountOrderPairPrefix(TestContract, TestAccount, "USDC", "ATOM"), keeper.GetKeyForOrderID(1)...), Value: cdc.MustMarshal(&order)},
+			{Key: append(types.CandlePrefix(TestContract, "USDC", "ATOM", 60), keeper.GetKeyForTs(60)...), Value: cdc.MustMarshal(&candle)},
+			{Key: types.RentTopUpPrefix(TestContract), Value: cdc.MustMarshal(&rentTopUp)},
+			{Key: append(types.ContractExecutionStatsPrefix(TestContract), keeper.GetKeyForHeight(5)...), Value: cdc.MustMarshal(&executionStats)},
+			{Key: types.OrderCountPrefix(TestContract, "USDC", "ATOM", true), Value: orderCount},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Contract", fmt.Sprintf("%v\n%v", &contract, &contract)},
+		{"LongBook", fmt.Sprintf("%v\n%v", &longBook, &longBook)},
+		{"RegisteredPair", fmt.Sprintf("%v\n%v", &pair, &pair)},
+		{"AccountOrder", fmt.Sprintf("%v\n%v", &order, &order)},
+		{"Candle", fmt.Sprintf("%v\n%v", &candle, &candle)},
+		{"RentTopUp", fmt.Sprintf("%v\n%v", &rentTopUp, &rentTopUp)},
+		{"ContractExecutionStats", fmt.Sprintf("%v\n%v", &executionStats, &executionStats)},
+		{"OrderCount", "3\n3"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
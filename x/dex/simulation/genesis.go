@@ -0,0 +1,93 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Simulation parameter constants
+const (
+	priceSnapshotRetentionKey = "price_snapshot_retention"
+	contractUnsuspendCostKey  = "contract_unsuspend_cost"
+	tradeLogRetentionKey      = "trade_log_retention"
+	candleRetentionKey        = "candle_retention"
+)
+
+// GenPriceSnapshotRetention randomized PriceSnapshotRetention
+func GenPriceSnapshotRetention(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(2*types.DefaultPriceSnapshotRetention))
+}
+
+// GenContractUnsuspendCost randomized ContractUnsuspendCost
+func GenContractUnsuspendCost(r *rand.Rand) uint64 {
+	return uint64(r.Intn(2 * types.DefaultContractUnsuspendCost))
+}
+
+// GenTradeLogRetention randomized TradeLogRetention, disabling the trade log half of the time
+func GenTradeLogRetention(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(1 + r.Intn(24*3600))
+}
+
+// GenCandleRetention randomized CandleRetention, disabling candles a quarter of the time
+func GenCandleRetention(r *rand.Rand) uint64 {
+	if r.Intn(4) == 0 {
+		return 0
+	}
+	return uint64(1 + r.Intn(2*types.DefaultCandleRetention))
+}
+
+// RandomizedGenState generates a random GenesisState for dex. Contracts are not
+// part of the randomized genesis; they are instantiated and registered by the
+// simulation operations instead.
+func RandomizedGenState(simState *module.SimulationState) {
+	var priceSnapshotRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, priceSnapshotRetentionKey, &priceSnapshotRetention, simState.Rand,
+		func(r *rand.Rand) { priceSnapshotRetention = GenPriceSnapshotRetention(r) },
+	)
+
+	var contractUnsuspendCost uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, contractUnsuspendCostKey, &contractUnsuspendCost, simState.Rand,
+		func(r *rand.Rand) { contractUnsuspendCost = GenContractUnsuspendCost(r) },
+	)
+
+	var tradeLogRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, tradeLogRetentionKey, &tradeLogRetention, simState.Rand,
+		func(r *rand.Rand) { tradeLogRetention = GenTradeLogRetention(r) },
+	)
+
+	var candleRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, candleRetentionKey, &candleRetention, simState.Rand,
+		func(r *rand.Rand) { candleRetention = GenCandleRetention(r) },
+	)
+
+	params := types.DefaultParams()
+	params.PriceSnapshotRetention = priceSnapshotRetention
+	params.ContractUnsuspendCost = contractUnsuspendCost
+	params.TradeLogRetention = tradeLogRetention
+	params.CandleRetention = candleRetention
+	dexGenesis := types.GenesisState{
+		Params:        params,
+		ContractState: []types.ContractState{},
+	}
+
+	bz, err := json.MarshalIndent(&dexGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated dex parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&dexGenesis)
+}
@@ -0,0 +1,546 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgStoreMarsCode           = "op_weight_msg_store_mars_code"
+	OpWeightMsgInstantiateMarsContract = "op_weight_msg_instantiate_mars_contract"
+	OpWeightMsgRegisterContract        = "op_weight_msg_register_contract"
+	OpWeightMsgRegisterPairs           = "op_weight_msg_register_pairs"
+	OpWeightMsgPlaceOrders             = "op_weight_msg_place_orders"
+	OpWeightMsgCancelOrders            = "op_weight_msg_cancel_orders"
+	OpWeightMsgContractDepositRent     = "op_weight_msg_contract_deposit_rent"
+	OpWeightMsgUnsuspendContract       = "op_weight_msg_unsuspend_contract"
+	OpMarsContractPath                 = "op_mars_contract_path"
+)
+
+// The order-matching test contract is instantiated with the same configuration
+// as in the dex module tests. Its denoms are the ones pairs are registered with.
+const marsInstantiateMsg = `{"whitelist": ["sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag"],
+	"use_whitelist":false,"admin":"sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag",
+	"limit_order_fee":{"decimal":"0.0001","negative":false},
+	"market_order_fee":{"decimal":"0.0001","negative":false},
+	"liquidation_order_fee":{"decimal":"0.0001","negative":false},
+	"margin_ratio":{"decimal":"0.0625","negative":false},
+	"max_leverage":{"decimal":"4","negative":false},
+	"default_base":"USDC",
+	"native_token":"USDC","denoms": ["SEI","ATOM","USDC","SOL","ETH","OSMO","AVAX","BTC"],
+	"full_denom_mapping": [["usei","SEI","0.000001"],["uatom","ATOM","0.000001"],["uusdc","USDC","0.000001"]],
+	"funding_payment_lookback":3600,"spot_market_contract":"sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag",
+	"supported_collateral_denoms": ["USDC"],
+	"supported_multicollateral_denoms": ["ATOM"],
+	"oracle_denom_mapping": [["usei","SEI","1"],["uatom","ATOM","1"],["uusdc","USDC","1"],["ueth","ETH","1"]],
+	"multicollateral_whitelist": ["sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag"],
+	"multicollateral_whitelist_enable": true,
+	"funding_payment_pairs": [["USDC","ETH"]],
+	"default_margin_ratios":{
+		"initial":"0.3",
+		"partial":"0.25",
+		"maintenance":"0.06"
+	}}`
+
+const marsOrderData = `{"position_effect":"Open","leverage":"1"}`
+
+var (
+	marsDenoms   = []string{"SEI", "ATOM", "USDC", "SOL", "ETH", "OSMO", "AVAX", "BTC"}
+	simTickSizes = []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 1), sdk.OneDec()}
+	simMaxOrders = 5
+	simMaxTicks  = int64(100)
+	simMaxFunds  = int64(1000000)
+	simMaxTxFee  = int64(100000)
+)
+
+// WasmKeeper is the subset of the wasm keeper used by dex simulations
+type WasmKeeper interface {
+	GetParams(ctx sdk.Context) wasmtypes.Params
+	IterateCodeInfos(ctx sdk.Context, cb func(uint64, wasmtypes.CodeInfo) bool)
+	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// Contracts are run against the order-matching test contract of the dex module, which
+// is stored and instantiated by the operations themselves.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	wk WasmKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgStoreMarsCode           int
+		weightMsgInstantiateMarsContract int
+		weightMsgRegisterContract        int
+		weightMsgRegisterPairs           int
+		weightMsgPlaceOrders             int
+		weightMsgCancelOrders            int
+		weightMsgContractDepositRent     int
+		weightMsgUnsuspendContract       int
+		marsContractPath                 string
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgStoreMarsCode, &weightMsgStoreMarsCode, nil,
+		func(_ *rand.Rand) { weightMsgStoreMarsCode = 5 },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgInstantiateMarsContract, &weightMsgInstantiateMarsContract, nil,
+		func(_ *rand.Rand) { weightMsgInstantiateMarsContract = 10 },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterContract, &weightMsgRegisterContract, nil,
+		func(_ *rand.Rand) { weightMsgRegisterContract = 10 },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterPairs, &weightMsgRegisterPairs, nil,
+		func(_ *rand.Rand) { weightMsgRegisterPairs = 20 },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgPlaceOrders, &weightMsgPlaceOrders, nil,
+		func(_ *rand.Rand) { weightMsgPlaceOrders = simappparams.DefaultWeightMsgSend },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelOrders, &weightMsgCancelOrders, nil,
+		func(_ *rand.Rand) { weightMsgCancelOrders = simappparams.DefaultWeightMsgSend / 2 },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgContractDepositRent, &weightMsgContractDepositRent, nil,
+		func(_ *rand.Rand) { weightMsgContractDepositRent = 10 },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnsuspendContract, &weightMsgUnsuspendContract, nil,
+		func(_ *rand.Rand) { weightMsgUnsuspendContract = 10 },
+	)
+	appParams.GetOrGenerate(cdc, OpMarsContractPath, &marsContractPath, nil,
+		func(_ *rand.Rand) {
+			// simulations are run from the `app` folder
+			marsContractPath = "../x/dex/testdata/mars.wasm"
+		},
+	)
+
+	marsBz, err := os.ReadFile(marsContractPath)
+	if err != nil {
+		panic(err)
+	}
+	marsChecksum := sha256.Sum256(marsBz)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgStoreMarsCode,
+			SimulateMsgStoreMarsCode(ak, bk, wk, marsBz),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgInstantiateMarsContract,
+			SimulateMsgInstantiateMarsContract(ak, bk, wk, marsChecksum[:]),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRegisterContract,
+			SimulateMsgRegisterContract(ak, bk, wk, k, marsChecksum[:]),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRegisterPairs,
+			SimulateMsgRegisterPairs(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgPlaceOrders,
+			SimulateMsgPlaceOrders(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelOrders,
+			SimulateMsgCancelOrders(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgContractDepositRent,
+			SimulateMsgContractDepositRent(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUnsuspendContract,
+			SimulateMsgUnsuspendContract(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgStoreMarsCode uploads the order-matching test contract if it hasn't been uploaded yet.
+func SimulateMsgStoreMarsCode(ak types.AccountKeeper, bk types.BankKeeper, wk WasmKeeper, marsBz []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := wasmtypes.MsgStoreCode{}.Type()
+		if wk.GetParams(ctx).CodeUploadAccess.Permission != wasmtypes.AccessTypeEverybody {
+			return simtypes.NoOpMsg(wasmtypes.ModuleName, msgType, "no chain permission"), nil, nil
+		}
+		checksum := sha256.Sum256(marsBz)
+		if _, found := findMarsCode(ctx, wk, checksum[:]); found {
+			return simtypes.NoOpMsg(wasmtypes.ModuleName, msgType, "contract already stored"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &wasmtypes.MsgStoreCode{
+			Sender:                simAccount.Address.String(),
+			WASMByteCode:          marsBz,
+			InstantiatePermission: &wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeEverybody},
+		}
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, wasmtypes.ModuleName, sdk.NewCoins(), sdk.ZeroInt())
+	}
+}
+
+// SimulateMsgInstantiateMarsContract instantiates the order-matching test contract.
+func SimulateMsgInstantiateMarsContract(ak types.AccountKeeper, bk types.BankKeeper, wk WasmKeeper, marsChecksum []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := wasmtypes.MsgInstantiateContract{}.Type()
+		codeID, found := findMarsCode(ctx, wk, marsChecksum)
+		if !found {
+			return simtypes.NoOpMsg(wasmtypes.ModuleName, msgType, "contract not stored"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &wasmtypes.MsgInstantiateContract{
+			Sender: simAccount.Address.String(),
+			Admin:  simAccount.Address.String(),
+			CodeID: codeID,
+			Label:  simtypes.RandStringOfLength(r, 10),
+			Msg:    []byte(marsInstantiateMsg),
+		}
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, wasmtypes.ModuleName, sdk.NewCoins(), sdk.ZeroInt())
+	}
+}
+
+// SimulateMsgRegisterContract registers an instantiated test contract with the dex module
+// on behalf of the account that instantiated it.
+func SimulateMsgRegisterContract(ak types.AccountKeeper, bk types.BankKeeper, wk WasmKeeper, k keeper.Keeper, marsChecksum []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		codeID, found := findMarsCode(ctx, wk, marsChecksum)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterContract, "contract not stored"), nil, nil
+		}
+
+		type candidate struct {
+			contractAddr sdk.AccAddress
+			creator      simtypes.Account
+		}
+		candidates := []candidate{}
+		wk.IterateContractsByCode(ctx, codeID, func(contractAddr sdk.AccAddress) bool {
+			if _, err := k.GetContract(ctx, contractAddr.String()); err == nil {
+				return false
+			}
+			// only the account that instantiated the contract can register it
+			if creator, found := FindAccount(accs, wk.GetContractInfo(ctx, contractAddr).Creator); found {
+				candidates = append(candidates, candidate{contractAddr: contractAddr, creator: creator})
+			}
+			return false
+		})
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterContract, "no unregistered contract"), nil, nil
+		}
+		picked := candidates[r.Intn(len(candidates))]
+
+		minRent := k.GetParams(ctx).MinRentDeposit
+		rent := minRent + uint64(r.Int63n(int64(minRent)+1))
+		msg := types.NewMsgRegisterContract(picked.creator.Address.String(), codeID, picked.contractAddr.String(), true, nil, rent)
+		spent := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(rent)))
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, picked.creator, msg, types.ModuleName, spent, sdk.ZeroInt())
+	}
+}
+
+// SimulateMsgRegisterPairs registers a random pair of the test contract's denoms.
+func SimulateMsgRegisterPairs(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		maxPairs := k.GetMaxPairsPerContract(ctx)
+		contract, creator, found := randomContract(r, ctx, k, accs, func(contract types.ContractInfoV2) bool {
+			return uint64(len(k.GetAllRegisteredPairs(ctx, contract.ContractAddr))) < maxPairs
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterPairs, "no contract to register pairs for"), nil, nil
+		}
+
+		denoms := r.Perm(len(marsDenoms))
+		priceTicksize := simTickSizes[r.Intn(len(simTickSizes))]
+		quantityTicksize := simTickSizes[r.Intn(len(simTickSizes))]
+		pair := types.Pair{
+			PriceDenom:       marsDenoms[denoms[0]],
+			AssetDenom:       marsDenoms[denoms[1]],
+			PriceTicksize:    &priceTicksize,
+			QuantityTicksize: &quantityTicksize,
+		}
+		msg := types.NewMsgRegisterPairs(creator.Address.String(), []types.BatchContractPair{
+			{ContractAddr: contract.ContractAddr, Pairs: []*types.Pair{&pair}},
+		})
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, creator, msg, types.ModuleName, sdk.NewCoins(), sdk.ZeroInt())
+	}
+}
+
+// SimulateMsgPlaceOrders places random limit and market orders on the pairs of a
+// registered contract.
+// nolint: funlen
+func SimulateMsgPlaceOrders(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, _, found := randomContract(r, ctx, k, nil, func(contract types.ContractInfoV2) bool {
+			return !contract.Suspended && len(k.GetAllRegisteredPairs(ctx, contract.ContractAddr)) > 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrders, "no contract with pairs"), nil, nil
+		}
+		pairs := k.GetAllRegisteredPairs(ctx, contract.ContractAddr)
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
+		orders := []*types.Order{}
+		for i := 0; i < 1+r.Intn(simMaxOrders); i++ {
+			pair := pairs[r.Intn(len(pairs))]
+			direction := types.PositionDirection_LONG
+			if r.Intn(2) == 0 {
+				direction = types.PositionDirection_SHORT
+			}
+			orderType := types.OrderType_LIMIT
+			if r.Intn(4) == 0 {
+				orderType = types.OrderType_MARKET
+			}
+			price := pair.PriceTicksize.MulInt64(1 + r.Int63n(simMaxTicks))
+			if k.GetOrderCountState(ctx, contract.ContractAddr, pair.PriceDenom, pair.AssetDenom, direction, price) >= maxOrderPerPrice {
+				continue
+			}
+			orders = append(orders, &types.Order{
+				Price:             price,
+				Quantity:          pair.QuantityTicksize.MulInt64(1 + r.Int63n(simMaxTicks)),
+				PriceDenom:        pair.PriceDenom,
+				AssetDenom:        pair.AssetDenom,
+				OrderType:         orderType,
+				PositionDirection: direction,
+				Data:              marsOrderData,
+			})
+		}
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrders, "price levels are full"), nil, nil
+		}
+
+		funds := sdk.NewCoins()
+		if r.Intn(2) == 0 {
+			funds = sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1+r.Int63n(simMaxFunds))))
+		}
+		msg := types.NewMsgPlaceOrders(simAccount.Address.String(), orders, contract.ContractAddr, funds)
+
+		params := k.GetParams(ctx)
+		numDependencies := len(dexutils.GetMemState(ctx.Context()).GetContractToDependencies(ctx, contract.ContractAddr, k.GetContractWithoutGasCharge))
+		dexGas := params.DefaultGasPerOrder * uint64(len(orders)*numDependencies)
+		for _, order := range orders {
+			dexGas += params.DefaultGasPerOrderDataByte * uint64(len(order.Data))
+		}
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, types.ModuleName, funds, dexFee(params, dexGas))
+	}
+}
+
+// SimulateMsgCancelOrders cancels resting orders of a random account.
+func SimulateMsgCancelOrders(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, _, found := randomContract(r, ctx, k, nil, func(types.ContractInfoV2) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelOrders, "no contract"), nil, nil
+		}
+
+		// look for an account with resting orders, starting from a random one
+		offset := r.Intn(len(accs))
+		var simAccount simtypes.Account
+		var orders []*types.Order
+		for i := range accs {
+			simAccount = accs[(offset+i)%len(accs)]
+			accountOrders, _, err := k.GetAccountOrdersPaginated(ctx, contract.ContractAddr, simAccount.Address.String(), nil)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelOrders, "unable to get account orders"), nil, err
+			}
+			if len(accountOrders) > 0 {
+				orders = accountOrders
+				break
+			}
+		}
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelOrders, "no resting orders"), nil, nil
+		}
+
+		cancellations := []*types.Cancellation{}
+		for _, i := range r.Perm(len(orders))[:1+r.Intn(len(orders))] {
+			cancellations = append(cancellations, &types.Cancellation{
+				Id:                orders[i].Id,
+				Price:             orders[i].Price,
+				PriceDenom:        orders[i].PriceDenom,
+				AssetDenom:        orders[i].AssetDenom,
+				PositionDirection: orders[i].PositionDirection,
+			})
+		}
+		msg := types.NewMsgCancelOrders(simAccount.Address.String(), cancellations, contract.ContractAddr)
+
+		params := k.GetParams(ctx)
+		numDependencies := len(dexutils.GetMemState(ctx.Context()).GetContractToDependencies(ctx, contract.ContractAddr, k.GetContractWithoutGasCharge))
+		dexGas := params.DefaultGasPerCancel * uint64(len(cancellations)*numDependencies)
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, types.ModuleName, sdk.NewCoins(), dexFee(params, dexGas))
+	}
+}
+
+// SimulateMsgContractDepositRent deposits rent for a random registered contract.
+func SimulateMsgContractDepositRent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, _, found := randomContract(r, ctx, k, nil, func(types.ContractInfoV2) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgContractDepositRent, "no contract"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		minRent := k.GetParams(ctx).MinRentDeposit
+		amount := minRent + uint64(r.Int63n(int64(minRent)+1))
+		msg := types.NewMsgContractDepositRent(contract.ContractAddr, amount, simAccount.Address.String())
+		spent := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(amount)))
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, types.ModuleName, spent, sdk.ZeroInt())
+	}
+}
+
+// SimulateMsgUnsuspendContract unsuspends a random suspended contract that has enough rent.
+func SimulateMsgUnsuspendContract(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		cost := k.GetContractUnsuspendCost(ctx)
+		contract, _, found := randomContract(r, ctx, k, nil, func(contract types.ContractInfoV2) bool {
+			return contract.Suspended && contract.RentBalance >= cost
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnsuspendContract, "no suspended contract"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgUnsuspendContract(simAccount.Address.String(), contract.ContractAddr)
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, types.ModuleName, sdk.NewCoins(), sdk.ZeroInt())
+	}
+}
+
+// Returns the ID of the uploaded code that matches the test contract checksum
+func findMarsCode(ctx sdk.Context, wk WasmKeeper, checksum []byte) (codeID uint64, found bool) {
+	wk.IterateCodeInfos(ctx, func(id uint64, info wasmtypes.CodeInfo) bool {
+		if bytes.Equal(info.CodeHash, checksum) && info.InstantiateConfig.Permission == wasmtypes.AccessTypeEverybody {
+			codeID, found = id, true
+		}
+		return found
+	})
+	return codeID, found
+}
+
+// Returns a random registered contract satisfying `filter`. If `accs` is set, only
+// contracts created by one of the accounts are considered and the creator is returned.
+func randomContract(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	filter func(types.ContractInfoV2) bool,
+) (types.ContractInfoV2, simtypes.Account, bool) {
+	type candidate struct {
+		contract types.ContractInfoV2
+		creator  simtypes.Account
+	}
+	candidates := []candidate{}
+	for _, contract := range k.GetAllContractInfo(ctx) {
+		if !filter(contract) {
+			continue
+		}
+		if accs == nil {
+			candidates = append(candidates, candidate{contract: contract})
+			continue
+		}
+		if creator, found := FindAccount(accs, contract.Creator); found {
+			candidates = append(candidates, candidate{contract: contract, creator: creator})
+		}
+	}
+	if len(candidates) == 0 {
+		return types.ContractInfoV2{}, simtypes.Account{}, false
+	}
+	picked := candidates[r.Intn(len(candidates))]
+	return picked.contract, picked.creator, true
+}
+
+// Returns the fee in usei that the dex ante handler requires for `dexGas`
+func dexFee(params types.Params, dexGas uint64) sdk.Int {
+	return sdk.NewDecFromBigInt(new(big.Int).SetUint64(dexGas)).Mul(params.SudoCallGasPrice).Ceil().RoundInt()
+}
+
+// Generates a tx paying at least `minFee` in usei and delivers it. Dex messages
+// need the dex memstate carried by the context, so the tx is delivered with the
+// given context instead of the deliver state of the base app.
+func genAndDeliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	chainID string,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	moduleName string,
+	coinsSpentInMsg sdk.Coins,
+	minFee sdk.Int,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simtypes.NoOpMsg(moduleName, msgType, "account does not exist"), nil, nil
+	}
+	fees := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, minFee.AddRaw(r.Int63n(simMaxTxFee))))
+	if _, hasNeg := bk.SpendableCoins(ctx, simAccount.Address).SafeSub(coinsSpentInMsg.Add(fees...)); hasNeg {
+		return simtypes.NoOpMsg(moduleName, msgType, "insufficient funds"), nil, nil
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(moduleName, msgType, "unable to generate mock tx"), nil, err
+	}
+	bz, err := txGen.TxEncoder()(tx)
+	if err != nil {
+		return simtypes.NoOpMsg(moduleName, msgType, "unable to encode mock tx"), nil, err
+	}
+
+	res := app.DeliverTx(ctx, abci.RequestDeliverTx{Tx: bz})
+	if !res.IsOK() {
+		return simtypes.NoOpMsg(moduleName, msgType, "unable to deliver tx"), nil, fmt.Errorf("code %d: %s", res.Code, res.Log)
+	}
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
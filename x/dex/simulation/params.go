@@ -0,0 +1,40 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPriceSnapshotRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenPriceSnapshotRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyContractUnsuspendCost),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenContractUnsuspendCost(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTradeLogRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenTradeLogRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCandleRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenCandleRetention(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// Intervals, in seconds, at which OHLCV candles are maintained for every pair,
+// from the finest to the coarsest: 1m, 5m, 1h and 1d.
+var CandleIntervalsInSeconds = []uint64{60, 300, 3600, 86400}
+
+// NewCandle returns an empty candle opened at `price`.
+func NewCandle(beginTimestamp uint64, intervalInSeconds uint64, price sdk.Dec) Candle {
+	return Candle{
+		BeginTimestamp:    beginTimestamp,
+		IntervalInSeconds: intervalInSeconds,
+		Open:              price,
+		High:              price,
+		Low:               price,
+		Close:             price,
+		Volume:            sdk.ZeroDec(),
+		VolumeNotional:    sdk.ZeroDec(),
+	}
+}
+
+// AddTrade folds a fill into the candle. Fills must be added in execution order.
+func (c *Candle) AddTrade(price sdk.Dec, quantity sdk.Dec) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume = c.Volume.Add(quantity)
+	c.VolumeNotional = c.VolumeNotional.Add(price.Mul(quantity))
+}
@@ -20,6 +20,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnregisterContract{}, "dex/MsgUnregisterContract", nil)
 	cdc.RegisterConcrete(&MsgContractDepositRent{}, "dex/MsgContractDepositRent", nil)
 	cdc.RegisterConcrete(&MsgUnsuspendContract{}, "dex/MsgUnsuspendContract", nil)
+	cdc.RegisterConcrete(&MsgAmendOrders{}, "dex/MsgAmendOrders", nil)
+	cdc.RegisterConcrete(&UpdatePairMatchingAlgorithmProposal{}, "dex/UpdatePairMatchingAlgorithmProposal", nil)
+	cdc.RegisterConcrete(&MsgHaltPair{}, "dex/MsgHaltPair", nil)
+	cdc.RegisterConcrete(&MsgResumePair{}, "dex/MsgResumePair", nil)
+	cdc.RegisterConcrete(&HaltPairProposal{}, "dex/HaltPairProposal", nil)
+	cdc.RegisterConcrete(&UpdatePairMatchingModeProposal{}, "dex/UpdatePairMatchingModeProposal", nil)
+	cdc.RegisterConcrete(&MsgSetRentTopUp{}, "dex/MsgSetRentTopUp", nil)
+	cdc.RegisterConcrete(&MsgRevokeRentTopUp{}, "dex/MsgRevokeRentTopUp", nil)
+	cdc.RegisterConcrete(&MsgPlaceRoutedOrder{}, "dex/MsgPlaceRoutedOrder", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -54,6 +63,33 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnsuspendContract{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendOrders{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdatePairMatchingAlgorithmProposal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgHaltPair{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResumePair{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&HaltPairProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdatePairMatchingModeProposal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRentTopUp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeRentTopUp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceRoutedOrder{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,45 @@
+package types
+
+// AddSudoCall records a sudo call of `msgType` that used `gasUsed` gas.
+func (s *ContractExecutionStats) AddSudoCall(msgType string, gasUsed uint64, failed bool) {
+	failures := uint64(0)
+	if failed {
+		failures = 1
+	}
+	s.addSudoCallStats(SudoCallStats{MsgType: msgType, Calls: 1, GasUsed: gasUsed, Failures: failures})
+}
+
+// Merge adds the stats of `other` into `s`. The height and code ID of `s` are
+// taken from `other` if it's more recent.
+func (s *ContractExecutionStats) Merge(other ContractExecutionStats) {
+	if other.Height >= s.Height {
+		s.Height = other.Height
+		s.CodeId = other.CodeId
+	}
+	for _, sudoCallStats := range other.SudoCallStats {
+		s.addSudoCallStats(sudoCallStats)
+	}
+	s.OrdersProcessed += other.OrdersProcessed
+	s.Failures += other.Failures
+}
+
+// TotalGasUsed returns the gas used by sudo calls of all message types.
+func (s *ContractExecutionStats) TotalGasUsed() uint64 {
+	total := uint64(0)
+	for _, sudoCallStats := range s.SudoCallStats {
+		total += sudoCallStats.GasUsed
+	}
+	return total
+}
+
+func (s *ContractExecutionStats) addSudoCallStats(stats SudoCallStats) {
+	for i := range s.SudoCallStats {
+		if s.SudoCallStats[i].MsgType == stats.MsgType {
+			s.SudoCallStats[i].Calls += stats.Calls
+			s.SudoCallStats[i].GasUsed += stats.GasUsed
+			s.SudoCallStats[i].Failures += stats.Failures
+			return
+		}
+	}
+	s.SudoCallStats = append(s.SudoCallStats, stats)
+}
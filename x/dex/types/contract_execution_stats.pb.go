@@ -0,0 +1,818 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/contract_execution_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Gas used by the sudo calls of a single message type made to a contract
+type SudoCallStats struct {
+	MsgType  string `protobuf:"bytes,1,opt,name=msgType,proto3" json:"msg_type"`
+	Calls    uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls"`
+	GasUsed  uint64 `protobuf:"varint,3,opt,name=gasUsed,proto3" json:"gas_used"`
+	Failures uint64 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures"`
+}
+
+func (m *SudoCallStats) Reset()         { *m = SudoCallStats{} }
+func (m *SudoCallStats) String() string { return proto.CompactTextString(m) }
+func (*SudoCallStats) ProtoMessage()    {}
+func (*SudoCallStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1aa4505218b8870f, []int{0}
+}
+func (m *SudoCallStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoCallStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoCallStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoCallStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoCallStats.Merge(m, src)
+}
+func (m *SudoCallStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoCallStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoCallStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoCallStats proto.InternalMessageInfo
+
+func (m *SudoCallStats) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *SudoCallStats) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *SudoCallStats) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SudoCallStats) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+// Execution of a contract during the dex EndBlocker of a block, or aggregated over
+// multiple blocks
+type ContractExecutionStats struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	// the last block the stats include
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	// the code ID of the contract as of `height`
+	CodeId        uint64          `protobuf:"varint,3,opt,name=codeId,proto3" json:"code_id"`
+	SudoCallStats []SudoCallStats `protobuf:"bytes,4,rep,name=sudoCallStats,proto3" json:"sudo_call_stats"`
+	// number of orders sent to the contract for placement
+	OrdersProcessed uint64 `protobuf:"varint,5,opt,name=ordersProcessed,proto3" json:"orders_processed"`
+	// number of times the contract failed the EndBlocker or ran out of rent
+	Failures uint64 `protobuf:"varint,6,opt,name=failures,proto3" json:"failures"`
+}
+
+func (m *ContractExecutionStats) Reset()         { *m = ContractExecutionStats{} }
+func (m *ContractExecutionStats) String() string { return proto.CompactTextString(m) }
+func (*ContractExecutionStats) ProtoMessage()    {}
+func (*ContractExecutionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1aa4505218b8870f, []int{1}
+}
+func (m *ContractExecutionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecutionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecutionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecutionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecutionStats.Merge(m, src)
+}
+func (m *ContractExecutionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecutionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecutionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecutionStats proto.InternalMessageInfo
+
+func (m *ContractExecutionStats) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *ContractExecutionStats) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetSudoCallStats() []SudoCallStats {
+	if m != nil {
+		return m.SudoCallStats
+	}
+	return nil
+}
+
+func (m *ContractExecutionStats) GetOrdersProcessed() uint64 {
+	if m != nil {
+		return m.OrdersProcessed
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SudoCallStats)(nil), "seiprotocol.seichain.dex.SudoCallStats")
+	proto.RegisterType((*ContractExecutionStats)(nil), "seiprotocol.seichain.dex.ContractExecutionStats")
+}
+
+func init() {
+	proto.RegisterFile("dex/contract_execution_stats.proto", fileDescriptor_1aa4505218b8870f)
+}
+
+var fileDescriptor_1aa4505218b8870f = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
+	0x18, 0x8d, 0x9b, 0x34, 0x6d, 0xd5, 0x96, 0x0e, 0x53, 0x36, 0xb3, 0x83, 0x1d, 0x32, 0xd8, 0x72,
+	0xa9, 0x0d, 0xdb, 0x65, 0xa7, 0xc1, 0x5c, 0xc6, 0xd8, 0x6d, 0xa8, 0xdb, 0x65, 0x17, 0xa3, 0x4a,
+	0x5f, 0x1d, 0x81, 0x13, 0x05, 0x7d, 0x32, 0xa4, 0xff, 0x62, 0xbf, 0x63, 0xbf, 0xa4, 0xc7, 0x1e,
+	0x77, 0x32, 0x23, 0xb9, 0x19, 0xf6, 0x1f, 0x86, 0x64, 0xab, 0x5b, 0x06, 0xdb, 0x49, 0x9f, 0xde,
+	0x7b, 0x3c, 0xbe, 0xf7, 0x24, 0x32, 0x15, 0xb0, 0xce, 0xb8, 0x5a, 0x1a, 0xcd, 0xb8, 0x29, 0x60,
+	0x0d, 0xbc, 0x36, 0x52, 0x2d, 0x0b, 0x34, 0xcc, 0x60, 0xba, 0xd2, 0xca, 0xa8, 0x30, 0x42, 0x90,
+	0x6e, 0xe2, 0xaa, 0x4a, 0x11, 0x24, 0x9f, 0x33, 0xb9, 0x4c, 0x05, 0xac, 0x9f, 0x9e, 0x97, 0xaa,
+	0x54, 0x8e, 0xca, 0xec, 0xd4, 0xe9, 0xa7, 0xdf, 0x02, 0x72, 0x7a, 0x55, 0x0b, 0x75, 0xc9, 0xaa,
+	0xea, 0xca, 0xfa, 0x84, 0xcf, 0xc9, 0xc1, 0x02, 0xcb, 0x4f, 0xb7, 0x2b, 0x88, 0x82, 0x49, 0x30,
+	0x3b, 0xca, 0x4f, 0xda, 0x26, 0x39, 0x5c, 0x60, 0x59, 0x98, 0xdb, 0x15, 0x50, 0x4f, 0x86, 0x09,
+	0xd9, 0xe7, 0xac, 0xaa, 0x30, 0xda, 0x9b, 0x04, 0xb3, 0x51, 0x7e, 0xd4, 0x36, 0x49, 0x07, 0xd0,
+	0xee, 0xb0, 0x46, 0x25, 0xc3, 0xcf, 0x08, 0x22, 0x1a, 0x3a, 0x89, 0x33, 0x2a, 0x19, 0x16, 0x35,
+	0x82, 0xa0, 0x9e, 0x0c, 0x67, 0xe4, 0xf0, 0x86, 0xc9, 0xaa, 0xd6, 0x80, 0xd1, 0xe8, 0xb7, 0xd0,
+	0x63, 0xf4, 0x61, 0x9a, 0xfe, 0xdc, 0x23, 0x8f, 0x2f, 0xfb, 0xfc, 0xef, 0x7c, 0xfc, 0x6e, 0xeb,
+	0xd7, 0xe4, 0xc4, 0x37, 0xf3, 0x56, 0x08, 0xdd, 0xaf, 0x7e, 0xde, 0x36, 0xc9, 0xa3, 0x87, 0xc6,
+	0x98, 0x10, 0x1a, 0x10, 0xe9, 0x8e, 0x32, 0x9c, 0x92, 0xf1, 0x1c, 0x64, 0x39, 0x37, 0x2e, 0xc8,
+	0x30, 0x27, 0x6d, 0x93, 0xf4, 0x08, 0xed, 0xcf, 0xf0, 0x19, 0x19, 0x73, 0x25, 0xe0, 0x83, 0x4f,
+	0x72, 0xdc, 0x36, 0xc9, 0x81, 0x45, 0x0a, 0x29, 0x68, 0x4f, 0x85, 0x37, 0xe4, 0x14, 0xff, 0x6c,
+	0x32, 0x1a, 0x4d, 0x86, 0xb3, 0xe3, 0x97, 0x2f, 0xd2, 0x7f, 0x3d, 0x49, 0xba, 0x53, 0x7c, 0xfe,
+	0xe4, 0xae, 0x49, 0x06, 0x6d, 0x93, 0x9c, 0x59, 0x97, 0xc2, 0x76, 0xd8, 0xbd, 0x2c, 0xdd, 0xb5,
+	0x0d, 0xdf, 0x90, 0x33, 0xa5, 0x05, 0x68, 0xfc, 0xa8, 0x15, 0x07, 0xb4, 0xfd, 0xee, 0xbb, 0xad,
+	0x5c, 0xda, 0x8e, 0x2a, 0x56, 0x9e, 0xa3, 0x7f, 0x8b, 0x77, 0xfa, 0x1e, 0xff, 0xaf, 0xef, 0xfc,
+	0xfd, 0xdd, 0x26, 0x0e, 0xee, 0x37, 0x71, 0xf0, 0x63, 0x13, 0x07, 0x5f, 0xb7, 0xf1, 0xe0, 0x7e,
+	0x1b, 0x0f, 0xbe, 0x6f, 0xe3, 0xc1, 0x97, 0x8b, 0x52, 0x9a, 0x79, 0x7d, 0x9d, 0x72, 0xb5, 0xc8,
+	0x10, 0xe4, 0x85, 0xcf, 0xe7, 0x2e, 0x2e, 0x60, 0xb6, 0xce, 0xec, 0x77, 0xb5, 0x7f, 0x06, 0xaf,
+	0xc7, 0x8e, 0x7f, 0xf5, 0x6b, 0x00, 0xd4, 0x94, 0x9e, 0x4b, 0xc2, 0x02, 0x00, 0x00,
+}
+
+func (m *SudoCallStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoCallStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoCallStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Calls != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractExecutionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecutionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecutionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OrdersProcessed != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.OrdersProcessed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SudoCallStats) > 0 {
+		for iNdEx := len(m.SudoCallStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SudoCallStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContractExecutionStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintContractExecutionStats(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractExecutionStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractExecutionStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SudoCallStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovContractExecutionStats(uint64(l))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.Calls))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.GasUsed))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *ContractExecutionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovContractExecutionStats(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.Height))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.CodeId))
+	}
+	if len(m.SudoCallStats) > 0 {
+		for _, e := range m.SudoCallStats {
+			l = e.Size()
+			n += 1 + l + sovContractExecutionStats(uint64(l))
+		}
+	}
+	if m.OrdersProcessed != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.OrdersProcessed))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovContractExecutionStats(uint64(m.Failures))
+	}
+	return n
+}
+
+func sovContractExecutionStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozContractExecutionStats(x uint64) (n int) {
+	return sovContractExecutionStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SudoCallStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractExecutionStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoCallStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoCallStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractExecutionStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractExecutionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractExecutionStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoCallStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoCallStats = append(m.SudoCallStats, SudoCallStats{})
+			if err := m.SudoCallStats[len(m.SudoCallStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersProcessed", wireType)
+			}
+			m.OrdersProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersProcessed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractExecutionStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractExecutionStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipContractExecutionStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowContractExecutionStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractExecutionStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthContractExecutionStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupContractExecutionStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthContractExecutionStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthContractExecutionStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowContractExecutionStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupContractExecutionStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -20,6 +20,21 @@ func GetOrderTypeFromStr(str string) (OrderType, error) {
 	return OrderType(val), err
 }
 
+func GetMatchingAlgorithmFromStr(str string) (MatchingAlgorithm, error) {
+	val, err := getEnumFromStr(str, MatchingAlgorithm_value)
+	return MatchingAlgorithm(val), err
+}
+
+func GetProRataRemainderRuleFromStr(str string) (ProRataRemainderRule, error) {
+	val, err := getEnumFromStr(str, ProRataRemainderRule_value)
+	return ProRataRemainderRule(val), err
+}
+
+func GetMatchingModeFromStr(str string) (MatchingMode, error) {
+	val, err := getEnumFromStr(str, MatchingMode_value)
+	return MatchingMode(val), err
+}
+
 func getEnumFromStr(str string, enumMap map[string]int32) (int32, error) {
 	upperStr := strings.ToUpper(str)
 	if val, ok := enumMap[upperStr]; ok {
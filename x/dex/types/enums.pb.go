@@ -79,6 +79,9 @@ const (
 	OrderType_FOKMARKETBYVALUE OrderType = 4
 	OrderType_STOPLOSS         OrderType = 5
 	OrderType_STOPLIMIT        OrderType = 6
+	OrderType_IOC              OrderType = 7
+	OrderType_POSTONLY         OrderType = 8
+	OrderType_POSTONLYREPRICE  OrderType = 9
 )
 
 var OrderType_name = map[int32]string{
@@ -88,6 +91,9 @@ var OrderType_name = map[int32]string{
 	4: "FOKMARKETBYVALUE",
 	5: "STOPLOSS",
 	6: "STOPLIMIT",
+	7: "IOC",
+	8: "POSTONLY",
+	9: "POSTONLYREPRICE",
 }
 
 var OrderType_value = map[string]int32{
@@ -97,6 +103,9 @@ var OrderType_value = map[string]int32{
 	"FOKMARKETBYVALUE": 4,
 	"STOPLOSS":         5,
 	"STOPLIMIT":        6,
+	"IOC":              7,
+	"POSTONLY":         8,
+	"POSTONLYREPRICE":  9,
 }
 
 func (x OrderType) String() string {
@@ -141,10 +150,13 @@ func (Unit) EnumDescriptor() ([]byte, []int) {
 type OrderStatus int32
 
 const (
-	OrderStatus_PLACED          OrderStatus = 0
-	OrderStatus_FAILED_TO_PLACE OrderStatus = 1
-	OrderStatus_CANCELLED       OrderStatus = 2
-	OrderStatus_FULFILLED       OrderStatus = 3
+	OrderStatus_PLACED             OrderStatus = 0
+	OrderStatus_FAILED_TO_PLACE    OrderStatus = 1
+	OrderStatus_CANCELLED          OrderStatus = 2
+	OrderStatus_FULFILLED          OrderStatus = 3
+	OrderStatus_IOC_CANCELLED      OrderStatus = 4
+	OrderStatus_POST_ONLY_REJECTED OrderStatus = 5
+	OrderStatus_POST_ONLY_REPRICED OrderStatus = 6
 )
 
 var OrderStatus_name = map[int32]string{
@@ -152,13 +164,19 @@ var OrderStatus_name = map[int32]string{
 	1: "FAILED_TO_PLACE",
 	2: "CANCELLED",
 	3: "FULFILLED",
+	4: "IOC_CANCELLED",
+	5: "POST_ONLY_REJECTED",
+	6: "POST_ONLY_REPRICED",
 }
 
 var OrderStatus_value = map[string]int32{
-	"PLACED":          0,
-	"FAILED_TO_PLACE": 1,
-	"CANCELLED":       2,
-	"FULFILLED":       3,
+	"PLACED":             0,
+	"FAILED_TO_PLACE":    1,
+	"CANCELLED":          2,
+	"FULFILLED":          3,
+	"IOC_CANCELLED":      4,
+	"POST_ONLY_REJECTED": 5,
+	"POST_ONLY_REPRICED": 6,
 }
 
 func (x OrderStatus) String() string {
@@ -172,18 +190,27 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 type CancellationInitiator int32
 
 const (
-	CancellationInitiator_USER       CancellationInitiator = 0
-	CancellationInitiator_LIQUIDATED CancellationInitiator = 1
+	CancellationInitiator_USER                 CancellationInitiator = 0
+	CancellationInitiator_LIQUIDATED           CancellationInitiator = 1
+	CancellationInitiator_EXPIRED              CancellationInitiator = 2
+	CancellationInitiator_SELF_TRADE_PREVENTED CancellationInitiator = 3
+	CancellationInitiator_AMENDED              CancellationInitiator = 4
 )
 
 var CancellationInitiator_name = map[int32]string{
 	0: "USER",
 	1: "LIQUIDATED",
+	2: "EXPIRED",
+	3: "SELF_TRADE_PREVENTED",
+	4: "AMENDED",
 }
 
 var CancellationInitiator_value = map[string]int32{
-	"USER":       0,
-	"LIQUIDATED": 1,
+	"USER":                 0,
+	"LIQUIDATED":           1,
+	"EXPIRED":              2,
+	"SELF_TRADE_PREVENTED": 3,
+	"AMENDED":              4,
 }
 
 func (x CancellationInitiator) String() string {
@@ -194,6 +221,115 @@ func (CancellationInitiator) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b8c5bb23c6eb0b88, []int{5}
 }
 
+type SelfTradePreventionMode int32
+
+const (
+	SelfTradePreventionMode_NO_PREVENTION        SelfTradePreventionMode = 0
+	SelfTradePreventionMode_CANCEL_NEWEST        SelfTradePreventionMode = 1
+	SelfTradePreventionMode_CANCEL_OLDEST        SelfTradePreventionMode = 2
+	SelfTradePreventionMode_CANCEL_BOTH          SelfTradePreventionMode = 3
+	SelfTradePreventionMode_DECREMENT_AND_CANCEL SelfTradePreventionMode = 4
+)
+
+var SelfTradePreventionMode_name = map[int32]string{
+	0: "NO_PREVENTION",
+	1: "CANCEL_NEWEST",
+	2: "CANCEL_OLDEST",
+	3: "CANCEL_BOTH",
+	4: "DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePreventionMode_value = map[string]int32{
+	"NO_PREVENTION":        0,
+	"CANCEL_NEWEST":        1,
+	"CANCEL_OLDEST":        2,
+	"CANCEL_BOTH":          3,
+	"DECREMENT_AND_CANCEL": 4,
+}
+
+func (x SelfTradePreventionMode) String() string {
+	return proto.EnumName(SelfTradePreventionMode_name, int32(x))
+}
+
+func (SelfTradePreventionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{6}
+}
+
+type MatchingAlgorithm int32
+
+const (
+	MatchingAlgorithm_PRICE_TIME MatchingAlgorithm = 0
+	MatchingAlgorithm_PRO_RATA   MatchingAlgorithm = 1
+)
+
+var MatchingAlgorithm_name = map[int32]string{
+	0: "PRICE_TIME",
+	1: "PRO_RATA",
+}
+
+var MatchingAlgorithm_value = map[string]int32{
+	"PRICE_TIME": 0,
+	"PRO_RATA":   1,
+}
+
+func (x MatchingAlgorithm) String() string {
+	return proto.EnumName(MatchingAlgorithm_name, int32(x))
+}
+
+func (MatchingAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{7}
+}
+
+type ProRataRemainderRule int32
+
+const (
+	ProRataRemainderRule_REMAINDER_TIME_PRIORITY      ProRataRemainderRule = 0
+	ProRataRemainderRule_REMAINDER_LARGEST_ALLOCATION ProRataRemainderRule = 1
+)
+
+var ProRataRemainderRule_name = map[int32]string{
+	0: "REMAINDER_TIME_PRIORITY",
+	1: "REMAINDER_LARGEST_ALLOCATION",
+}
+
+var ProRataRemainderRule_value = map[string]int32{
+	"REMAINDER_TIME_PRIORITY":      0,
+	"REMAINDER_LARGEST_ALLOCATION": 1,
+}
+
+func (x ProRataRemainderRule) String() string {
+	return proto.EnumName(ProRataRemainderRule_name, int32(x))
+}
+
+func (ProRataRemainderRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{8}
+}
+
+type MatchingMode int32
+
+const (
+	MatchingMode_CONTINUOUS    MatchingMode = 0
+	MatchingMode_BATCH_AUCTION MatchingMode = 1
+)
+
+var MatchingMode_name = map[int32]string{
+	0: "CONTINUOUS",
+	1: "BATCH_AUCTION",
+}
+
+var MatchingMode_value = map[string]int32{
+	"CONTINUOUS":    0,
+	"BATCH_AUCTION": 1,
+}
+
+func (x MatchingMode) String() string {
+	return proto.EnumName(MatchingMode_name, int32(x))
+}
+
+func (MatchingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{9}
+}
+
 func init() {
 	proto.RegisterEnum("seiprotocol.seichain.dex.PositionDirection", PositionDirection_name, PositionDirection_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.PositionEffect", PositionEffect_name, PositionEffect_value)
@@ -201,35 +337,57 @@ func init() {
 	proto.RegisterEnum("seiprotocol.seichain.dex.Unit", Unit_name, Unit_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.CancellationInitiator", CancellationInitiator_name, CancellationInitiator_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.SelfTradePreventionMode", SelfTradePreventionMode_name, SelfTradePreventionMode_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.MatchingAlgorithm", MatchingAlgorithm_name, MatchingAlgorithm_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.ProRataRemainderRule", ProRataRemainderRule_name, ProRataRemainderRule_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.MatchingMode", MatchingMode_name, MatchingMode_value)
 }
 
 func init() { proto.RegisterFile("dex/enums.proto", fileDescriptor_b8c5bb23c6eb0b88) }
 
 var fileDescriptor_b8c5bb23c6eb0b88 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xdf, 0x6e, 0xd3, 0x30,
-	0x18, 0xc5, 0xe3, 0xb5, 0xab, 0xd6, 0x0f, 0xd8, 0x8c, 0x01, 0x89, 0xab, 0xdc, 0x21, 0xa1, 0x48,
-	0x6b, 0x84, 0xe0, 0x05, 0xbc, 0xc4, 0x1d, 0xd6, 0xdc, 0x38, 0xe4, 0x0f, 0x12, 0xdc, 0x4c, 0x59,
-	0xe2, 0x31, 0x4b, 0x5d, 0x52, 0x25, 0xae, 0xd4, 0xbd, 0x05, 0x8f, 0xc5, 0xe5, 0x2e, 0xb9, 0x44,
-	0xed, 0x8b, 0x20, 0x3b, 0x8c, 0xbb, 0x73, 0x3e, 0x9f, 0xcf, 0xfe, 0xc9, 0x07, 0xce, 0x1a, 0xb5,
-	0x0b, 0x55, 0xbb, 0xbd, 0x1f, 0x16, 0x9b, 0xbe, 0x33, 0x1d, 0x79, 0x3b, 0x28, 0xed, 0x54, 0xdd,
-	0xad, 0x17, 0x83, 0xd2, 0xf5, 0x5d, 0xa5, 0xdb, 0x45, 0xa3, 0x76, 0xc1, 0x7b, 0x78, 0x99, 0x76,
-	0x83, 0x36, 0xba, 0x6b, 0x63, 0xdd, 0xab, 0xda, 0x0a, 0x72, 0x02, 0x53, 0x21, 0x93, 0x4b, 0xec,
-	0x91, 0x39, 0x1c, 0xe7, 0x9f, 0x65, 0x56, 0x60, 0x14, 0xbc, 0x83, 0xd3, 0xa7, 0x24, 0xbb, 0xbd,
-	0x55, 0xb5, 0xb1, 0x31, 0x99, 0xb2, 0x64, 0x8c, 0x45, 0x42, 0xe6, 0x0c, 0xa3, 0xa0, 0x81, 0xb9,
-	0xec, 0x1b, 0xd5, 0x17, 0x0f, 0x1b, 0x65, 0xe7, 0x82, 0xaf, 0x78, 0x81, 0x3d, 0x02, 0x30, 0x5b,
-	0xd1, 0xec, 0x8a, 0x15, 0x18, 0x91, 0x17, 0x30, 0x5f, 0xca, 0xab, 0x7f, 0x76, 0x42, 0x5e, 0x03,
-	0xfe, 0x6f, 0x2f, 0xbe, 0x7d, 0xa5, 0xa2, 0x64, 0x78, 0x4a, 0x9e, 0xc3, 0x49, 0x5e, 0xc8, 0x54,
-	0xc8, 0x3c, 0xc7, 0xc7, 0x76, 0xc5, 0x39, 0x77, 0xdb, 0x2c, 0xf8, 0x04, 0xd3, 0xb2, 0xd5, 0x66,
-	0x0c, 0xd1, 0x24, 0xa6, 0x59, 0x3c, 0x62, 0xac, 0xb8, 0x10, 0x1c, 0xa3, 0x51, 0x46, 0x99, 0xc4,
-	0x47, 0x16, 0x33, 0xa1, 0x89, 0xc4, 0x93, 0x40, 0xc0, 0x33, 0xc7, 0x96, 0x9b, 0xca, 0x6c, 0x07,
-	0x8b, 0x94, 0x0a, 0x1a, 0x31, 0xbb, 0xfa, 0x0a, 0xce, 0x96, 0x94, 0x0b, 0x16, 0x5f, 0x17, 0xf2,
-	0xda, 0x4d, 0x47, 0xce, 0x88, 0x26, 0x11, 0x13, 0x82, 0xc5, 0xf8, 0xc8, 0x61, 0x97, 0x62, 0xc9,
-	0x9d, 0x9d, 0x04, 0x1f, 0xe0, 0x4d, 0x54, 0xb5, 0xb5, 0x5a, 0xaf, 0x2b, 0xfb, 0x29, 0xbc, 0xd5,
-	0x46, 0x57, 0xa6, 0xeb, 0xed, 0x83, 0x65, 0xce, 0x32, 0xec, 0x91, 0x53, 0x00, 0xc1, 0xbf, 0x94,
-	0x3c, 0xa6, 0x05, 0x8b, 0x31, 0xba, 0xb8, 0xfc, 0xb5, 0xf7, 0xd1, 0xe3, 0xde, 0x47, 0x7f, 0xf6,
-	0x3e, 0xfa, 0x79, 0xf0, 0xbd, 0xc7, 0x83, 0xef, 0xfd, 0x3e, 0xf8, 0xde, 0xf7, 0xf3, 0x1f, 0xda,
-	0xdc, 0x6d, 0x6f, 0x16, 0x75, 0x77, 0x1f, 0x0e, 0x4a, 0x9f, 0x3f, 0xb5, 0xe5, 0x8c, 0xab, 0x2b,
-	0xdc, 0x85, 0xb6, 0x56, 0xf3, 0xb0, 0x51, 0xc3, 0xcd, 0xcc, 0x9d, 0x7f, 0xfc, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x40, 0x7d, 0x48, 0x4c, 0xea, 0x01, 0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xc7, 0xa5, 0xd8, 0xf9, 0x3a, 0x6e, 0x62, 0x9a, 0xcd, 0xd6, 0x02, 0x1b, 0x8c, 0xdd, 0x0c,
+	0x18, 0x04, 0xd4, 0x46, 0xb1, 0xbd, 0x00, 0x2d, 0xd1, 0x09, 0x57, 0x8a, 0xd4, 0x28, 0xaa, 0x5b,
+	0x76, 0x23, 0xa8, 0x36, 0x13, 0x0b, 0xb0, 0xa5, 0x40, 0x96, 0x87, 0xf4, 0x62, 0xcf, 0xb0, 0x3e,
+	0xd6, 0x2e, 0x7b, 0xb9, 0xcb, 0x21, 0x79, 0x91, 0x81, 0x54, 0xbc, 0x00, 0xbd, 0xe3, 0xff, 0x7c,
+	0xe9, 0xff, 0xd3, 0xc1, 0x81, 0xe1, 0xd2, 0xdc, 0x4f, 0x4d, 0xb5, 0xdb, 0x6c, 0x27, 0x77, 0x4d,
+	0xdd, 0xd6, 0xf8, 0xf5, 0xd6, 0x94, 0xee, 0xb5, 0xa8, 0xd7, 0x93, 0xad, 0x29, 0x17, 0xab, 0xa2,
+	0xac, 0x26, 0x4b, 0x73, 0x1f, 0xfc, 0x00, 0xa3, 0xa4, 0xde, 0x96, 0x6d, 0x59, 0x57, 0x51, 0xd9,
+	0x98, 0x85, 0x7d, 0xe0, 0x13, 0xe8, 0x73, 0x29, 0x2e, 0x91, 0x87, 0x4f, 0xe1, 0x30, 0xbd, 0x92,
+	0x4a, 0x23, 0x3f, 0xf8, 0x1e, 0xce, 0xf7, 0x95, 0xf4, 0xe6, 0xc6, 0x2c, 0x5a, 0x5b, 0x26, 0x13,
+	0x2a, 0xba, 0xb2, 0x90, 0xcb, 0x94, 0x22, 0x3f, 0xf8, 0xe4, 0xc3, 0xa9, 0x6c, 0x96, 0xa6, 0xd1,
+	0x1f, 0xef, 0x8c, 0x4d, 0x70, 0x16, 0x33, 0x8d, 0x3c, 0x0c, 0x70, 0x14, 0x13, 0xf5, 0x8e, 0x6a,
+	0xe4, 0xe3, 0x33, 0x38, 0x9d, 0xcb, 0x77, 0x4f, 0xb2, 0x87, 0x2f, 0x00, 0xfd, 0x2f, 0x67, 0xd7,
+	0xef, 0x09, 0xcf, 0x28, 0xea, 0xe3, 0x17, 0x70, 0x92, 0x6a, 0x99, 0x70, 0x99, 0xa6, 0xe8, 0xd0,
+	0xb6, 0x38, 0xe5, 0xa6, 0x1d, 0xe1, 0x63, 0xe8, 0x31, 0x19, 0xa2, 0x63, 0x5b, 0x95, 0xc8, 0x54,
+	0x4b, 0xc1, 0xaf, 0xd1, 0x09, 0x7e, 0x09, 0xc3, 0xbd, 0x52, 0x34, 0x51, 0x2c, 0xa4, 0xe8, 0x34,
+	0xf8, 0x09, 0xfa, 0x59, 0x55, 0xb6, 0xdd, 0x40, 0x22, 0x22, 0xa2, 0xa2, 0xce, 0x73, 0xcc, 0x38,
+	0x67, 0xc8, 0xef, 0x9e, 0xa1, 0x92, 0xe8, 0xc0, 0x32, 0x09, 0x22, 0x24, 0xea, 0x05, 0x7f, 0xf9,
+	0x30, 0x70, 0x20, 0x69, 0x5b, 0xb4, 0xbb, 0xad, 0xf5, 0x9f, 0x70, 0x12, 0x52, 0xdb, 0xfb, 0x12,
+	0x86, 0x73, 0xc2, 0x38, 0x8d, 0x72, 0x2d, 0x73, 0x17, 0xed, 0xa0, 0x42, 0x22, 0x42, 0xca, 0x39,
+	0x8d, 0xd0, 0x81, 0x63, 0xcc, 0xf8, 0x9c, 0x39, 0xd9, 0xc3, 0x23, 0x38, 0x63, 0x32, 0xcc, 0x9f,
+	0x2b, 0xfa, 0xf8, 0x6b, 0xc0, 0xd6, 0x6c, 0x6e, 0xdd, 0xe6, 0x8a, 0xfe, 0x4c, 0x43, 0x4d, 0x23,
+	0x74, 0xf8, 0x65, 0xdc, 0x61, 0x44, 0xe8, 0x28, 0x30, 0xf0, 0x55, 0x58, 0x54, 0x0b, 0xb3, 0x5e,
+	0x17, 0x76, 0x0b, 0xac, 0x2a, 0xdb, 0xb2, 0x68, 0xeb, 0xc6, 0x9a, 0xce, 0x52, 0xaa, 0x90, 0x87,
+	0xcf, 0x01, 0x38, 0xfb, 0x25, 0x63, 0x11, 0xb1, 0xa3, 0x7c, 0x3c, 0x80, 0x63, 0xfa, 0x5b, 0xc2,
+	0x94, 0x73, 0xf4, 0x1a, 0x2e, 0x52, 0xca, 0xe7, 0xb9, 0x56, 0x24, 0xa2, 0x79, 0xa2, 0xe8, 0x7b,
+	0x2a, 0xb4, 0x33, 0x37, 0x80, 0x63, 0x12, 0x53, 0x11, 0x59, 0x5b, 0xc1, 0x9f, 0xf0, 0x2a, 0x35,
+	0xeb, 0x1b, 0xdd, 0x14, 0x4b, 0x93, 0x34, 0xe6, 0x0f, 0x53, 0xd9, 0xaf, 0xc5, 0xf5, 0xd2, 0x58,
+	0x08, 0x21, 0xf7, 0x9d, 0x4c, 0xda, 0xd5, 0x8f, 0xe0, 0xac, 0x63, 0xca, 0x05, 0xfd, 0x95, 0xa6,
+	0x76, 0xbb, 0xcf, 0x21, 0xc9, 0x23, 0x1b, 0x3a, 0xc0, 0x43, 0x18, 0x3c, 0x85, 0x66, 0x52, 0x5f,
+	0xa1, 0x9e, 0xf5, 0x12, 0xd1, 0x50, 0xd1, 0x98, 0x0a, 0x9d, 0x13, 0x11, 0x3d, 0xfd, 0x18, 0xd4,
+	0x0f, 0xde, 0xc2, 0x28, 0x2e, 0xda, 0xc5, 0xaa, 0xac, 0x6e, 0xc9, 0xfa, 0xb6, 0x6e, 0xca, 0x76,
+	0xb5, 0xb1, 0x5c, 0xee, 0x37, 0xe4, 0x9a, 0xc5, 0x14, 0x79, 0x6e, 0xeb, 0x4a, 0xe6, 0x8a, 0x68,
+	0x82, 0xfc, 0x20, 0x83, 0x8b, 0xa4, 0xa9, 0x55, 0xd1, 0x16, 0xca, 0x6c, 0x8a, 0xb2, 0x5a, 0x9a,
+	0x46, 0xed, 0xd6, 0x06, 0x7f, 0x03, 0xaf, 0x14, 0x8d, 0x09, 0x13, 0x11, 0x55, 0xae, 0x33, 0x4f,
+	0x14, 0x93, 0x8a, 0xe9, 0x6b, 0xe4, 0xe1, 0xef, 0xe0, 0xdb, 0xe7, 0x24, 0x27, 0xea, 0x92, 0xa6,
+	0x3a, 0x27, 0x9c, 0xcb, 0x90, 0x38, 0x34, 0x3f, 0x78, 0x0b, 0x2f, 0xf6, 0x4e, 0x1c, 0xfd, 0x39,
+	0x40, 0x28, 0x85, 0x66, 0x22, 0x93, 0x59, 0xda, 0xa1, 0xcf, 0x88, 0x0e, 0xaf, 0x72, 0x92, 0x85,
+	0x5d, 0xcb, 0xec, 0xf2, 0xef, 0x87, 0xb1, 0xff, 0xf9, 0x61, 0xec, 0xff, 0xfb, 0x30, 0xf6, 0x3f,
+	0x3d, 0x8e, 0xbd, 0xcf, 0x8f, 0x63, 0xef, 0x9f, 0xc7, 0xb1, 0xf7, 0xfb, 0x9b, 0xdb, 0xb2, 0x5d,
+	0xed, 0x3e, 0x4c, 0x16, 0xf5, 0x66, 0xba, 0x35, 0xe5, 0x9b, 0xfd, 0x39, 0x3a, 0xe1, 0xee, 0x71,
+	0x7a, 0x3f, 0xb5, 0x77, 0xdb, 0x7e, 0xbc, 0x33, 0xdb, 0x0f, 0x47, 0x2e, 0xff, 0xe3, 0x7f, 0x03,
+	0x00, 0x4e, 0xc3, 0xd3, 0x8c, 0xcb, 0x03, 0x00, 0x00,
 }
@@ -22,6 +22,12 @@ var (
 	ErrContractNotExists          = sdkerrors.Register(ModuleName, 17, "Error finding contract info")
 	ErrParsingContractInfo        = sdkerrors.Register(ModuleName, 18, "Error parsing contract info")
 	ErrInsufficientRent           = sdkerrors.Register(ModuleName, 19, "Error contract does not have sufficient fee")
+	ErrEncodeDexAmendOrders       = sdkerrors.Register(ModuleName, 20, "Error while encoding dex order amendment msg in wasmd")
+	ErrEncodingOrderBookDepth     = sdkerrors.Register(ModuleName, 21, "Error encoding order book depth as JSON")
+	ErrPairHalted                 = sdkerrors.Register(ModuleName, 22, "pair is halted")
+	ErrPairNotHalted              = sdkerrors.Register(ModuleName, 23, "pair is not halted")
+	ErrRentTopUpNotFound          = sdkerrors.Register(ModuleName, 24, "rent top-up not found")
+	ErrInvalidRoute               = sdkerrors.Register(ModuleName, 25, "invalid order route")
 	ErrCircularContractDependency = sdkerrors.Register(ModuleName, 1103, "circular contract dependency detected")
 	ErrContractSuspended          = sdkerrors.Register(ModuleName, 1104, "contract suspended")
 	ErrContractNotSuspended       = sdkerrors.Register(ModuleName, 1105, "contract not suspended")
@@ -9,6 +9,18 @@ const (
 	EventTypeRegisterPair        = "register_pair"
 	EventTypeSetQuantityTickSize = "set_quantity_tick_size"
 	EventTypeSetPriceTickSize    = "set_price_tick_size"
+	EventTypeTriggerOrder        = "trigger_order"
+	EventTypeSelfTradePrevention = "self_trade_prevention"
+	EventTypeAmendOrder          = "amend_order"
+	EventTypeRestoreOrder        = "restore_order"
+
+	EventTypeSetMatchingAlgorithm = "set_matching_algorithm"
+	EventTypeSetMatchingMode      = "set_matching_mode"
+	EventTypeHaltPair             = "halt_pair"
+	EventTypeResumePair           = "resume_pair"
+	EventTypeBatchAuction         = "batch_auction"
+	EventTypePlaceRoutedOrder     = "place_routed_order"
+	EventTypeRevertRoutedOrder    = "revert_routed_order"
 
 	AttributeKeyOrderID         = "order_id"
 	AttributeKeyCancellationID  = "cancellation_id"
@@ -16,6 +28,20 @@ const (
 	AttributeKeyRentBalance     = "rent_balance"
 	AttributeKeyPriceDenom      = "price_denom"
 	AttributeKeyAssetDenom      = "asset_denom"
+	AttributeKeyTriggerPrice    = "trigger_price"
+	AttributeKeyAccount         = "account"
+	AttributeKeyTakerOrderID    = "taker_order_id"
+	AttributeKeyMakerOrderID    = "maker_order_id"
+	AttributeKeyQuantity        = "quantity"
+	AttributeKeyNewOrderID      = "new_order_id"
+
+	AttributeKeySelfTradePreventionMode = "self_trade_prevention_mode"
+	AttributeKeyMatchingAlgorithm       = "matching_algorithm"
+	AttributeKeyMatchingMode            = "matching_mode"
+	AttributeKeyClearingPrice           = "clearing_price"
+	AttributeKeyRoutedOrderID           = "routed_order_id"
+	AttributeKeyReason                  = "reason"
+	AttributeKeyRevertFee               = "revert_fee"
 
 	AttributeValueCategory = ModuleName
 )
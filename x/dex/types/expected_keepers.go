@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -16,3 +17,9 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// OracleKeeper defines the expected interface needed to retrieve oracle exchange rates
+type OracleKeeper interface {
+	GetBaseExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, sdk.Int, int64, error)
+	GetVoteTarget(ctx sdk.Context, denom string) (oracletypes.Denom, error)
+}
@@ -71,5 +71,13 @@ func (cs ContractState) Validate() error {
 		}
 		shortBookPriceMap[priceElem] = struct{}{}
 	}
+	if cs.RentTopUp != nil {
+		if cs.RentTopUp.ContractAddr != cs.ContractInfo.ContractAddr {
+			return fmt.Errorf("rent top-up does not belong to contract")
+		}
+		if _, err := sdk.AccAddressFromBech32(cs.RentTopUp.Source); err != nil {
+			return fmt.Errorf("rent top-up source address is invalid")
+		}
+	}
 	return nil
 }
@@ -92,6 +92,7 @@ type ContractState struct {
 	PairList            []Pair               `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
 	PriceList           []ContractPairPrices `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
 	NextOrderId         uint64               `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	RentTopUp           *RentTopUp           `protobuf:"bytes,8,opt,name=rentTopUp,proto3" json:"rentTopUp,omitempty"`
 }
 
 func (m *ContractState) Reset()         { *m = ContractState{} }
@@ -176,6 +177,13 @@ func (m *ContractState) GetNextOrderId() uint64 {
 	return 0
 }
 
+func (m *ContractState) GetRentTopUp() *RentTopUp {
+	if m != nil {
+		return m.RentTopUp
+	}
+	return nil
+}
+
 type ContractPairPrices struct {
 	PricePair Pair     `protobuf:"bytes,1,opt,name=pricePair,proto3" json:"pricePair"`
 	Prices    []*Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
@@ -237,39 +245,40 @@ func init() {
 func init() { proto.RegisterFile("dex/genesis.proto", fileDescriptor_a803aaabd08db59d) }
 
 var fileDescriptor_a803aaabd08db59d = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
+	// 520 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0x5a, 0x0a, 0x73, 0x5b, 0xfe, 0x78, 0x3b, 0x44, 0x15, 0xca, 0xa2, 0x72, 0xa0,
-	0x07, 0x96, 0x48, 0xe5, 0xc0, 0x0d, 0xa1, 0x22, 0x34, 0x4d, 0xaa, 0xb4, 0xaa, 0x95, 0x40, 0xe2,
-	0x82, 0xd2, 0xc4, 0xa4, 0xd6, 0xba, 0xbc, 0x91, 0x6d, 0xa4, 0xf2, 0x2d, 0xd8, 0x47, 0xe2, 0xb6,
-	0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xf2, 0x1b, 0x7b, 0x4d, 0x04, 0x59, 0xb9, 0xc5, 0x8f,
-	0x9f, 0xf7, 0xe7, 0xf7, 0xb5, 0x9f, 0x90, 0xa7, 0x09, 0x5b, 0x87, 0x29, 0xcb, 0x98, 0xe4, 0x32,
-	0xc8, 0x05, 0x28, 0xa0, 0xae, 0x64, 0x1c, 0xbf, 0x62, 0x58, 0x05, 0x92, 0xf1, 0x78, 0x19, 0xf1,
-	0x2c, 0x48, 0xd8, 0xba, 0x7f, 0x94, 0x42, 0x0a, 0xb8, 0x15, 0xea, 0xaf, 0xc2, 0xdf, 0x7f, 0xa2,
-	0x11, 0x79, 0x24, 0xa2, 0x4b, 0x43, 0xe8, 0x1f, 0x6a, 0x65, 0x05, 0x59, 0xfa, 0x79, 0x01, 0x70,
-	0x61, 0xc4, 0x23, 0x2d, 0xca, 0x25, 0x08, 0x55, 0x56, 0x1f, 0x6b, 0x15, 0x44, 0xc2, 0x84, 0x11,
-	0xa8, 0x16, 0x62, 0xc8, 0x94, 0x88, 0x62, 0x65, 0xb4, 0x47, 0xc5, 0x09, 0x5c, 0x94, 0x8b, 0x72,
-	0xc1, 0x63, 0x56, 0x08, 0x83, 0x1f, 0x0e, 0xe9, 0x9e, 0x16, 0x43, 0xcc, 0x55, 0xa4, 0x18, 0x7d,
-	0x43, 0xda, 0x45, 0x47, 0xae, 0xe3, 0x3b, 0xc3, 0xce, 0xc8, 0x0f, 0xea, 0x86, 0x0a, 0xa6, 0xe8,
-	0x1b, 0xb7, 0xae, 0x7f, 0x1d, 0x37, 0x66, 0xa6, 0x8a, 0xce, 0x49, 0xcf, 0xf6, 0x80, 0x40, 0xf7,
-	0x9e, 0xdf, 0x1c, 0x76, 0x46, 0x2f, 0xea, 0x31, 0xef, 0xca, 0x76, 0x43, 0xab, 0x32, 0xe8, 0x33,
-	0x72, 0xb0, 0x8a, 0xa4, 0x7a, 0x9f, 0x43, 0xbc, 0x74, 0x9b, 0xbe, 0x33, 0x6c, 0xcd, 0x76, 0xc2,
-	0xe0, 0xaa, 0x45, 0x7a, 0x15, 0x08, 0x9d, 0x91, 0xae, 0x05, 0x9c, 0x65, 0x5f, 0xc0, 0x8c, 0x32,
-	0xdc, 0xdf, 0x83, 0x76, 0x7f, 0x18, 0x99, 0x26, 0x2a, 0x0c, 0x3a, 0x21, 0x5d, 0xfd, 0x30, 0x63,
-	0x80, 0x8b, 0x09, 0x97, 0xca, 0xcc, 0x35, 0xa8, 0x67, 0x4e, 0x8c, 0xdb, 0xd2, 0xca, 0xd5, 0xf4,
-	0x9c, 0xf4, 0xf0, 0x45, 0x6f, 0x71, 0x4d, 0xc4, 0x3d, 0xaf, 0xc7, 0xcd, 0xad, 0xdd, 0x5e, 0x51,
-	0xa5, 0x9e, 0x7e, 0x24, 0x87, 0x4a, 0xf0, 0x34, 0x65, 0x82, 0x25, 0xe7, 0x3a, 0x15, 0x12, 0xb1,
-	0x2d, 0xc4, 0x1e, 0xd7, 0x63, 0xd1, 0x6b, 0x90, 0xff, 0x22, 0xd0, 0xb7, 0xe4, 0xa1, 0x0e, 0x10,
-	0xd2, 0xee, 0x23, 0xcd, 0xbb, 0x2b, 0x12, 0xdc, 0xc2, 0x6e, 0xab, 0xe8, 0x94, 0x1c, 0x60, 0xe4,
-	0x10, 0xd1, 0x46, 0xc4, 0xcb, 0xfd, 0x4f, 0xa1, 0x51, 0x53, 0x5d, 0x66, 0x13, 0xb6, 0x83, 0x50,
-	0x9f, 0x74, 0x32, 0xb6, 0x56, 0xd8, 0xe5, 0x59, 0xe2, 0x3e, 0xc0, 0x44, 0x94, 0xa5, 0xc1, 0x95,
-	0x43, 0xe8, 0xdf, 0x24, 0x3a, 0x36, 0xad, 0x68, 0xc9, 0xa4, 0xe2, 0xff, 0xa6, 0xd9, 0x95, 0xd1,
-	0xd7, 0xa4, 0x8d, 0x0b, 0x69, 0x22, 0x70, 0xc7, 0xe5, 0xe2, 0xa9, 0x33, 0x63, 0x1f, 0x9f, 0x5e,
-	0x6f, 0x3c, 0xe7, 0x66, 0xe3, 0x39, 0xbf, 0x37, 0x9e, 0xf3, 0x7d, 0xeb, 0x35, 0x6e, 0xb6, 0x5e,
-	0xe3, 0xe7, 0xd6, 0x6b, 0x7c, 0x3a, 0x49, 0xb9, 0x5a, 0x7e, 0x5d, 0x04, 0x31, 0x5c, 0x86, 0x92,
-	0xf1, 0x13, 0x4b, 0xc3, 0x05, 0xe2, 0xc2, 0x75, 0xa8, 0x7f, 0x5d, 0xf5, 0x2d, 0x67, 0x72, 0xd1,
-	0xc6, 0xfd, 0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x4d, 0x7c, 0x7f, 0x83, 0x04, 0x00,
-	0x00,
+	0x18, 0xc6, 0xeb, 0xb5, 0x94, 0xd5, 0x6d, 0xf9, 0xe3, 0xed, 0x10, 0x55, 0x28, 0x8b, 0xca, 0x81,
+	0x1e, 0x58, 0x22, 0x95, 0x03, 0x37, 0x04, 0x45, 0x68, 0x9a, 0x54, 0x69, 0x55, 0xca, 0x1f, 0x89,
+	0x0b, 0x4a, 0x13, 0x93, 0x5a, 0xeb, 0xe2, 0xc8, 0x36, 0x52, 0xf9, 0x16, 0xf0, 0x91, 0xb8, 0xed,
+	0xb8, 0x03, 0x07, 0x4e, 0x08, 0xb5, 0x5f, 0x04, 0xf9, 0xad, 0xbd, 0x26, 0x82, 0xac, 0xbb, 0x25,
+	0x4f, 0x9e, 0xe7, 0xe7, 0xf7, 0xf5, 0xfb, 0x06, 0x3f, 0x4c, 0xe8, 0x32, 0x48, 0x69, 0x46, 0x25,
+	0x93, 0x7e, 0x2e, 0xb8, 0xe2, 0xc4, 0x91, 0x94, 0xc1, 0x53, 0xcc, 0x17, 0xbe, 0xa4, 0x2c, 0x9e,
+	0x47, 0x2c, 0xf3, 0x13, 0xba, 0xec, 0x1d, 0xa6, 0x3c, 0xe5, 0xf0, 0x29, 0xd0, 0x4f, 0x1b, 0x7f,
+	0xef, 0x81, 0x46, 0xe4, 0x91, 0x88, 0x2e, 0x0c, 0xa1, 0x77, 0xa0, 0x95, 0x05, 0xcf, 0xd2, 0x4f,
+	0x33, 0xce, 0xcf, 0x8d, 0x78, 0xa8, 0x45, 0x39, 0xe7, 0x42, 0x15, 0xd5, 0xfb, 0x5a, 0xe5, 0x22,
+	0xa1, 0xc2, 0x08, 0x44, 0x0b, 0x31, 0xcf, 0x94, 0x88, 0x62, 0x65, 0xb4, 0x7b, 0x9b, 0x13, 0x98,
+	0x28, 0x86, 0x72, 0xc1, 0x62, 0x5a, 0x34, 0x08, 0x9a, 0x99, 0x40, 0xff, 0x07, 0xc2, 0x9d, 0x93,
+	0x4d, 0x53, 0x53, 0x15, 0x29, 0x4a, 0x5e, 0xe0, 0xe6, 0xa6, 0x42, 0x07, 0x79, 0x68, 0xd0, 0x1e,
+	0x7a, 0x7e, 0x55, 0x93, 0xfe, 0x04, 0x7c, 0xa3, 0xc6, 0xe5, 0xef, 0xa3, 0x5a, 0x68, 0x52, 0x64,
+	0x8a, 0xbb, 0xb6, 0x26, 0x00, 0x3a, 0x7b, 0x5e, 0x7d, 0xd0, 0x1e, 0x3e, 0xa9, 0xc6, 0xbc, 0x2e,
+	0xda, 0x0d, 0xad, 0xcc, 0x20, 0x8f, 0x70, 0x6b, 0x11, 0x49, 0xf5, 0x26, 0xe7, 0xf1, 0xdc, 0xa9,
+	0x7b, 0x68, 0xd0, 0x08, 0xb7, 0x42, 0xff, 0x67, 0x03, 0x77, 0x4b, 0x10, 0x12, 0xe2, 0x8e, 0x05,
+	0x9c, 0x66, 0x9f, 0xb9, 0x69, 0x65, 0xb0, 0xbb, 0x06, 0xed, 0x7e, 0x3f, 0x34, 0x45, 0x94, 0x18,
+	0x64, 0x8c, 0x3b, 0x7a, 0x50, 0x23, 0xce, 0xcf, 0xc7, 0x4c, 0x2a, 0xd3, 0x57, 0xbf, 0x9a, 0x39,
+	0x36, 0x6e, 0x4b, 0x2b, 0xa6, 0xc9, 0x19, 0xee, 0xc2, 0x84, 0xaf, 0x71, 0x75, 0xc0, 0x3d, 0xae,
+	0xc6, 0x4d, 0xad, 0xdd, 0x5e, 0x51, 0x29, 0x4f, 0x3e, 0xe0, 0x03, 0x25, 0x58, 0x9a, 0x52, 0x41,
+	0x93, 0x33, 0xbd, 0x25, 0x12, 0xb0, 0x0d, 0xc0, 0x1e, 0x55, 0x63, 0xc1, 0x6b, 0x90, 0xff, 0x23,
+	0x90, 0x97, 0x78, 0x5f, 0x2f, 0x14, 0xd0, 0xee, 0x00, 0xcd, 0xbd, 0x69, 0x25, 0x98, 0x85, 0x5d,
+	0xa7, 0xc8, 0x04, 0xb7, 0x60, 0x05, 0x01, 0xd1, 0x04, 0xc4, 0xd3, 0xdd, 0xa3, 0xd0, 0xa8, 0x89,
+	0x8e, 0xd9, 0x0d, 0xdb, 0x42, 0x88, 0x87, 0xdb, 0x19, 0x5d, 0x2a, 0xa8, 0xf2, 0x34, 0x71, 0xee,
+	0xc2, 0x46, 0x14, 0x25, 0xf2, 0x0a, 0xb7, 0xf4, 0x96, 0xbf, 0xe5, 0xf9, 0xbb, 0xdc, 0xd9, 0xf7,
+	0xd0, 0xcd, 0x77, 0x1b, 0x5a, 0x6b, 0xb8, 0x4d, 0xf5, 0xbf, 0x23, 0x4c, 0xfe, 0x2d, 0x86, 0x8c,
+	0x4c, 0x37, 0x5a, 0x32, 0x8b, 0x75, 0xbb, 0x0b, 0xd9, 0xc6, 0xc8, 0x73, 0xdc, 0x84, 0x17, 0xe9,
+	0xec, 0xed, 0x9a, 0x0f, 0x9c, 0x1a, 0x1a, 0xfb, 0xe8, 0xe4, 0x72, 0xe5, 0xa2, 0xab, 0x95, 0x8b,
+	0xfe, 0xac, 0x5c, 0xf4, 0x6d, 0xed, 0xd6, 0xae, 0xd6, 0x6e, 0xed, 0xd7, 0xda, 0xad, 0x7d, 0x3c,
+	0x4e, 0x99, 0x9a, 0x7f, 0x99, 0xf9, 0x31, 0xbf, 0x08, 0x24, 0x65, 0xc7, 0x96, 0x06, 0x2f, 0x80,
+	0x0b, 0x96, 0x81, 0xfe, 0xf9, 0xd5, 0xd7, 0x9c, 0xca, 0x59, 0x13, 0xbe, 0x3f, 0xfb, 0x3b, 0x00,
+	0xca, 0x9c, 0x8c, 0xbf, 0xd6, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -344,6 +353,18 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RentTopUp != nil {
+		{
+			size, err := m.RentTopUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.NextOrderId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
 		i--
@@ -551,6 +572,10 @@ func (m *ContractState) Size() (n int) {
 	if m.NextOrderId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextOrderId))
 	}
+	if m.RentTopUp != nil {
+		l = m.RentTopUp.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -964,6 +989,42 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentTopUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RentTopUp == nil {
+				m.RentTopUp = &RentTopUp{}
+			}
+			if err := m.RentTopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
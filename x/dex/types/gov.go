@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,14 +10,23 @@ import (
 )
 
 const (
-	ProposalTypeAddAssetMetadata = "AddAssetMetadata"
+	ProposalTypeAddAssetMetadata            = "AddAssetMetadata"
+	ProposalTypeUpdatePairMatchingAlgorithm = "UpdatePairMatchingAlgorithm"
+	ProposalTypeHaltPair                    = "HaltPair"
+	ProposalTypeUpdatePairMatchingMode      = "UpdatePairMatchingMode"
 )
 
 func init() {
 	// for routing
 	govtypes.RegisterProposalType(ProposalTypeAddAssetMetadata)
+	govtypes.RegisterProposalType(ProposalTypeUpdatePairMatchingAlgorithm)
+	govtypes.RegisterProposalType(ProposalTypeHaltPair)
+	govtypes.RegisterProposalType(ProposalTypeUpdatePairMatchingMode)
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdatePairMatchingAlgorithmProposal{}, "dex/UpdatePairMatchingAlgorithmProposal")
+	govtypes.RegisterProposalTypeCodec(&HaltPairProposal{}, "dex/HaltPairProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdatePairMatchingModeProposal{}, "dex/UpdatePairMatchingModeProposal")
 }
 
 func (p *AddAssetMetadataProposal) GetTitle() string { return p.Title }
@@ -55,3 +65,119 @@ func (p AddAssetMetadataProposal) String() string {
 `, p.Title, p.Description, assetRecords))
 	return b.String()
 }
+
+func (p *UpdatePairMatchingAlgorithmProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePairMatchingAlgorithmProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePairMatchingAlgorithmProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePairMatchingAlgorithmProposal) ProposalType() string {
+	return ProposalTypeUpdatePairMatchingAlgorithm
+}
+
+func (p *UpdatePairMatchingAlgorithmProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddr); err != nil {
+		return errors.New("contract address format is not bech32")
+	}
+	if p.Pair.PriceDenom == "" || p.Pair.AssetDenom == "" {
+		return errors.New("pair denoms cannot be empty")
+	}
+	if err := p.Pair.ValidateMatchingAlgorithm(); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p UpdatePairMatchingAlgorithmProposal) String() string {
+	minAllocation := "none"
+	if p.Pair.ProRataMinAllocation != nil {
+		minAllocation = p.Pair.ProRataMinAllocation.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pair Matching Algorithm Proposal:
+  Title:                   %s
+  Description:             %s
+  Contract:                %s
+  Pair:                    %s/%s
+  Matching Algorithm:      %s
+  Pro-rata Min Allocation: %s
+  Pro-rata Remainder Rule: %s
+`, p.Title, p.Description, p.ContractAddr, p.Pair.PriceDenom, p.Pair.AssetDenom,
+		p.Pair.MatchingAlgorithm, minAllocation, p.Pair.ProRataRemainderRule))
+	return b.String()
+}
+
+func (p *HaltPairProposal) GetTitle() string { return p.Title }
+
+func (p *HaltPairProposal) GetDescription() string { return p.Description }
+
+func (p *HaltPairProposal) ProposalRoute() string { return RouterKey }
+
+func (p *HaltPairProposal) ProposalType() string {
+	return ProposalTypeHaltPair
+}
+
+func (p *HaltPairProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddr); err != nil {
+		return errors.New("contract address format is not bech32")
+	}
+	if p.PriceDenom == "" || p.AssetDenom == "" {
+		return errors.New("pair denoms cannot be empty")
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p HaltPairProposal) String() string {
+	action := "halt"
+	if p.Resume {
+		action = "resume"
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Halt Pair Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Pair:        %s/%s
+  Action:      %s
+`, p.Title, p.Description, p.ContractAddr, p.PriceDenom, p.AssetDenom, action))
+	return b.String()
+}
+
+func (p *UpdatePairMatchingModeProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePairMatchingModeProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePairMatchingModeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePairMatchingModeProposal) ProposalType() string {
+	return ProposalTypeUpdatePairMatchingMode
+}
+
+func (p *UpdatePairMatchingModeProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddr); err != nil {
+		return errors.New("contract address format is not bech32")
+	}
+	if p.PriceDenom == "" || p.AssetDenom == "" {
+		return errors.New("pair denoms cannot be empty")
+	}
+	if _, ok := MatchingMode_name[int32(p.MatchingMode)]; !ok {
+		return fmt.Errorf("unknown matching mode %d", p.MatchingMode)
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p UpdatePairMatchingModeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pair Matching Mode Proposal:
+  Title:         %s
+  Description:   %s
+  Contract:      %s
+  Pair:          %s/%s
+  Matching Mode: %s
+`, p.Title, p.Description, p.ContractAddr, p.PriceDenom, p.AssetDenom, p.MatchingMode))
+	return b.String()
+}
@@ -77,6 +77,14 @@ func PriceContractPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(PriceKey), AddressKeyPrefix(contractAddr)...)
 }
 
+func RentTopUpPrefix(contractAddr string) []byte {
+	return ContractKeyPrefix(RentTopUpKey, contractAddr)
+}
+
+func ContractExecutionStatsPrefix(contractAddr string) []byte {
+	return ContractKeyPrefix(ContractExecutionStatsKey, contractAddr)
+}
+
 func RegisteredPairPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(RegisteredPairKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -124,6 +132,13 @@ func MemCancelPrefixForPair(contractAddr string, priceDenom string, assetDenom s
 	)
 }
 
+func MemAmendPrefixForPair(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		append(KeyPrefix(MemAmendKey), AddressKeyPrefix(contractAddr)...),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
 func MemOrderPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(MemOrderKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -132,6 +147,14 @@ func MemCancelPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(MemCancelKey), AddressKeyPrefix(contractAddr)...)
 }
 
+func MemAmendPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(MemAmendKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func MemBlockUpdatePrefix(contractAddr string) []byte {
+	return append(KeyPrefix(MemBlockUpdateKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func MemDepositPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(MemDepositKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -154,6 +177,80 @@ func OrderCountPrefix(contractAddr string, priceDenom string, assetDenom string,
 	return append(prefix, append(AddressKeyPrefix(contractAddr), PairPrefix(priceDenom, assetDenom)...)...)
 }
 
+// `TriggerBook` constant + contract + price denom + asset denom
+func TriggerBookPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ContractKeyPrefix(TriggerBookKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+// `TriggerBookPrice` constant + contract + price denom + asset denom
+func TriggerBookPricePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ContractKeyPrefix(TriggerBookPriceKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+// `TriggeredOrder` constant + contract + price denom + asset denom
+func TriggeredOrderPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ContractKeyPrefix(TriggeredOrderKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+// `ExpiryQueue` constant + contract + price denom + asset denom
+func ExpiryQueuePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ContractKeyPrefix(ExpiryQueueKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+// `AccountOrder` constant + contract + account
+func AccountOrderPrefix(contractAddr string, account string) []byte {
+	return append(
+		ContractKeyPrefix(AccountOrderKey, contractAddr),
+		address.MustLengthPrefix([]byte(account))...,
+	)
+}
+
+// `AccountOrder` constant + contract + account + price denom + asset denom
+func AccountOrderPairPrefix(contractAddr string, account string, priceDenom string, assetDenom string) []byte {
+	return append(
+		AccountOrderPrefix(contractAddr, account),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+// `Trade` constant + contract + price denom + asset denom
+func TradePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ContractKeyPrefix(TradeKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+// `Candle` constant + contract + price denom + asset denom + interval
+func CandlePrefix(contractAddr string, priceDenom string, assetDenom string, intervalInSeconds uint64) []byte {
+	interval := make([]byte, 8)
+	binary.BigEndian.PutUint64(interval, intervalInSeconds)
+	return append(append(
+		ContractKeyPrefix(CandleKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	), interval...)
+}
+
+// `AccountTrade` constant + contract + account
+func AccountTradePrefix(contractAddr string, account string) []byte {
+	return append(
+		ContractKeyPrefix(AccountTradeKey, contractAddr),
+		address.MustLengthPrefix([]byte(account))...,
+	)
+}
+
 const (
 	LongBookKey = "LongBook-value-"
 
@@ -163,18 +260,33 @@ const (
 	AccountActiveOrdersKey = "account-active-orders"
 	CancelKey              = "cancel"
 
-	TwapKey             = "TWAP-"
-	PriceKey            = "Price-"
-	SettlementEntryKey  = "SettlementEntry-"
-	NextSettlementIDKey = "NextSettlementID-"
-	NextOrderIDKey      = "noid"
-	RegisteredPairKey   = "rp"
-	AssetListKey        = "AssetList-"
-	MatchResultKey      = "MatchResult-"
-	LongOrderCountKey   = "loc-"
-	ShortOrderCountKey  = "soc-"
+	TwapKey                   = "TWAP-"
+	PriceKey                  = "Price-"
+	SettlementEntryKey        = "SettlementEntry-"
+	NextSettlementIDKey       = "NextSettlementID-"
+	NextOrderIDKey            = "noid"
+	RegisteredPairKey         = "rp"
+	AssetListKey              = "AssetList-"
+	MatchResultKey            = "MatchResult-"
+	LongOrderCountKey         = "loc-"
+	ShortOrderCountKey        = "soc-"
+	TriggerBookKey            = "TriggerBook-"
+	TriggerBookPriceKey       = "TriggerBookPrice-"
+	TriggeredOrderKey         = "TriggeredOrder-"
+	ExpiryQueueKey            = "ExpiryQueue-"
+	AccountOrderKey           = "AccountOrder-"
+	TradeKey                  = "Trade-"
+	AccountTradeKey           = "AccountTrade-"
+	CandleKey                 = "Candle-"
+	RentTopUpKey              = "RentTopUp-"
+	ContractExecutionStatsKey = "ContractExecutionStats-"
 
 	MemOrderKey   = "MemOrder-"
 	MemDepositKey = "MemDeposit-"
 	MemCancelKey  = "MemCancel-"
+	MemAmendKey   = "MemAmend-"
+
+	MemBlockUpdateKey = "MemBlockUpdate-"
+
+	MemRoutedOrderKey = "MemRoutedOrder-"
 )
@@ -8,6 +8,7 @@ func NewMatchResult(
 	orders []*Order,
 	cancellations []*Cancellation,
 	settlements []*SettlementEntry,
+	selfTradePreventions []*SelfTradePrevention,
 ) *MatchResult {
 	// Note that we use string comparator since it is more robust. E.g. in the case that 2 orders match
 	// on the same orderId, we will then sort on the next field
@@ -20,9 +21,13 @@ func NewMatchResult(
 	sort.SliceStable(settlements, func(i, j int) bool {
 		return settlements[i].String() < settlements[j].String()
 	})
+	sort.SliceStable(selfTradePreventions, func(i, j int) bool {
+		return selfTradePreventions[i].String() < selfTradePreventions[j].String()
+	})
 	return &MatchResult{
-		Orders:        orders,
-		Cancellations: cancellations,
-		Settlements:   settlements,
+		Orders:               orders,
+		Cancellations:        cancellations,
+		Settlements:          settlements,
+		SelfTradePreventions: selfTradePreventions,
 	}
 }
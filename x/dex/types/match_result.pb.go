@@ -24,11 +24,12 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MatchResult struct {
-	Height        int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
-	ContractAddr  string             `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
-	Orders        []*Order           `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders"`
-	Settlements   []*SettlementEntry `protobuf:"bytes,4,rep,name=settlements,proto3" json:"settlements"`
-	Cancellations []*Cancellation    `protobuf:"bytes,5,rep,name=cancellations,proto3" json:"cancellations"`
+	Height               int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	ContractAddr         string                 `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Orders               []*Order               `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders"`
+	Settlements          []*SettlementEntry     `protobuf:"bytes,4,rep,name=settlements,proto3" json:"settlements"`
+	Cancellations        []*Cancellation        `protobuf:"bytes,5,rep,name=cancellations,proto3" json:"cancellations"`
+	SelfTradePreventions []*SelfTradePrevention `protobuf:"bytes,6,rep,name=selfTradePreventions,proto3" json:"self_trade_preventions"`
 }
 
 func (m *MatchResult) Reset()         { *m = MatchResult{} }
@@ -99,6 +100,13 @@ func (m *MatchResult) GetCancellations() []*Cancellation {
 	return nil
 }
 
+func (m *MatchResult) GetSelfTradePreventions() []*SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePreventions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MatchResult)(nil), "seiprotocol.seichain.dex.MatchResult")
 }
@@ -106,29 +114,32 @@ func init() {
 func init() { proto.RegisterFile("dex/match_result.proto", fileDescriptor_9225b122096d4ce7) }
 
 var fileDescriptor_9225b122096d4ce7 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x6a, 0xfa, 0x40,
-	0x10, 0xc6, 0x8d, 0xfe, 0xff, 0x42, 0xd7, 0x16, 0xdb, 0x20, 0x25, 0x78, 0x48, 0xc4, 0x43, 0xb1,
-	0x07, 0x13, 0x68, 0x2f, 0xbd, 0x36, 0x52, 0x7a, 0x2a, 0x85, 0xf4, 0x56, 0x0a, 0x12, 0x77, 0x87,
-	0x64, 0x21, 0x66, 0x65, 0x77, 0x04, 0x7d, 0x8b, 0x3e, 0x56, 0x8f, 0x1e, 0x7b, 0x0a, 0x45, 0x6f,
-	0xb9, 0xf4, 0x15, 0xca, 0x6e, 0xb4, 0xd5, 0x83, 0xa7, 0x99, 0xf9, 0xf2, 0xcd, 0xef, 0xcb, 0xb0,
-	0xe4, 0x92, 0xc1, 0x22, 0x98, 0xc6, 0x48, 0xd3, 0xb1, 0x04, 0x35, 0xcf, 0xd0, 0x9f, 0x49, 0x81,
-	0xc2, 0x76, 0x14, 0x70, 0xd3, 0x51, 0x91, 0xf9, 0x0a, 0x38, 0x4d, 0x63, 0x9e, 0xfb, 0x0c, 0x16,
-	0xdd, 0xb6, 0xde, 0x10, 0x92, 0x81, 0xac, 0xac, 0xdd, 0x8e, 0x16, 0x14, 0x20, 0x66, 0x30, 0x85,
-	0x1c, 0x77, 0x6a, 0x22, 0x12, 0x61, 0xda, 0x40, 0x77, 0x95, 0xda, 0xff, 0xae, 0x93, 0xd6, 0x93,
-	0x4e, 0x8b, 0x4c, 0x98, 0xdd, 0x27, 0xcd, 0x14, 0x78, 0x92, 0xa2, 0x63, 0xf5, 0xac, 0x41, 0x23,
-	0x24, 0x65, 0xe1, 0x6d, 0x95, 0x68, 0x5b, 0xed, 0x3b, 0x72, 0x4a, 0x45, 0x8e, 0x32, 0xa6, 0x78,
-	0xcf, 0x98, 0x74, 0xea, 0x3d, 0x6b, 0x70, 0x12, 0x76, 0xca, 0xc2, 0x3b, 0xdf, 0xe9, 0xe3, 0x98,
-	0x31, 0x09, 0x4a, 0x45, 0x07, 0x4e, 0x7b, 0x44, 0x9a, 0xe6, 0x47, 0x95, 0xd3, 0xe8, 0x35, 0x06,
-	0xad, 0x1b, 0xcf, 0x3f, 0x76, 0x95, 0xff, 0xac, 0x7d, 0x55, 0x7c, 0xb5, 0x12, 0x6d, 0xab, 0xfd,
-	0x46, 0x5a, 0x7f, 0xc7, 0x29, 0xe7, 0x9f, 0x21, 0x5d, 0x1f, 0x27, 0xbd, 0xfc, 0x9a, 0x1f, 0x72,
-	0x94, 0xcb, 0xb0, 0x5d, 0x16, 0xde, 0x3e, 0x21, 0xda, 0x1f, 0xec, 0x31, 0x39, 0xa3, 0x71, 0x4e,
-	0x21, 0xcb, 0x62, 0xe4, 0x22, 0x57, 0xce, 0x7f, 0xc3, 0xbf, 0x3a, 0xce, 0x1f, 0xed, 0xd9, 0xc3,
-	0x8b, 0xb2, 0xf0, 0x0e, 0x01, 0xd1, 0xe1, 0x18, 0x3e, 0x7e, 0xac, 0x5d, 0x6b, 0xb5, 0x76, 0xad,
-	0xaf, 0xb5, 0x6b, 0xbd, 0x6f, 0xdc, 0xda, 0x6a, 0xe3, 0xd6, 0x3e, 0x37, 0x6e, 0xed, 0x75, 0x98,
-	0x70, 0x4c, 0xe7, 0x13, 0x9f, 0x8a, 0x69, 0xa0, 0x80, 0x0f, 0x77, 0x71, 0x66, 0x30, 0x79, 0xc1,
-	0x22, 0xd0, 0x6f, 0x8b, 0xcb, 0x19, 0xa8, 0x49, 0xd3, 0x7c, 0xbf, 0xfd, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x40, 0xd8, 0xfe, 0x79, 0x32, 0x02, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
+	0x10, 0xb5, 0xaa, 0x56, 0xd0, 0x75, 0x8b, 0x5b, 0x61, 0x8c, 0xd0, 0x41, 0x32, 0x3e, 0x14, 0xf7,
+	0x60, 0x09, 0xda, 0x4b, 0xaf, 0x95, 0x29, 0x3d, 0x95, 0x06, 0x25, 0xa7, 0x10, 0x10, 0xf2, 0xee,
+	0x44, 0x12, 0xc8, 0x5a, 0xb3, 0xbb, 0x0e, 0xf6, 0x21, 0xff, 0x90, 0xcf, 0xca, 0xd1, 0xc7, 0x9c,
+	0x44, 0xb0, 0x6f, 0x3a, 0xe4, 0x1b, 0xc2, 0xae, 0x64, 0xc7, 0x26, 0x76, 0x4e, 0x33, 0xf3, 0xf4,
+	0xe6, 0xcd, 0x8c, 0xde, 0xa2, 0x1e, 0x81, 0x85, 0x3f, 0x8d, 0x05, 0x4e, 0x23, 0x06, 0x7c, 0x9e,
+	0x0b, 0x6f, 0xc6, 0xa8, 0xa0, 0xa6, 0xc5, 0x21, 0x53, 0x19, 0xa6, 0xb9, 0xc7, 0x21, 0xc3, 0x69,
+	0x9c, 0x15, 0x1e, 0x81, 0x85, 0xdd, 0x91, 0x1d, 0x94, 0x11, 0x60, 0x35, 0xd5, 0xee, 0x4a, 0x80,
+	0x83, 0x10, 0x39, 0x4c, 0xa1, 0x68, 0x04, 0x6c, 0xb7, 0x46, 0xf3, 0xeb, 0x48, 0xb0, 0x98, 0x40,
+	0x34, 0x63, 0x70, 0x03, 0x85, 0xc8, 0x68, 0xb1, 0x6d, 0x4b, 0x68, 0x42, 0x55, 0xea, 0xcb, 0xac,
+	0x46, 0x07, 0x4f, 0x3a, 0x6a, 0xff, 0x93, 0xeb, 0x84, 0x6a, 0x1b, 0x73, 0x80, 0x8c, 0x14, 0xb2,
+	0x24, 0x15, 0x96, 0xd6, 0xd7, 0x86, 0x7a, 0x80, 0xaa, 0xd2, 0x6d, 0x90, 0xb0, 0x89, 0xe6, 0x2f,
+	0xf4, 0x09, 0xd3, 0x42, 0xb0, 0x18, 0x8b, 0xdf, 0x84, 0x30, 0xeb, 0x5d, 0x5f, 0x1b, 0x7e, 0x0c,
+	0xba, 0x55, 0xe9, 0x7e, 0xd9, 0xe2, 0x51, 0x4c, 0x08, 0x03, 0xce, 0xc3, 0x03, 0xa6, 0x39, 0x46,
+	0x86, 0xba, 0x84, 0x5b, 0x7a, 0x5f, 0x1f, 0xb6, 0x7f, 0xb8, 0xde, 0xa9, 0xb3, 0xbd, 0xff, 0x92,
+	0x57, 0x8f, 0xaf, 0x5b, 0xc2, 0x26, 0x9a, 0x57, 0xa8, 0xfd, 0x72, 0x3d, 0xb7, 0xde, 0x2b, 0xa5,
+	0xef, 0xa7, 0x95, 0xce, 0x77, 0xe4, 0x3f, 0x85, 0x60, 0xcb, 0xa0, 0x53, 0x95, 0xee, 0xbe, 0x42,
+	0xb8, 0x5f, 0x98, 0x11, 0xfa, 0x8c, 0xe3, 0x02, 0x43, 0x9e, 0xc7, 0xf2, 0xe7, 0x71, 0xeb, 0x83,
+	0xd2, 0xff, 0x76, 0x5a, 0x7f, 0xbc, 0x47, 0x0f, 0xbe, 0x56, 0xa5, 0x7b, 0x28, 0x10, 0x1e, 0x96,
+	0xe6, 0x2d, 0xea, 0x4a, 0x9b, 0x2e, 0xa4, 0x4b, 0x67, 0x3b, 0x93, 0xb8, 0x65, 0xa8, 0x39, 0xa3,
+	0xb7, 0xee, 0x78, 0xd5, 0x15, 0xd8, 0x55, 0xe9, 0xf6, 0x8e, 0xba, 0xce, 0xc3, 0xa3, 0x63, 0x82,
+	0xbf, 0xf7, 0x6b, 0x47, 0x5b, 0xad, 0x1d, 0xed, 0x71, 0xed, 0x68, 0x77, 0x1b, 0xa7, 0xb5, 0xda,
+	0x38, 0xad, 0x87, 0x8d, 0xd3, 0xba, 0x1c, 0x25, 0x99, 0x48, 0xe7, 0x13, 0x0f, 0xd3, 0xa9, 0xcf,
+	0x21, 0x1b, 0x6d, 0xb7, 0x50, 0x85, 0x5a, 0xc3, 0x5f, 0xf8, 0xf2, 0x95, 0x89, 0xe5, 0x0c, 0xf8,
+	0xc4, 0x50, 0xdf, 0x7f, 0x3e, 0x0f, 0x00, 0xe9, 0x79, 0x63, 0xbc, 0xd2, 0x02, 0x00, 0x00,
 }
 
 func (m *MatchResult) Marshal() (dAtA []byte, err error) {
@@ -151,6 +162,20 @@ func (m *MatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SelfTradePreventions) > 0 {
+		for iNdEx := len(m.SelfTradePreventions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelfTradePreventions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMatchResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Cancellations) > 0 {
 		for iNdEx := len(m.Cancellations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -250,6 +275,12 @@ func (m *MatchResult) Size() (n int) {
 			n += 1 + l + sovMatchResult(uint64(l))
 		}
 	}
+	if len(m.SelfTradePreventions) > 0 {
+		for _, e := range m.SelfTradePreventions {
+			l = e.Size()
+			n += 1 + l + sovMatchResult(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -441,6 +472,40 @@ func (m *MatchResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePreventions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatchResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatchResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatchResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfTradePreventions = append(m.SelfTradePreventions, &SelfTradePrevention{})
+			if err := m.SelfTradePreventions[len(m.SelfTradePreventions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMatchResult(dAtA[iNdEx:])
@@ -41,7 +41,7 @@ func TestMatchResultSorted(t *testing.T) {
 	cancellations := []*types.Cancellation{cancellation2, cancellation1}
 	settlements := []*types.SettlementEntry{settlement2, settlement1}
 
-	matchResult := types.NewMatchResult(orders, cancellations, settlements)
+	matchResult := types.NewMatchResult(orders, cancellations, settlements, []*types.SelfTradePrevention{})
 	expectedOrders := []*types.Order{order1, order2, order3}
 	expectedCancellations := []*types.Cancellation{cancellation1, cancellation2}
 	expectedSettlements := []*types.SettlementEntry{settlement1, settlement2}
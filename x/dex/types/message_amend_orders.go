@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAmendOrders = "amend_orders"
+
+var _ sdk.Msg = &MsgAmendOrders{}
+
+func NewMsgAmendOrders(
+	creator string,
+	amendments []*Amendment,
+	contractAddr string,
+) *MsgAmendOrders {
+	return &MsgAmendOrders{
+		Creator:      creator,
+		Amendments:   amendments,
+		ContractAddr: contractAddr,
+	}
+}
+
+func (msg *MsgAmendOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAmendOrders) Type() string {
+	return TypeMsgAmendOrders
+}
+
+func (msg *MsgAmendOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAmendOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAmendOrders) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	if len(msg.Amendments) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at least one amendment is required")
+	}
+
+	seen := map[uint64]struct{}{}
+	for _, amendment := range msg.Amendments {
+		if _, ok := seen[amendment.Id]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order %d is amended more than once", amendment.Id)
+		}
+		seen[amendment.Id] = struct{}{}
+		if amendment.Price.IsNil() || !amendment.Price.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amendment, price must be positive")
+		}
+		if amendment.NewPrice.IsNil() || !amendment.NewPrice.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amendment, new price must be positive")
+		}
+		if amendment.NewQuantity.IsNil() || !amendment.NewQuantity.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amendment, new quantity must be positive")
+		}
+		if len(amendment.AssetDenom) == 0 || sdk.ValidateDenom(amendment.AssetDenom) != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amendment, asset denom is empty or invalid")
+		}
+		if len(amendment.PriceDenom) == 0 || sdk.ValidateDenom(amendment.PriceDenom) != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amendment, price denom is empty or invalid")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgHaltPair = "halt_pair"
+
+var _ sdk.Msg = &MsgHaltPair{}
+
+func NewMsgHaltPair(
+	creator string,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+) *MsgHaltPair {
+	return &MsgHaltPair{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		PriceDenom:   priceDenom,
+		AssetDenom:   assetDenom,
+	}
+}
+
+func (msg *MsgHaltPair) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgHaltPair) Type() string {
+	return TypeMsgHaltPair
+}
+
+func (msg *MsgHaltPair) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgHaltPair) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgHaltPair) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	if msg.PriceDenom == "" || msg.AssetDenom == "" {
+		return errors.New("pair denoms cannot be empty")
+	}
+
+	return nil
+}
@@ -76,8 +76,15 @@ func (msg *MsgPlaceOrders) ValidateBasic() error {
 		if order.OrderType == OrderType_FOKMARKETBYVALUE || order.OrderType == OrderType_FOKMARKET {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "FOK orders are temporarily disabled")
 		}
+		if order.OrderType == OrderType_IOC || order.OrderType == OrderType_POSTONLY || order.OrderType == OrderType_POSTONLYREPRICE {
+			if !order.Price.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "IOC and post-only orders need a positive price")
+			}
+		}
 		if order.OrderType == OrderType_STOPLIMIT || order.OrderType == OrderType_STOPLOSS {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order (%s) are not supported yet", err)
+			if order.TriggerPrice.IsNil() || !order.TriggerPrice.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order needs a positive trigger price")
+			}
 		}
 	}
 
@@ -100,3 +100,58 @@ func TestValidateMsgPlaceOrder(t *testing.T) {
 	}
 	require.Error(t, msg.ValidateBasic())
 }
+
+func TestValidateMsgPlaceStopOrder(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	order := &types.Order{
+		Id:           1,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+		Quantity:     sdk.OneDec(),
+		Price:        sdk.OneDec(),
+		AssetDenom:   "denom1",
+		PriceDenom:   "denom2",
+		OrderType:    types.OrderType_STOPLIMIT,
+	}
+	msg := &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders:       []*types.Order{order},
+	}
+	// missing trigger price
+	require.Error(t, msg.ValidateBasic())
+
+	order.TriggerPrice = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+
+	order.TriggerPrice = sdk.OneDec()
+	require.NoError(t, msg.ValidateBasic())
+
+	order.OrderType = types.OrderType_STOPLOSS
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestValidateMsgPlaceIOCAndPostOnlyOrder(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	for _, orderType := range []types.OrderType{types.OrderType_IOC, types.OrderType_POSTONLY, types.OrderType_POSTONLYREPRICE} {
+		order := &types.Order{
+			Id:           1,
+			Account:      "test",
+			ContractAddr: TEST_CONTRACT,
+			Quantity:     sdk.OneDec(),
+			Price:        sdk.ZeroDec(),
+			AssetDenom:   "denom1",
+			PriceDenom:   "denom2",
+			OrderType:    orderType,
+		}
+		msg := &types.MsgPlaceOrders{
+			Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+			ContractAddr: TEST_CONTRACT,
+			Orders:       []*types.Order{order},
+		}
+		require.Error(t, msg.ValidateBasic())
+
+		order.Price = sdk.OneDec()
+		require.NoError(t, msg.ValidateBasic())
+	}
+}
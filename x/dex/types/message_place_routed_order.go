@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlaceRoutedOrder = "place_routed_order"
+
+var _ sdk.Msg = &MsgPlaceRoutedOrder{}
+
+func NewMsgPlaceRoutedOrder(
+	creator string,
+	hops []*RouteHop,
+	inputDenom string,
+	inputQuantity sdk.Dec,
+	minOutput sdk.Dec,
+	funds sdk.Coins,
+) *MsgPlaceRoutedOrder {
+	return &MsgPlaceRoutedOrder{
+		Creator:       creator,
+		Hops:          hops,
+		InputDenom:    inputDenom,
+		InputQuantity: inputQuantity,
+		MinOutput:     minOutput,
+		Funds:         funds,
+	}
+}
+
+func (msg *MsgPlaceRoutedOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceRoutedOrder) Type() string {
+	return TypeMsgPlaceRoutedOrder
+}
+
+func (msg *MsgPlaceRoutedOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceRoutedOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceRoutedOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Hops) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRoute, "at least one hop is required")
+	}
+	if sdk.ValidateDenom(msg.InputDenom) != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "invalid input denom %s", msg.InputDenom)
+	}
+	if msg.InputQuantity.IsNil() || !msg.InputQuantity.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidRoute, "input quantity must be positive")
+	}
+	if msg.MinOutput.IsNil() || msg.MinOutput.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRoute, "min output cannot be negative")
+	}
+	for i, hop := range msg.Hops {
+		if _, err := sdk.AccAddressFromBech32(hop.ContractAddr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address of hop %d (%s)", i, err)
+		}
+		if sdk.ValidateDenom(hop.PriceDenom) != nil || sdk.ValidateDenom(hop.AssetDenom) != nil {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "hop %d has an empty or invalid denom", i)
+		}
+		if hop.Price.IsNil() || hop.Price.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "invalid price of hop %d", i)
+		}
+	}
+	directions, _, err := GetRouteHopDirections(msg.InputDenom, msg.Hops)
+	if err != nil {
+		return err
+	}
+	for i, direction := range directions {
+		if direction == PositionDirection_LONG && !msg.Hops[i].Price.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "hop %d buys the asset and needs a positive worst price", i)
+		}
+	}
+	for _, fund := range msg.Funds {
+		if fund.Amount.IsNil() || fund.IsNegative() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fund deposits cannot be nil or negative")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgPlaceRoutedOrder(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	newMsg := func(inputDenom string, hops ...*types.RouteHop) *types.MsgPlaceRoutedOrder {
+		return types.NewMsgPlaceRoutedOrder("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx", hops, inputDenom, sdk.OneDec(), sdk.OneDec(), sdk.NewCoins())
+	}
+	hop := func(priceDenom string, assetDenom string, price sdk.Dec) *types.RouteHop {
+		return &types.RouteHop{ContractAddr: TEST_CONTRACT, PriceDenom: priceDenom, AssetDenom: assetDenom, Price: price}
+	}
+
+	// sell atom for usdc, then buy sei with usdc
+	msg := newMsg("atom", hop("usdc", "atom", sdk.ZeroDec()), hop("usdc", "sei", sdk.OneDec()))
+	require.NoError(t, msg.ValidateBasic())
+	directions, outputDenom, err := types.GetRouteHopDirections(msg.InputDenom, msg.Hops)
+	require.NoError(t, err)
+	require.Equal(t, []types.PositionDirection{types.PositionDirection_SHORT, types.PositionDirection_LONG}, directions)
+	require.Equal(t, "sei", outputDenom)
+
+	// no hops
+	require.ErrorIs(t, newMsg("atom").ValidateBasic(), types.ErrInvalidRoute)
+
+	// hops that don't connect
+	require.ErrorIs(t, newMsg("atom", hop("usdc", "atom", sdk.ZeroDec()), hop("eth", "sei", sdk.OneDec())).ValidateBasic(), types.ErrInvalidRoute)
+
+	// a hop buying the asset needs a worst price
+	require.ErrorIs(t, newMsg("usdc", hop("usdc", "atom", sdk.ZeroDec())).ValidateBasic(), types.ErrInvalidRoute)
+
+	// invalid quantities
+	msg = newMsg("atom", hop("usdc", "atom", sdk.ZeroDec()))
+	msg.InputQuantity = sdk.ZeroDec()
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRoute)
+	msg = newMsg("atom", hop("usdc", "atom", sdk.ZeroDec()))
+	msg.MinOutput = sdk.OneDec().Neg()
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRoute)
+}
+
+func TestGetHopOutput(t *testing.T) {
+	settlements := []*types.SettlementEntry{
+		{Quantity: sdk.NewDec(2), ExecutionCostOrProceed: sdk.NewDec(3)},
+		{Quantity: sdk.NewDec(1), ExecutionCostOrProceed: sdk.NewDec(4)},
+	}
+	// buying hops get the asset, selling hops get the proceeds
+	require.Equal(t, sdk.NewDec(3), types.GetHopOutput(types.PositionDirection_LONG, settlements))
+	require.Equal(t, sdk.NewDec(10), types.GetHopOutput(types.PositionDirection_SHORT, settlements))
+
+	routedOrder := types.RoutedOrder{
+		Creator: "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		Hops:    []*types.RouteHop{{ContractAddr: "test", PriceDenom: "usdc", AssetDenom: "atom", Price: sdk.NewDec(4)}},
+	}
+	order := routedOrder.GetHopOrder(0, types.PositionDirection_LONG, sdk.NewDec(10))
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), order.Quantity)
+	require.Equal(t, types.OrderType_MARKET, order.OrderType)
+	order = routedOrder.GetHopOrder(0, types.PositionDirection_SHORT, sdk.NewDec(10))
+	require.Equal(t, sdk.NewDec(10), order.Quantity)
+}
+
+func TestGetHopContractAddrs(t *testing.T) {
+	routedOrder := types.RoutedOrder{
+		Hops: []*types.RouteHop{{ContractAddr: "first"}, {ContractAddr: "second"}, {ContractAddr: "first"}},
+	}
+	require.Equal(t, []string{"first", "second"}, routedOrder.GetHopContractAddrs())
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -76,6 +77,15 @@ func (msg *MsgRegisterPairs) ValidateBasic() error {
 			if pair == nil {
 				return errors.New("empty pair info")
 			}
+			if _, ok := SelfTradePreventionMode_name[int32(pair.SelfTradePreventionMode)]; !ok {
+				return fmt.Errorf("invalid self-trade prevention mode %d", pair.SelfTradePreventionMode)
+			}
+			if err := pair.ValidateMatchingAlgorithm(); err != nil {
+				return err
+			}
+			if pair.Halted {
+				return errors.New("pairs cannot be registered as halted")
+			}
 		}
 	}
 
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResumePair = "resume_pair"
+
+var _ sdk.Msg = &MsgResumePair{}
+
+func NewMsgResumePair(
+	creator string,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+) *MsgResumePair {
+	return &MsgResumePair{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		PriceDenom:   priceDenom,
+		AssetDenom:   assetDenom,
+	}
+}
+
+func (msg *MsgResumePair) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumePair) Type() string {
+	return TypeMsgResumePair
+}
+
+func (msg *MsgResumePair) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResumePair) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResumePair) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	if msg.PriceDenom == "" || msg.AssetDenom == "" {
+		return errors.New("pair denoms cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevokeRentTopUp = "revoke_rent_top_up"
+
+var _ sdk.Msg = &MsgRevokeRentTopUp{}
+
+func NewMsgRevokeRentTopUp(
+	sender string,
+	contractAddr string,
+) *MsgRevokeRentTopUp {
+	return &MsgRevokeRentTopUp{
+		Sender:       sender,
+		ContractAddr: contractAddr,
+	}
+}
+
+func (msg *MsgRevokeRentTopUp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeRentTopUp) Type() string {
+	return TypeMsgRevokeRentTopUp
+}
+
+func (msg *MsgRevokeRentTopUp) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgRevokeRentTopUp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeRentTopUp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRentTopUp = "set_rent_top_up"
+
+var _ sdk.Msg = &MsgSetRentTopUp{}
+
+func NewMsgSetRentTopUp(
+	creator string,
+	contractAddr string,
+	source string,
+	threshold uint64,
+	amount uint64,
+	spendLimit uint64,
+	expiration uint64,
+) *MsgSetRentTopUp {
+	return &MsgSetRentTopUp{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		Source:       source,
+		Threshold:    threshold,
+		Amount:       amount,
+		SpendLimit:   spendLimit,
+		Expiration:   expiration,
+	}
+}
+
+func (msg *MsgSetRentTopUp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRentTopUp) Type() string {
+	return TypeMsgSetRentTopUp
+}
+
+// The source account needs to sign as well since rent will be drawn from it.
+func (msg *MsgSetRentTopUp) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	source, err := sdk.AccAddressFromBech32(msg.Source)
+	if err != nil {
+		panic(err)
+	}
+	if creator.Equals(source) {
+		return []sdk.AccAddress{creator}
+	}
+	return []sdk.AccAddress{creator, source}
+}
+
+func (msg *MsgSetRentTopUp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRentTopUp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Source)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address (%s)", err)
+	}
+
+	if msg.Threshold == 0 {
+		return errors.New("rent top-up threshold must be positive")
+	}
+
+	if msg.Amount == 0 {
+		return errors.New("rent top-up amount must be positive")
+	}
+
+	if msg.SpendLimit == 0 {
+		return errors.New("rent top-up spend limit must be positive")
+	}
+
+	return nil
+}
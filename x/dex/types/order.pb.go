@@ -40,6 +40,10 @@ type Order struct {
 	Nominal           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=nominal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"nominal" yaml:"nominal"`
 	TriggerPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
 	TriggerStatus     bool                                   `protobuf:"varint,15,opt,name=triggerStatus,proto3" json:"trigger_status"`
+	// block height at which a resting order expires, 0 for no height-based expiry
+	ExpiryHeight uint64 `protobuf:"varint,16,opt,name=expiryHeight,proto3" json:"expiry_height"`
+	// unix timestamp in seconds at which a resting order expires, 0 for no time-based expiry
+	ExpiryTimestamp uint64 `protobuf:"varint,17,opt,name=expiryTimestamp,proto3" json:"expiry_timestamp"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -152,6 +156,20 @@ func (m *Order) GetTriggerStatus() bool {
 	return false
 }
 
+func (m *Order) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Order) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 type Cancellation struct {
 	Id                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	Initiator         CancellationInitiator                  `protobuf:"varint,2,opt,name=initiator,proto3,enum=seiprotocol.seichain.dex.CancellationInitiator" json:"initiator"`
@@ -161,6 +179,9 @@ type Cancellation struct {
 	AssetDenom        string                                 `protobuf:"bytes,6,opt,name=assetDenom,proto3" json:"asset_denom"`
 	PositionDirection PositionDirection                      `protobuf:"varint,7,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
 	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// quantity removed from the order by a partial cancellation, zero if the whole
+	// remainder of the order is cancelled
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
 }
 
 func (m *Cancellation) Reset()         { *m = Cancellation{} }
@@ -289,63 +310,172 @@ func (m *ActiveOrders) GetIds() []uint64 {
 	return nil
 }
 
+type Amendment struct {
+	Id                uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Creator           string            `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator"`
+	ContractAddr      string            `protobuf:"bytes,3,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom        string            `protobuf:"bytes,4,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom        string            `protobuf:"bytes,5,opt,name=assetDenom,proto3" json:"asset_denom"`
+	PositionDirection PositionDirection `protobuf:"varint,6,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
+	// the price the order currently rests at
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	NewPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=newPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_price" yaml:"new_price"`
+	NewQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=newQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_quantity" yaml:"new_quantity"`
+	// the id of the order replacing the amended one if the price changed
+	NewOrderId uint64 `protobuf:"varint,10,opt,name=newOrderId,proto3" json:"new_order_id"`
+}
+
+func (m *Amendment) Reset()         { *m = Amendment{} }
+func (m *Amendment) String() string { return proto.CompactTextString(m) }
+func (*Amendment) ProtoMessage()    {}
+func (*Amendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{3}
+}
+func (m *Amendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Amendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Amendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Amendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Amendment.Merge(m, src)
+}
+func (m *Amendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Amendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Amendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Amendment proto.InternalMessageInfo
+
+func (m *Amendment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Amendment) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Amendment) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *Amendment) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *Amendment) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *Amendment) GetPositionDirection() PositionDirection {
+	if m != nil {
+		return m.PositionDirection
+	}
+	return PositionDirection_LONG
+}
+
+func (m *Amendment) GetNewOrderId() uint64 {
+	if m != nil {
+		return m.NewOrderId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Order)(nil), "seiprotocol.seichain.dex.Order")
 	proto.RegisterType((*Cancellation)(nil), "seiprotocol.seichain.dex.Cancellation")
 	proto.RegisterType((*ActiveOrders)(nil), "seiprotocol.seichain.dex.ActiveOrders")
+	proto.RegisterType((*Amendment)(nil), "seiprotocol.seichain.dex.Amendment")
 }
 
 func init() { proto.RegisterFile("dex/order.proto", fileDescriptor_c2d5fab85368797d) }
 
 var fileDescriptor_c2d5fab85368797d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0xb5, 0x1c, 0xff, 0x4e, 0x1c, 0xfb, 0xcb, 0x10, 0x82, 0xbe, 0x50, 0x2c, 0xa3, 0xd2, 0xe2,
-	0x50, 0x62, 0x41, 0xbb, 0x09, 0xa5, 0x9b, 0xa8, 0x86, 0x50, 0x4a, 0x68, 0x3a, 0x2d, 0x14, 0x4a,
-	0x8b, 0x51, 0x34, 0x83, 0x33, 0xd4, 0xd6, 0x38, 0x9a, 0x71, 0x89, 0xe9, 0x4b, 0xf4, 0x05, 0xfa,
-	0x3e, 0xd9, 0x35, 0xcb, 0xd2, 0x85, 0x28, 0xc9, 0x4e, 0xcb, 0x3c, 0x41, 0xd1, 0x95, 0x26, 0xb6,
-	0xf3, 0x43, 0xea, 0x45, 0x36, 0xd6, 0x9d, 0x73, 0xcf, 0x39, 0x57, 0x9a, 0x99, 0x7b, 0x8d, 0x1a,
-	0x94, 0x1d, 0x3b, 0x22, 0xa4, 0x2c, 0xec, 0x8c, 0x42, 0xa1, 0x04, 0x36, 0x25, 0xe3, 0x10, 0xf9,
-	0x62, 0xd0, 0x91, 0x8c, 0xfb, 0x87, 0x1e, 0x0f, 0x3a, 0x94, 0x1d, 0x6f, 0xac, 0xf5, 0x45, 0x5f,
-	0x40, 0xca, 0x49, 0xa2, 0x94, 0xbf, 0x01, 0x06, 0x2c, 0x18, 0x0f, 0x65, 0x0a, 0xd8, 0x3f, 0x2b,
-	0xa8, 0xf8, 0x26, 0x31, 0xc4, 0x1b, 0x28, 0xcf, 0xa9, 0x69, 0xb4, 0x8c, 0x76, 0xc1, 0x45, 0x27,
-	0x91, 0x65, 0xc4, 0x91, 0x95, 0xe7, 0x94, 0xe4, 0x39, 0xc5, 0x7b, 0xa8, 0x24, 0x95, 0xa7, 0xc6,
-	0xd2, 0xcc, 0xb7, 0x8c, 0x76, 0xfd, 0xe9, 0xa3, 0xce, 0x6d, 0x75, 0x3b, 0x60, 0xf6, 0x0e, 0xc8,
-	0x6e, 0x3d, 0xb3, 0xc9, 0xc4, 0x24, 0x7b, 0xe2, 0x4d, 0x54, 0xf6, 0x7c, 0x5f, 0x8c, 0x03, 0x65,
-	0x2e, 0xb5, 0x8c, 0x76, 0xd5, 0x6d, 0x64, 0x44, 0x0d, 0x13, 0x1d, 0xe0, 0x17, 0xa8, 0xe6, 0x8b,
-	0x40, 0x85, 0x9e, 0xaf, 0x76, 0x28, 0x0d, 0xcd, 0x02, 0xf0, 0xcd, 0x8c, 0xff, 0x9f, 0xce, 0xf5,
-	0x3c, 0x4a, 0x43, 0x26, 0x25, 0x99, 0x63, 0xe3, 0xcf, 0xa8, 0x38, 0x0a, 0xb9, 0xcf, 0xcc, 0x22,
-	0xc8, 0x76, 0x4f, 0x22, 0x2b, 0xf7, 0x3b, 0xb2, 0x1e, 0xf7, 0xb9, 0x3a, 0x1c, 0x1f, 0x74, 0x7c,
-	0x31, 0x74, 0x7c, 0x21, 0x87, 0x42, 0x66, 0x8f, 0x2d, 0x49, 0xbf, 0x38, 0x6a, 0x32, 0x62, 0xb2,
-	0xd3, 0x65, 0x7e, 0x1c, 0x59, 0xa9, 0xfc, 0x22, 0xb2, 0x6a, 0x13, 0x6f, 0x38, 0x78, 0x6e, 0xc3,
-	0xd2, 0x26, 0x29, 0x8c, 0x39, 0xaa, 0x1c, 0x8d, 0xbd, 0x40, 0x71, 0x35, 0x31, 0x4b, 0x50, 0x61,
-	0x6f, 0xe1, 0x0a, 0x97, 0x0e, 0x17, 0x91, 0xd5, 0x48, 0x8b, 0x68, 0xc4, 0x26, 0x97, 0x49, 0xec,
-	0x20, 0x04, 0x35, 0xbb, 0x2c, 0x10, 0x43, 0xb3, 0x9c, 0xee, 0x5a, 0x1c, 0x59, 0xcb, 0x80, 0xf6,
-	0x68, 0x02, 0x93, 0x19, 0x4a, 0x22, 0xf0, 0xa4, 0x64, 0x2a, 0x15, 0x54, 0xa6, 0x02, 0x40, 0xb5,
-	0x60, 0x4a, 0xc1, 0x6f, 0x51, 0x15, 0x6e, 0xd6, 0xfb, 0xc9, 0x88, 0x99, 0x55, 0x38, 0xe6, 0x87,
-	0x77, 0x1c, 0x73, 0x42, 0x75, 0xeb, 0x71, 0x64, 0x21, 0x50, 0xf6, 0x92, 0xef, 0x22, 0x53, 0x17,
-	0x7c, 0x84, 0x56, 0x47, 0x42, 0x72, 0xc5, 0x45, 0xd0, 0xe5, 0x21, 0xf3, 0x93, 0xc0, 0x44, 0x60,
-	0xfd, 0xe4, 0x76, 0xeb, 0xfd, 0xab, 0x12, 0x77, 0x3d, 0x8e, 0x2c, 0xac, 0x9d, 0x7a, 0x54, 0xe3,
-	0xe4, 0xba, 0x3b, 0x7e, 0x80, 0x0a, 0xd4, 0x53, 0x9e, 0xb9, 0x0c, 0x1f, 0x5c, 0x89, 0x23, 0x0b,
-	0xd6, 0x04, 0x7e, 0x71, 0x17, 0xad, 0xa6, 0x57, 0xb0, 0xcb, 0xa4, 0x1f, 0xf2, 0x11, 0xbc, 0x50,
-	0x0d, 0xa8, 0x50, 0x23, 0x4d, 0xf6, 0xe8, 0x34, 0x4b, 0xae, 0x0b, 0x30, 0x43, 0xe5, 0x40, 0x0c,
-	0x79, 0xe0, 0x0d, 0xcc, 0x15, 0xd0, 0xbe, 0x5e, 0xf8, 0xd4, 0xb5, 0xc1, 0x45, 0x64, 0xd5, 0xd3,
-	0x43, 0xcf, 0x00, 0x9b, 0xe8, 0x14, 0xfe, 0x86, 0x6a, 0x2a, 0xe4, 0xfd, 0x3e, 0x0b, 0xf7, 0xe1,
-	0x0e, 0xd7, 0xa1, 0xd6, 0x87, 0x85, 0x6b, 0xad, 0x64, 0x2e, 0x3d, 0x7d, 0x97, 0xd7, 0xd2, 0x8a,
-	0x73, 0xb0, 0x4d, 0xe6, 0x8a, 0xe1, 0x6d, 0xa4, 0x65, 0x69, 0x2f, 0x9b, 0x8d, 0x96, 0xd1, 0xae,
-	0xb8, 0x38, 0x8e, 0xac, 0xba, 0x16, 0x66, 0x5d, 0x3d, 0x4f, 0xb4, 0x7f, 0x14, 0x50, 0xed, 0xa5,
-	0x17, 0xf8, 0x6c, 0x30, 0xf0, 0x60, 0xbb, 0xd6, 0x67, 0x06, 0x4b, 0x69, 0x66, 0xa8, 0x7c, 0x42,
-	0x55, 0x1e, 0x70, 0xc5, 0x3d, 0x25, 0xc2, 0x6c, 0xae, 0x38, 0xb7, 0xdf, 0x8a, 0x59, 0xcb, 0x57,
-	0x5a, 0xe6, 0xae, 0xc4, 0x91, 0x35, 0x75, 0x21, 0xd3, 0x30, 0x99, 0x31, 0x7e, 0xc8, 0xc0, 0xfb,
-	0xca, 0x8c, 0xc9, 0x60, 0xa2, 0x03, 0xbc, 0x7d, 0xe3, 0x8c, 0x59, 0xfb, 0x87, 0xf9, 0x32, 0xdf,
-	0x95, 0xc5, 0x45, 0xbb, 0xb2, 0x74, 0x77, 0x57, 0xde, 0xd8, 0x42, 0xe5, 0x7b, 0x6d, 0xa1, 0xcb,
-	0xa1, 0x59, 0xb9, 0x8f, 0xa1, 0x69, 0x6f, 0xa2, 0xda, 0x8e, 0xaf, 0xf8, 0x57, 0x06, 0x23, 0x44,
-	0xe2, 0xff, 0xd1, 0x12, 0xa7, 0xd2, 0x34, 0x5a, 0x4b, 0xed, 0x82, 0x5b, 0x8e, 0x23, 0x2b, 0x59,
-	0x92, 0xe4, 0xc7, 0xdd, 0x3d, 0x39, 0x6b, 0x1a, 0xa7, 0x67, 0x4d, 0xe3, 0xcf, 0x59, 0xd3, 0xf8,
-	0x7e, 0xde, 0xcc, 0x9d, 0x9e, 0x37, 0x73, 0xbf, 0xce, 0x9b, 0xb9, 0x8f, 0x5b, 0x33, 0x2f, 0x23,
-	0x19, 0xdf, 0xd2, 0xdb, 0x00, 0x0b, 0xd8, 0x07, 0xe7, 0xd8, 0x49, 0xfe, 0xeb, 0xe0, 0xbd, 0x0e,
-	0x4a, 0x90, 0x7f, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0x8b, 0xbe, 0x40, 0x07, 0x00,
-	0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0xe3, 0x46,
+	0x18, 0x8d, 0x1c, 0xff, 0x9c, 0x38, 0x76, 0x32, 0x0d, 0x8b, 0x1a, 0x8a, 0x65, 0x54, 0x5a, 0xbc,
+	0x94, 0xd8, 0xa5, 0xa5, 0xb0, 0x2c, 0xa5, 0x10, 0xd5, 0xb0, 0x5d, 0xca, 0xb2, 0xd9, 0xe9, 0xb6,
+	0x85, 0xd2, 0x62, 0xb4, 0x9a, 0xc1, 0x19, 0xd6, 0x1a, 0x79, 0x35, 0xe3, 0x3a, 0xa6, 0xff, 0x44,
+	0xff, 0xaa, 0x92, 0xe3, 0x1e, 0x7a, 0x28, 0x3d, 0x88, 0x92, 0xdc, 0x74, 0x0c, 0xf4, 0x5e, 0xf4,
+	0x49, 0x63, 0xc9, 0xd9, 0x35, 0xa9, 0x03, 0xd9, 0x4b, 0x3c, 0xf3, 0x7d, 0xdf, 0x7b, 0x4f, 0xce,
+	0xbc, 0x79, 0x16, 0x6a, 0x53, 0x76, 0x36, 0x08, 0x42, 0xca, 0xc2, 0xfe, 0x34, 0x0c, 0x54, 0x80,
+	0x4d, 0xc9, 0x38, 0xac, 0xbc, 0x60, 0xd2, 0x97, 0x8c, 0x7b, 0xa7, 0x2e, 0x17, 0x7d, 0xca, 0xce,
+	0x0e, 0x0f, 0xc6, 0xc1, 0x38, 0x80, 0xd6, 0x20, 0x59, 0xa5, 0xf3, 0x87, 0x40, 0xc0, 0xc4, 0xcc,
+	0x97, 0x69, 0xc1, 0xfe, 0xa3, 0x81, 0x2a, 0x4f, 0x13, 0x42, 0x7c, 0x88, 0x4a, 0x9c, 0x9a, 0x46,
+	0xd7, 0xe8, 0x95, 0x1d, 0x74, 0x1e, 0x59, 0x46, 0x1c, 0x59, 0x25, 0x4e, 0x49, 0x89, 0x53, 0xfc,
+	0x04, 0x55, 0xa5, 0x72, 0xd5, 0x4c, 0x9a, 0xa5, 0xae, 0xd1, 0x6b, 0x7d, 0xf6, 0x51, 0x7f, 0x9d,
+	0x6e, 0x1f, 0xc8, 0xbe, 0x83, 0x61, 0xa7, 0x95, 0xd1, 0x64, 0x60, 0x92, 0x7d, 0xe2, 0xfb, 0xa8,
+	0xe6, 0x7a, 0x5e, 0x30, 0x13, 0xca, 0xdc, 0xee, 0x1a, 0xbd, 0x86, 0xd3, 0xce, 0x06, 0x75, 0x99,
+	0xe8, 0x05, 0xfe, 0x12, 0x35, 0xbd, 0x40, 0xa8, 0xd0, 0xf5, 0xd4, 0x31, 0xa5, 0xa1, 0x59, 0x86,
+	0x79, 0x33, 0x9b, 0xdf, 0xd3, 0xbd, 0x91, 0x4b, 0x69, 0xc8, 0xa4, 0x24, 0x2b, 0xd3, 0xf8, 0x17,
+	0x54, 0x99, 0x86, 0xdc, 0x63, 0x66, 0x05, 0x60, 0x8f, 0xce, 0x23, 0x6b, 0xeb, 0xef, 0xc8, 0xfa,
+	0x78, 0xcc, 0xd5, 0xe9, 0xec, 0x45, 0xdf, 0x0b, 0xfc, 0x81, 0x17, 0x48, 0x3f, 0x90, 0xd9, 0xc7,
+	0x91, 0xa4, 0x2f, 0x07, 0x6a, 0x31, 0x65, 0xb2, 0x3f, 0x64, 0x5e, 0x1c, 0x59, 0x29, 0xfc, 0x2a,
+	0xb2, 0x9a, 0x0b, 0xd7, 0x9f, 0x3c, 0xb4, 0x61, 0x6b, 0x93, 0xb4, 0x8c, 0x39, 0xaa, 0xbf, 0x9a,
+	0xb9, 0x42, 0x71, 0xb5, 0x30, 0xab, 0xa0, 0xf0, 0x64, 0x63, 0x85, 0x25, 0xc3, 0x55, 0x64, 0xb5,
+	0x53, 0x11, 0x5d, 0xb1, 0xc9, 0xb2, 0x89, 0x07, 0x08, 0x81, 0xe6, 0x90, 0x89, 0xc0, 0x37, 0x6b,
+	0xe9, 0x7f, 0x2d, 0x8e, 0xac, 0x1d, 0xa8, 0x8e, 0x68, 0x52, 0x26, 0x85, 0x91, 0x04, 0xe0, 0x4a,
+	0xc9, 0x54, 0x0a, 0xa8, 0xe7, 0x00, 0xa8, 0x6a, 0x40, 0x3e, 0x82, 0x9f, 0xa1, 0x06, 0x38, 0xeb,
+	0xf9, 0x62, 0xca, 0xcc, 0x06, 0x1c, 0xf3, 0x87, 0x37, 0x1c, 0x73, 0x32, 0xea, 0xb4, 0xe2, 0xc8,
+	0x42, 0x80, 0x1c, 0x25, 0xdf, 0x8b, 0xe4, 0x2c, 0xf8, 0x15, 0xda, 0x9f, 0x06, 0x92, 0x2b, 0x1e,
+	0x88, 0x21, 0x0f, 0x99, 0x97, 0x2c, 0x4c, 0x04, 0xd4, 0x9f, 0xac, 0xa7, 0x3e, 0xb9, 0x0e, 0x71,
+	0xee, 0xc5, 0x91, 0x85, 0x35, 0xd3, 0x88, 0xea, 0x3a, 0x79, 0x93, 0x1d, 0x7f, 0x80, 0xca, 0xd4,
+	0x55, 0xae, 0xb9, 0x03, 0x5f, 0xb8, 0x1e, 0x47, 0x16, 0xec, 0x09, 0xfc, 0xc5, 0x43, 0xb4, 0x9f,
+	0x5a, 0x70, 0xc8, 0xa4, 0x17, 0xf2, 0x29, 0x3c, 0x50, 0x13, 0x46, 0x41, 0x23, 0x6d, 0x8e, 0x68,
+	0xde, 0x25, 0x6f, 0x02, 0x30, 0x43, 0x35, 0x11, 0xf8, 0x5c, 0xb8, 0x13, 0x73, 0x17, 0xb0, 0xdf,
+	0x6e, 0x7c, 0xea, 0x9a, 0xe0, 0x2a, 0xb2, 0x5a, 0xe9, 0xa1, 0x67, 0x05, 0x9b, 0xe8, 0x16, 0xfe,
+	0x0d, 0x35, 0x55, 0xc8, 0xc7, 0x63, 0x16, 0x9e, 0x80, 0x87, 0x5b, 0xa0, 0xf5, 0xe3, 0xc6, 0x5a,
+	0xbb, 0x19, 0xcb, 0x48, 0x7b, 0xf9, 0x20, 0x55, 0x5c, 0x29, 0xdb, 0x64, 0x45, 0x0c, 0x3f, 0x40,
+	0x1a, 0x96, 0xde, 0x65, 0xb3, 0xdd, 0x35, 0x7a, 0x75, 0x07, 0xc7, 0x91, 0xd5, 0xd2, 0xc0, 0xec,
+	0x56, 0xaf, 0x0e, 0xe2, 0x2f, 0x50, 0x93, 0x9d, 0x4d, 0x79, 0xb8, 0xf8, 0x86, 0xf1, 0xf1, 0xa9,
+	0x32, 0xf7, 0x20, 0x51, 0xf6, 0x93, 0x07, 0x49, 0xeb, 0xa3, 0x53, 0x68, 0x90, 0x95, 0x31, 0xfc,
+	0x15, 0x6a, 0xa7, 0xfb, 0xe7, 0xdc, 0x67, 0x52, 0xb9, 0xfe, 0xd4, 0xdc, 0x07, 0xe4, 0x41, 0x72,
+	0xcf, 0x33, 0xa4, 0xd2, 0x3d, 0x72, 0x7d, 0xd8, 0xfe, 0xb7, 0x8c, 0x9a, 0x5f, 0xbb, 0xc2, 0x63,
+	0x93, 0x89, 0x0b, 0xa7, 0x74, 0xaf, 0x90, 0x67, 0xd5, 0x42, 0x96, 0xfd, 0x8c, 0x1a, 0x5c, 0x70,
+	0xc5, 0x5d, 0x15, 0x84, 0x59, 0x9c, 0x0d, 0xd6, 0x9b, 0xb1, 0x48, 0xf9, 0x58, 0xc3, 0x9c, 0xdd,
+	0x38, 0xb2, 0x72, 0x16, 0x92, 0x2f, 0x93, 0x68, 0xf3, 0x42, 0x06, 0xdc, 0xd7, 0xa2, 0x2d, 0x2b,
+	0x13, 0xbd, 0xc0, 0x0f, 0xde, 0x1a, 0x6d, 0x07, 0xff, 0x23, 0xd6, 0x56, 0xc3, 0xa0, 0xb2, 0x69,
+	0x18, 0x54, 0x6f, 0x0e, 0x83, 0xb7, 0xde, 0xdc, 0xda, 0x9d, 0xde, 0xdc, 0x65, 0x56, 0xd7, 0xef,
+	0x24, 0xab, 0x7f, 0x28, 0x64, 0x75, 0x03, 0x14, 0x1e, 0xde, 0x3e, 0xab, 0xf3, 0x60, 0xb6, 0xef,
+	0xa3, 0xe6, 0xb1, 0xa7, 0xf8, 0xaf, 0x0c, 0x12, 0x51, 0xe2, 0xf7, 0xd1, 0x36, 0xa7, 0xd2, 0x34,
+	0xba, 0xdb, 0xbd, 0xb2, 0x53, 0x8b, 0x23, 0x2b, 0xd9, 0x92, 0xe4, 0x8f, 0xfd, 0x67, 0x05, 0x35,
+	0x8e, 0x7d, 0x26, 0xa8, 0xcf, 0x84, 0x5a, 0xeb, 0xcf, 0x82, 0x83, 0x4a, 0x1b, 0x3a, 0x68, 0xfb,
+	0x96, 0x0e, 0x2a, 0x6f, 0xea, 0xa0, 0xca, 0x2d, 0x1d, 0x54, 0x7d, 0x37, 0x0e, 0xaa, 0xdd, 0x89,
+	0x83, 0x5e, 0xa2, 0xba, 0x60, 0xf3, 0x93, 0x82, 0x47, 0x9f, 0x6e, 0xac, 0xd0, 0x10, 0x6c, 0xbe,
+	0xcc, 0xe1, 0xbd, 0x2c, 0xf9, 0x75, 0xc9, 0x26, 0x4b, 0x01, 0x3c, 0x47, 0x3b, 0x82, 0xcd, 0x9f,
+	0xad, 0x3a, 0xf6, 0xfb, 0x8d, 0xf5, 0x9a, 0x09, 0x79, 0xe1, 0x0d, 0xe3, 0xbd, 0x5c, 0x32, 0x7f,
+	0xcb, 0x28, 0x2a, 0xe1, 0x4f, 0x11, 0x12, 0x6c, 0x0e, 0x66, 0x7e, 0x4c, 0xe1, 0xc7, 0xba, 0xec,
+	0xec, 0x69, 0xa6, 0xf4, 0x67, 0x9e, 0x53, 0x52, 0x98, 0x71, 0x1e, 0x9d, 0x5f, 0x74, 0x8c, 0xd7,
+	0x17, 0x1d, 0xe3, 0x9f, 0x8b, 0x8e, 0xf1, 0xfb, 0x65, 0x67, 0xeb, 0xf5, 0x65, 0x67, 0xeb, 0xaf,
+	0xcb, 0xce, 0xd6, 0x4f, 0x47, 0x85, 0xe7, 0x94, 0x8c, 0x1f, 0xe9, 0x33, 0x87, 0x0d, 0x1c, 0xfa,
+	0xe0, 0x6c, 0x90, 0xbc, 0x91, 0xc2, 0x23, 0xbf, 0xa8, 0x42, 0xff, 0xf3, 0xff, 0x06, 0x00, 0xb4,
+	0x86, 0x8d, 0x9f, 0xe6, 0x0a, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -368,6 +498,20 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.TriggerStatus {
 		i--
 		if m.TriggerStatus {
@@ -503,6 +647,16 @@ func (m *Cancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.Price.Size()
 		i -= size
@@ -600,6 +754,102 @@ func (m *ActiveOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Amendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Amendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Amendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewOrderId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.NewOrderId))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.NewQuantity.Size()
+		i -= size
+		if _, err := m.NewQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.NewPrice.Size()
+		i -= size
+		if _, err := m.NewPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.PositionDirection != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PositionDirection))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOrder(v)
 	base := offset
@@ -664,6 +914,12 @@ func (m *Order) Size() (n int) {
 	if m.TriggerStatus {
 		n += 2
 	}
+	if m.ExpiryHeight != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryTimestamp))
+	}
 	return n
 }
 
@@ -700,6 +956,8 @@ func (m *Cancellation) Size() (n int) {
 	}
 	l = m.Price.Size()
 	n += 1 + l + sovOrder(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
 	return n
 }
 
@@ -719,6 +977,46 @@ func (m *ActiveOrders) Size() (n int) {
 	return n
 }
 
+func (m *Amendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.PositionDirection != 0 {
+		n += 1 + sovOrder(uint64(m.PositionDirection))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.NewPrice.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.NewQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.NewOrderId != 0 {
+		n += 1 + sovOrder(uint64(m.NewOrderId))
+	}
+	return n
+}
+
 func sovOrder(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1178,6 +1476,44 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TriggerStatus = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1447,21 +1783,55 @@ func (m *Cancellation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrder(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOrder
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1594,6 +1964,343 @@ func (m *ActiveOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Amendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDirection", wireType)
+			}
+			m.PositionDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionDirection |= PositionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOrderId", wireType)
+			}
+			m.NewOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOrder(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,9 +1,25 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type SudoOrderCancellationMsg struct {
 	OrderCancellations OrderCancellationMsgDetails `json:"bulk_order_cancellations"`
 }
 
 type OrderCancellationMsgDetails struct {
-	IdsToCancel []uint64 `json:"ids"`
+	IdsToCancel          []uint64              `json:"ids"`
+	PartialCancellations []PartialCancellation `json:"partial_cancellations,omitempty"`
+}
+
+// Reduction of an order that stays on the book with a smaller quantity.
+type PartialCancellation struct {
+	ID       uint64  `json:"id"`
+	Quantity sdk.Dec `json:"quantity"`
+}
+
+// Whether the cancellation only removes part of the order's quantity.
+func (c *Cancellation) IsPartial() bool {
+	return !c.Quantity.IsNil() && c.Quantity.IsPositive()
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/utils"
@@ -18,6 +20,10 @@ type CachedSortedOrderBookEntries struct {
 	CachedEntries  []OrderBookEntry
 	currentPtr     int
 	currentChanged bool
+	// prices of the entries that have been written back to the store
+	flushedPrices []sdk.Dec
+	// how fills are split across the allocations of an entry by `AllocateQuantity`
+	allocationRule AllocationRule
 
 	loader  func(ctx sdk.Context, startingPriceExclusive sdk.Dec, withLimit bool) []OrderBookEntry
 	setter  func(sdk.Context, OrderBookEntry)
@@ -95,6 +101,116 @@ func (c *CachedSortedOrderBookEntries) SettleQuantity(_ sdk.Context, quantity sd
 	return res, settled
 }
 
+// AllocationRule determines how a fill against a single order book entry is split
+// across the entry's allocations.
+type AllocationRule struct {
+	Algorithm     MatchingAlgorithm
+	MinAllocation sdk.Dec
+	Remainder     ProRataRemainderRule
+	// pro-rata shares are rounded down to a multiple of the lot size, if positive
+	LotSize sdk.Dec
+}
+
+func AllocationRuleForPair(pair Pair) AllocationRule {
+	rule := AllocationRule{
+		Algorithm:     pair.MatchingAlgorithm,
+		MinAllocation: sdk.ZeroDec(),
+		Remainder:     pair.ProRataRemainderRule,
+		LotSize:       sdk.ZeroDec(),
+	}
+	if pair.ProRataMinAllocation != nil {
+		rule.MinAllocation = *pair.ProRataMinAllocation
+	}
+	if pair.QuantityTicksize != nil {
+		rule.LotSize = *pair.QuantityTicksize
+	}
+	return rule
+}
+
+// Sets the rule used by `AllocateQuantity` to split fills across allocations. Entries
+// default to price-time priority.
+func (c *CachedSortedOrderBookEntries) SetAllocationRule(rule AllocationRule) {
+	c.allocationRule = rule
+}
+
+// Same as `SettleQuantity`, except that the allocations of the order book entry are
+// reduced according to the allocation rule of the entries instead of always in FIFO
+// order.
+func (c *CachedSortedOrderBookEntries) AllocateQuantity(ctx sdk.Context, quantity sdk.Dec) (res []ToSettle, settled sdk.Dec) {
+	if c.allocationRule.Algorithm != MatchingAlgorithm_PRO_RATA || quantity.IsZero() {
+		return c.SettleQuantity(ctx, quantity)
+	}
+	currentEntry := c.CachedEntries[c.currentPtr].GetOrderEntry()
+	total := sdk.ZeroDec()
+	for _, a := range currentEntry.Allocations {
+		total = total.Add(a.Quantity)
+	}
+	if quantity.GTE(currentEntry.Quantity) || quantity.GTE(total) {
+		return c.SettleQuantity(ctx, quantity)
+	}
+	c.currentChanged = true
+
+	fills := proRataFills(currentEntry.Allocations, quantity, total, c.allocationRule)
+	remaining := []*Allocation{}
+	for idx, a := range currentEntry.Allocations {
+		if fills[idx].IsPositive() {
+			res = append(res, ToSettle{
+				OrderID: a.OrderId,
+				Account: a.Account,
+				Amount:  fills[idx],
+			})
+			a.Quantity = a.Quantity.Sub(fills[idx])
+		}
+		if a.Quantity.IsPositive() {
+			remaining = append(remaining, a)
+		}
+	}
+	currentEntry.Quantity = currentEntry.Quantity.Sub(quantity)
+	currentEntry.Allocations = remaining
+	return res, quantity
+}
+
+// Splits `quantity` across `allocations` (whose quantities sum up to `total`, which
+// must be greater than `quantity`) proportionally to their quantities. Shares are
+// rounded down to the lot size, and shares below the minimum allocation are dropped.
+// Whatever is left after the proportional pass is handed out according to the
+// remainder rule, capped by each allocation's unfilled quantity.
+func proRataFills(allocations []*Allocation, quantity sdk.Dec, total sdk.Dec, rule AllocationRule) []sdk.Dec {
+	fills := make([]sdk.Dec, len(allocations))
+	allocated := sdk.ZeroDec()
+	for idx, a := range allocations {
+		share := quantity.MulTruncate(a.Quantity).QuoTruncate(total)
+		if rule.LotSize.IsPositive() {
+			share = share.Quo(rule.LotSize).TruncateDec().Mul(rule.LotSize)
+		}
+		if share.LT(rule.MinAllocation) {
+			share = sdk.ZeroDec()
+		}
+		fills[idx] = share
+		allocated = allocated.Add(share)
+	}
+
+	order := make([]int, len(allocations))
+	for idx := range order {
+		order[idx] = idx
+	}
+	if rule.Remainder == ProRataRemainderRule_REMAINDER_LARGEST_ALLOCATION {
+		sort.SliceStable(order, func(i, j int) bool {
+			return allocations[order[i]].Quantity.GT(allocations[order[j]].Quantity)
+		})
+	}
+	remainder := quantity.Sub(allocated)
+	for _, idx := range order {
+		if !remainder.IsPositive() {
+			break
+		}
+		extra := sdk.MinDec(remainder, allocations[idx].Quantity.Sub(fills[idx]))
+		fills[idx] = fills[idx].Add(extra)
+		remainder = remainder.Sub(extra)
+	}
+	return fills
+}
+
 // Discard all dirty changes and reload
 func (c *CachedSortedOrderBookEntries) Refresh(ctx sdk.Context) {
 	c.CachedEntries = c.loader(ctx, sdk.ZeroDec(), false)
@@ -112,6 +228,7 @@ func (c *CachedSortedOrderBookEntries) Flush(ctx sdk.Context) {
 			break
 		}
 		entry := c.CachedEntries[i]
+		c.flushedPrices = append(c.flushedPrices, entry.GetPrice())
 		if entry.GetOrderEntry().Quantity.IsZero() {
 			c.deleter(ctx, entry)
 		} else {
@@ -123,6 +240,35 @@ func (c *CachedSortedOrderBookEntries) Flush(ctx sdk.Context) {
 	c.currentChanged = false
 }
 
+// FlushedPrices returns the prices of all entries that have been written back to the
+// store (updated or deleted) by `Flush`, possibly with duplicates.
+func (c *CachedSortedOrderBookEntries) FlushedPrices() []sdk.Dec {
+	return c.flushedPrices
+}
+
+// Peek returns the non-empty entries from the current one onward for as long as `cond`
+// holds, loading more entries as needed. The current entry is left unchanged, so the
+// returned entries can be settled afterwards with `Next` and `AllocateQuantity`.
+func (c *CachedSortedOrderBookEntries) Peek(ctx sdk.Context, cond func(OrderBookEntry) bool) []OrderBookEntry {
+	res := []OrderBookEntry{}
+	for i := c.currentPtr; ; i++ {
+		if i >= len(c.CachedEntries) {
+			c.load(ctx)
+			if i >= len(c.CachedEntries) {
+				return res
+			}
+		}
+		entry := c.CachedEntries[i]
+		if entry.GetOrderEntry().Quantity.IsZero() {
+			continue
+		}
+		if !cond(entry) {
+			return res
+		}
+		res = append(res, entry)
+	}
+}
+
 // Next will only move on to the next order if the current order quantity hits zero.
 // So it should not be used for read-only iteration
 func (c *CachedSortedOrderBookEntries) Next(ctx sdk.Context) OrderBookEntry {
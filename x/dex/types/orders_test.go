@@ -164,3 +164,134 @@ func TestRefreshAndFlush(t *testing.T) {
 	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
 	require.Equal(t, TestEntryOne, *entry.GetOrderEntry())
 }
+
+func setProRataEntry(ctx sdk.Context, keeper *keeper.Keeper, quantities ...int64) {
+	entry := types.OrderEntry{
+		Price:      sdk.MustNewDecFromStr("10"),
+		Quantity:   sdk.ZeroDec(),
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+	}
+	for i, quantity := range quantities {
+		entry.Quantity = entry.Quantity.Add(sdk.NewDec(quantity))
+		entry.Allocations = append(entry.Allocations, &types.Allocation{
+			Quantity: sdk.NewDec(quantity),
+			Account:  "abc",
+			OrderId:  uint64(i + 1),
+		})
+	}
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: entry.Price, Entry: &entry})
+}
+
+func TestAllocateQuantityProRata(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		quantities []int64
+		rule       types.AllocationRule
+		toAllocate int64
+		expected   []types.ToSettle
+		remaining  []int64
+	}{
+		{
+			name:       "proportional",
+			quantities: []int64{2, 6, 2},
+			rule:       types.AllocationRule{Algorithm: types.MatchingAlgorithm_PRO_RATA, MinAllocation: sdk.ZeroDec(), LotSize: sdk.OneDec()},
+			toAllocate: 5,
+			expected: []types.ToSettle{
+				{OrderID: 1, Account: "abc", Amount: sdk.NewDec(1)},
+				{OrderID: 2, Account: "abc", Amount: sdk.NewDec(3)},
+				{OrderID: 3, Account: "abc", Amount: sdk.NewDec(1)},
+			},
+			remaining: []int64{1, 3, 1},
+		},
+		{
+			name:       "remainder by time priority",
+			quantities: []int64{3, 3, 4},
+			rule:       types.AllocationRule{Algorithm: types.MatchingAlgorithm_PRO_RATA, MinAllocation: sdk.ZeroDec(), LotSize: sdk.OneDec()},
+			toAllocate: 5,
+			// shares are 1.5, 1.5 and 2, rounded down to 1, 1 and 2
+			expected: []types.ToSettle{
+				{OrderID: 1, Account: "abc", Amount: sdk.NewDec(2)},
+				{OrderID: 2, Account: "abc", Amount: sdk.NewDec(1)},
+				{OrderID: 3, Account: "abc", Amount: sdk.NewDec(2)},
+			},
+			remaining: []int64{1, 2, 2},
+		},
+		{
+			name:       "remainder by largest allocation",
+			quantities: []int64{3, 3, 4},
+			rule: types.AllocationRule{
+				Algorithm:     types.MatchingAlgorithm_PRO_RATA,
+				MinAllocation: sdk.ZeroDec(),
+				Remainder:     types.ProRataRemainderRule_REMAINDER_LARGEST_ALLOCATION,
+				LotSize:       sdk.OneDec(),
+			},
+			toAllocate: 5,
+			expected: []types.ToSettle{
+				{OrderID: 1, Account: "abc", Amount: sdk.NewDec(1)},
+				{OrderID: 2, Account: "abc", Amount: sdk.NewDec(1)},
+				{OrderID: 3, Account: "abc", Amount: sdk.NewDec(3)},
+			},
+			remaining: []int64{2, 2, 1},
+		},
+		{
+			name:       "minimum allocation",
+			quantities: []int64{1, 9},
+			rule:       types.AllocationRule{Algorithm: types.MatchingAlgorithm_PRO_RATA, MinAllocation: sdk.NewDec(2), LotSize: sdk.OneDec()},
+			toAllocate: 5,
+			// the share of 0.5 of the first order is below the minimum, so the second
+			// order gets 4 in the proportional pass and the first one gets the remainder
+			expected: []types.ToSettle{
+				{OrderID: 1, Account: "abc", Amount: sdk.NewDec(1)},
+				{OrderID: 2, Account: "abc", Amount: sdk.NewDec(4)},
+			},
+			remaining: []int64{5},
+		},
+		{
+			name:       "price-time",
+			quantities: []int64{3, 3, 4},
+			rule:       types.AllocationRule{Algorithm: types.MatchingAlgorithm_PRICE_TIME},
+			toAllocate: 5,
+			expected: []types.ToSettle{
+				{OrderID: 1, Account: "abc", Amount: sdk.NewDec(3)},
+				{OrderID: 2, Account: "abc", Amount: sdk.NewDec(2)},
+			},
+			remaining: []int64{1, 4},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dexkeeper, ctx := keepertest.DexKeeper(t)
+			setProRataEntry(ctx, dexkeeper, tc.quantities...)
+			cache := getCachedSortedOrderBookEntries(dexkeeper)
+			cache.SetAllocationRule(tc.rule)
+			require.NotNil(t, cache.Next(ctx))
+			res, settled := cache.AllocateQuantity(ctx, sdk.NewDec(tc.toAllocate))
+			require.Equal(t, tc.expected, res)
+			require.Equal(t, sdk.NewDec(tc.toAllocate), settled)
+
+			entry := cache.Next(ctx).GetOrderEntry()
+			remaining := sdk.ZeroDec()
+			require.Equal(t, len(tc.remaining), len(entry.Allocations))
+			for i, allocation := range entry.Allocations {
+				require.Equal(t, sdk.NewDec(tc.remaining[i]), allocation.Quantity)
+				remaining = remaining.Add(allocation.Quantity)
+			}
+			require.Equal(t, remaining, entry.Quantity)
+		})
+	}
+}
+
+func TestAllocateQuantityProRataFullLevel(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	setProRataEntry(ctx, dexkeeper, 3, 7)
+	cache := getCachedSortedOrderBookEntries(dexkeeper)
+	cache.SetAllocationRule(types.AllocationRuleForPair(types.Pair{MatchingAlgorithm: types.MatchingAlgorithm_PRO_RATA}))
+	require.NotNil(t, cache.Next(ctx))
+	res, settled := cache.AllocateQuantity(ctx, sdk.NewDec(20))
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(3)},
+		{OrderID: 2, Account: "abc", Amount: sdk.NewDec(7)},
+	}, res)
+	require.Equal(t, sdk.NewDec(10), settled)
+	require.Nil(t, cache.Next(ctx))
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ValidateMatchingAlgorithm checks that the matching algorithm settings of the pair
+// are well formed and compatible with its self-trade prevention mode.
+func (p Pair) ValidateMatchingAlgorithm() error {
+	if _, ok := MatchingAlgorithm_name[int32(p.MatchingAlgorithm)]; !ok {
+		return fmt.Errorf("invalid matching algorithm %d", p.MatchingAlgorithm)
+	}
+	if _, ok := ProRataRemainderRule_name[int32(p.ProRataRemainderRule)]; !ok {
+		return fmt.Errorf("invalid pro-rata remainder rule %d", p.ProRataRemainderRule)
+	}
+	if _, ok := MatchingMode_name[int32(p.MatchingMode)]; !ok {
+		return fmt.Errorf("invalid matching mode %d", p.MatchingMode)
+	}
+	if p.ProRataMinAllocation != nil && p.ProRataMinAllocation.IsNegative() {
+		return fmt.Errorf("pro-rata minimum allocation cannot be negative")
+	}
+	// self-trade prevention finds the conflicting allocation by walking the price
+	// level in insertion order, which pro-rata allocation does not follow
+	if p.MatchingAlgorithm == MatchingAlgorithm_PRO_RATA && p.SelfTradePreventionMode != SelfTradePreventionMode_NO_PREVENTION {
+		return fmt.Errorf("pro-rata matching cannot be combined with self-trade prevention mode %s", p.SelfTradePreventionMode)
+	}
+	// all crossing orders of a batch auction clear at once, so there is no taker
+	// whose remainder could be cancelled
+	if p.MatchingMode == MatchingMode_BATCH_AUCTION && p.SelfTradePreventionMode != SelfTradePreventionMode_NO_PREVENTION {
+		return fmt.Errorf("batch auction matching cannot be combined with self-trade prevention mode %s", p.SelfTradePreventionMode)
+	}
+	return nil
+}
@@ -25,10 +25,21 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Pair struct {
-	PriceDenom       string                                  `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"price_denom"`
-	AssetDenom       string                                  `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
-	PriceTicksize    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_tick_size"`
-	QuantityTicksize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantityTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity_tick_size"`
+	PriceDenom              string                                  `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom              string                                  `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
+	PriceTicksize           *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_tick_size"`
+	QuantityTicksize        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantityTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity_tick_size"`
+	SelfTradePreventionMode SelfTradePreventionMode                 `protobuf:"varint,5,opt,name=selfTradePreventionMode,proto3,enum=seiprotocol.seichain.dex.SelfTradePreventionMode" json:"self_trade_prevention_mode"`
+	MatchingAlgorithm       MatchingAlgorithm                       `protobuf:"varint,6,opt,name=matchingAlgorithm,proto3,enum=seiprotocol.seichain.dex.MatchingAlgorithm" json:"matching_algorithm"`
+	// pro-rata shares smaller than this are not allocated in the proportional pass
+	ProRataMinAllocation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=proRataMinAllocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pro_rata_min_allocation"`
+	ProRataRemainderRule ProRataRemainderRule                    `protobuf:"varint,8,opt,name=proRataRemainderRule,proto3,enum=seiprotocol.seichain.dex.ProRataRemainderRule" json:"pro_rata_remainder_rule"`
+	// a halted pair only accepts cancellations and is not matched
+	Halted       bool         `protobuf:"varint,9,opt,name=halted,proto3" json:"halted"`
+	MatchingMode MatchingMode `protobuf:"varint,10,opt,name=matchingMode,proto3,enum=seiprotocol.seichain.dex.MatchingMode" json:"matching_mode"`
+	// stop orders of the pair are triggered by the oracle price of the asset in
+	// the price denom instead of the last traded price
+	TriggerOnOraclePrice bool `protobuf:"varint,11,opt,name=triggerOnOraclePrice,proto3" json:"trigger_on_oracle_price"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -78,6 +89,48 @@ func (m *Pair) GetAssetDenom() string {
 	return ""
 }
 
+func (m *Pair) GetSelfTradePreventionMode() SelfTradePreventionMode {
+	if m != nil {
+		return m.SelfTradePreventionMode
+	}
+	return SelfTradePreventionMode_NO_PREVENTION
+}
+
+func (m *Pair) GetMatchingAlgorithm() MatchingAlgorithm {
+	if m != nil {
+		return m.MatchingAlgorithm
+	}
+	return MatchingAlgorithm_PRICE_TIME
+}
+
+func (m *Pair) GetProRataRemainderRule() ProRataRemainderRule {
+	if m != nil {
+		return m.ProRataRemainderRule
+	}
+	return ProRataRemainderRule_REMAINDER_TIME_PRIORITY
+}
+
+func (m *Pair) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *Pair) GetMatchingMode() MatchingMode {
+	if m != nil {
+		return m.MatchingMode
+	}
+	return MatchingMode_CONTINUOUS
+}
+
+func (m *Pair) GetTriggerOnOraclePrice() bool {
+	if m != nil {
+		return m.TriggerOnOraclePrice
+	}
+	return false
+}
+
 type BatchContractPair struct {
 	ContractAddr string  `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
 	Pairs        []*Pair `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs"`
@@ -138,30 +191,47 @@ func init() {
 func init() { proto.RegisterFile("dex/pair.proto", fileDescriptor_d4350ebee878f69a) }
 
 var fileDescriptor_d4350ebee878f69a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x4f, 0xf2, 0x40,
-	0x18, 0xa7, 0xc0, 0xfb, 0x26, 0x1c, 0x22, 0xd2, 0x38, 0x34, 0x0e, 0x2d, 0x61, 0x30, 0x2c, 0xf4,
-	0x12, 0x8d, 0xb3, 0xa1, 0x92, 0xb8, 0x9a, 0xc6, 0xc9, 0x85, 0x1c, 0x77, 0x97, 0x72, 0x41, 0x7a,
-	0xf5, 0xee, 0x48, 0xc0, 0xaf, 0xe0, 0xe2, 0x77, 0x72, 0x61, 0x64, 0x34, 0x0e, 0x8d, 0x81, 0xad,
-	0x9f, 0xc2, 0xdc, 0x95, 0x0a, 0xc4, 0x38, 0x38, 0xdd, 0x3d, 0xbf, 0xe7, 0xf7, 0xa7, 0x7d, 0x9e,
-	0x03, 0xc7, 0x84, 0xce, 0x61, 0x82, 0x98, 0xf0, 0x13, 0xc1, 0x15, 0xb7, 0x1d, 0x49, 0x99, 0xb9,
-	0x61, 0xfe, 0xe8, 0x4b, 0xca, 0xf0, 0x18, 0xb1, 0xd8, 0x27, 0x74, 0x7e, 0x76, 0x1a, 0xf1, 0x88,
-	0x9b, 0x16, 0xd4, 0xb7, 0x9c, 0xdf, 0x79, 0x2b, 0x83, 0xea, 0x1d, 0x62, 0xc2, 0x86, 0x00, 0x24,
-	0x82, 0x61, 0x3a, 0xa0, 0x31, 0x9f, 0x3a, 0x56, 0xdb, 0xea, 0xd6, 0x82, 0x66, 0x96, 0x7a, 0x75,
-	0x83, 0x0e, 0x89, 0x86, 0xc3, 0x3d, 0x8a, 0x16, 0x20, 0x29, 0xa9, 0xca, 0x05, 0xe5, 0x9d, 0xc0,
-	0xa0, 0x85, 0x60, 0x47, 0xb1, 0x23, 0xd0, 0x30, 0xf2, 0x7b, 0x86, 0x27, 0x92, 0x3d, 0x53, 0xa7,
-	0x62, 0x34, 0xfd, 0x65, 0xea, 0x59, 0x1f, 0xa9, 0x77, 0x1e, 0x31, 0x35, 0x9e, 0x8d, 0x7c, 0xcc,
-	0xa7, 0x10, 0x73, 0x39, 0xe5, 0x72, 0x7b, 0xf4, 0x24, 0x99, 0x40, 0xb5, 0x48, 0xa8, 0xf4, 0x07,
-	0x14, 0x67, 0xa9, 0xd7, 0xcc, 0x3f, 0x49, 0x31, 0x3c, 0x19, 0x6a, 0xa3, 0xf0, 0xd0, 0xd7, 0x4e,
-	0xc0, 0xc9, 0xd3, 0x0c, 0xc5, 0x8a, 0xa9, 0xc5, 0x77, 0x56, 0xd5, 0x64, 0x0d, 0xfe, 0x9c, 0x65,
-	0x17, 0x4e, 0x7b, 0x71, 0x3f, 0xdc, 0x3b, 0x2f, 0x16, 0x68, 0x05, 0x48, 0xe1, 0xf1, 0x0d, 0x8f,
-	0x95, 0x40, 0x58, 0x99, 0x91, 0x5e, 0x81, 0x23, 0xbc, 0xad, 0xfb, 0x84, 0x88, 0xed, 0x50, 0x5b,
-	0x59, 0xea, 0x35, 0x0a, 0x7c, 0x88, 0x08, 0x11, 0xe1, 0x01, 0xcd, 0xbe, 0x06, 0xff, 0xf4, 0x42,
-	0xa5, 0x53, 0x6e, 0x57, 0xba, 0xf5, 0x0b, 0xd7, 0xff, 0x6d, 0xa5, 0xbe, 0x4e, 0x09, 0x6a, 0x59,
-	0xea, 0xe5, 0x82, 0x30, 0x3f, 0x82, 0xdb, 0xe5, 0xda, 0xb5, 0x56, 0x6b, 0xd7, 0xfa, 0x5c, 0xbb,
-	0xd6, 0xeb, 0xc6, 0x2d, 0xad, 0x36, 0x6e, 0xe9, 0x7d, 0xe3, 0x96, 0x1e, 0x7a, 0x7b, 0xff, 0x2d,
-	0x29, 0xeb, 0x15, 0xb6, 0xa6, 0x30, 0xbe, 0x70, 0x0e, 0xf5, 0x8b, 0x32, 0x23, 0x18, 0xfd, 0x37,
-	0xfd, 0xcb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x03, 0x32, 0x50, 0x65, 0x02, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4b, 0x1c, 0x3f,
+	0x18, 0x77, 0x7c, 0xfb, 0x6b, 0x7c, 0xfb, 0x3b, 0x48, 0x1d, 0x2c, 0xcc, 0x2c, 0x1e, 0x64, 0xa1,
+	0x38, 0x43, 0x2d, 0x3d, 0x97, 0x9d, 0x0a, 0xa5, 0x07, 0x71, 0x49, 0x3d, 0x15, 0x4a, 0x88, 0x49,
+	0x3a, 0x1b, 0x9c, 0x49, 0xc6, 0x24, 0x5b, 0xb4, 0x50, 0xfa, 0x01, 0xbc, 0xf4, 0xd8, 0x8f, 0xe4,
+	0xd1, 0x63, 0xe9, 0x61, 0x28, 0x7a, 0xdb, 0x4f, 0x51, 0x92, 0xdd, 0x59, 0x77, 0xeb, 0x6e, 0x8b,
+	0xa7, 0xcc, 0x3c, 0xcf, 0xf3, 0x7b, 0x49, 0xf2, 0xe4, 0x01, 0xeb, 0x94, 0x5d, 0x24, 0x25, 0xe6,
+	0x2a, 0x2e, 0x95, 0x34, 0xd2, 0x0f, 0x34, 0xe3, 0xee, 0x8b, 0xc8, 0x3c, 0xd6, 0x8c, 0x93, 0x0e,
+	0xe6, 0x22, 0xa6, 0xec, 0x62, 0x67, 0x2b, 0x93, 0x99, 0x74, 0xa9, 0xc4, 0x7e, 0xf5, 0xeb, 0x77,
+	0x36, 0x2c, 0x9e, 0x89, 0x6e, 0xa1, 0xfb, 0x81, 0xdd, 0xef, 0x4b, 0x60, 0xbe, 0x8d, 0xb9, 0xf2,
+	0x13, 0x00, 0x4a, 0xc5, 0x09, 0x3b, 0x64, 0x42, 0x16, 0x81, 0xd7, 0xf0, 0x9a, 0xcb, 0xe9, 0x46,
+	0xaf, 0x8a, 0x56, 0x5c, 0x14, 0x51, 0x1b, 0x86, 0x23, 0x25, 0x16, 0x80, 0xb5, 0x66, 0xa6, 0x0f,
+	0x98, 0xbd, 0x07, 0xb8, 0x68, 0x0d, 0xb8, 0x2f, 0xf1, 0x33, 0xb0, 0xe6, 0xe0, 0x27, 0x9c, 0x9c,
+	0x69, 0xfe, 0x99, 0x05, 0x73, 0x0e, 0xd3, 0xba, 0xae, 0x22, 0xef, 0x67, 0x15, 0xed, 0x65, 0xdc,
+	0x74, 0xba, 0xa7, 0x31, 0x91, 0x45, 0x42, 0xa4, 0x2e, 0xa4, 0x1e, 0x2c, 0xfb, 0x9a, 0x9e, 0x25,
+	0xe6, 0xb2, 0x64, 0x3a, 0x3e, 0x64, 0xa4, 0x57, 0x45, 0x1b, 0x7d, 0x4b, 0x86, 0x93, 0x33, 0x64,
+	0x89, 0xe0, 0x38, 0xaf, 0x5f, 0x82, 0xff, 0xcf, 0xbb, 0x58, 0x18, 0x6e, 0x2e, 0x87, 0x5a, 0xf3,
+	0x4e, 0xeb, 0xf0, 0xd1, 0x5a, 0x7e, 0xcd, 0x34, 0x22, 0xf7, 0x80, 0xdd, 0xbf, 0xf2, 0xc0, 0xb6,
+	0x66, 0xf9, 0xc7, 0x13, 0x85, 0x29, 0x6b, 0x2b, 0xf6, 0x89, 0x09, 0xc3, 0xa5, 0x38, 0x92, 0x94,
+	0x05, 0x0b, 0x0d, 0xaf, 0xb9, 0x7e, 0xf0, 0x3c, 0x9e, 0x76, 0x53, 0xf1, 0xbb, 0xc9, 0xc0, 0x34,
+	0xec, 0x55, 0xd1, 0x8e, 0x65, 0x45, 0xc6, 0x66, 0x51, 0x39, 0x4c, 0xa3, 0x42, 0x52, 0x06, 0xa7,
+	0x29, 0xfa, 0xe7, 0x60, 0xb3, 0xc0, 0x86, 0x74, 0xb8, 0xc8, 0x5a, 0x79, 0x26, 0x15, 0x37, 0x9d,
+	0x22, 0x58, 0x74, 0x36, 0x9e, 0x4d, 0xb7, 0x71, 0xf4, 0x27, 0x24, 0x7d, 0x62, 0xf7, 0x5f, 0x33,
+	0x21, 0x5c, 0xc7, 0xe1, 0x43, 0x76, 0xff, 0x0b, 0xd8, 0x2a, 0x95, 0x84, 0xd8, 0xe0, 0x23, 0x2e,
+	0x5a, 0x79, 0x2e, 0x09, 0xb6, 0x76, 0x82, 0xff, 0xdc, 0xb1, 0xbf, 0x7d, 0xf4, 0xb1, 0x6f, 0x97,
+	0x4a, 0x22, 0x85, 0x0d, 0x46, 0x05, 0x17, 0x08, 0x0f, 0x09, 0xe1, 0x44, 0x19, 0xff, 0xeb, 0x50,
+	0x1e, 0xb2, 0x02, 0x73, 0x41, 0x99, 0x82, 0xdd, 0x9c, 0x05, 0x4b, 0x6e, 0xd3, 0xf1, 0xf4, 0x4d,
+	0xb7, 0x27, 0xa0, 0xd2, 0xa7, 0x63, 0x06, 0x54, 0x9d, 0x43, 0xaa, 0x9b, 0x33, 0x38, 0x51, 0xc8,
+	0xdf, 0x05, 0x8b, 0x1d, 0x9c, 0x1b, 0x46, 0x83, 0xe5, 0x86, 0xd7, 0x5c, 0x4a, 0x41, 0xaf, 0x8a,
+	0x06, 0x11, 0x38, 0x58, 0xfd, 0x0f, 0x60, 0xb5, 0x3e, 0x38, 0xd7, 0x18, 0xc0, 0x99, 0xdb, 0xfb,
+	0xf7, 0x8d, 0xb8, 0x6e, 0xd8, 0xec, 0x55, 0xd1, 0xda, 0xf0, 0x32, 0x5c, 0x03, 0x8c, 0xd1, 0xf9,
+	0xc7, 0x60, 0xcb, 0x28, 0x9e, 0x65, 0x4c, 0x1d, 0x8b, 0x63, 0x85, 0x49, 0xce, 0xda, 0xf6, 0x55,
+	0x04, 0x2b, 0xce, 0x90, 0xdb, 0xd3, 0x20, 0x8f, 0xa4, 0x40, 0xd2, 0x55, 0x20, 0xf7, 0x70, 0xe0,
+	0x44, 0xe0, 0xee, 0x95, 0x07, 0x36, 0x53, 0xab, 0xf0, 0x5a, 0x0a, 0xa3, 0x30, 0x31, 0x6e, 0x4e,
+	0xbc, 0x04, 0xab, 0x64, 0xf0, 0xdf, 0xa2, 0x54, 0x0d, 0x26, 0x85, 0x73, 0x57, 0xc7, 0x11, 0xa6,
+	0x54, 0xc1, 0xb1, 0x32, 0xff, 0x15, 0x58, 0xb0, 0x63, 0x4b, 0x07, 0xb3, 0x8d, 0xb9, 0xe6, 0xca,
+	0x41, 0xf8, 0x97, 0x2b, 0xc1, 0x5c, 0xa5, 0xcb, 0xbd, 0x2a, 0xea, 0x03, 0x60, 0x7f, 0x49, 0xdf,
+	0x5c, 0xdf, 0x86, 0xde, 0xcd, 0x6d, 0xe8, 0xfd, 0xba, 0x0d, 0xbd, 0x6f, 0x77, 0xe1, 0xcc, 0xcd,
+	0x5d, 0x38, 0xf3, 0xe3, 0x2e, 0x9c, 0x79, 0xbf, 0x3f, 0xd2, 0x55, 0x9a, 0xf1, 0xfd, 0x9a, 0xd6,
+	0xfd, 0x38, 0xde, 0xe4, 0x22, 0xb1, 0x73, 0xcf, 0x35, 0xd8, 0xe9, 0xa2, 0xcb, 0xbf, 0xf8, 0x3d,
+	0x00, 0x98, 0xdc, 0x2b, 0xde, 0x4b, 0x05, 0x00, 0x00,
 }
 
 func (m *Pair) Marshal() (dAtA []byte, err error) {
@@ -184,6 +254,58 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TriggerOnOraclePrice {
+		i--
+		if m.TriggerOnOraclePrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MatchingMode != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.MatchingMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ProRataRemainderRule != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.ProRataRemainderRule))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ProRataMinAllocation != nil {
+		{
+			size := m.ProRataMinAllocation.Size()
+			i -= size
+			if _, err := m.ProRataMinAllocation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MatchingAlgorithm != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.MatchingAlgorithm))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SelfTradePreventionMode != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.SelfTradePreventionMode))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.QuantityTicksize != nil {
 		{
 			size := m.QuantityTicksize.Size()
@@ -302,6 +424,28 @@ func (m *Pair) Size() (n int) {
 		l = m.QuantityTicksize.Size()
 		n += 1 + l + sovPair(uint64(l))
 	}
+	if m.SelfTradePreventionMode != 0 {
+		n += 1 + sovPair(uint64(m.SelfTradePreventionMode))
+	}
+	if m.MatchingAlgorithm != 0 {
+		n += 1 + sovPair(uint64(m.MatchingAlgorithm))
+	}
+	if m.ProRataMinAllocation != nil {
+		l = m.ProRataMinAllocation.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.ProRataRemainderRule != 0 {
+		n += 1 + sovPair(uint64(m.ProRataRemainderRule))
+	}
+	if m.Halted {
+		n += 2
+	}
+	if m.MatchingMode != 0 {
+		n += 1 + sovPair(uint64(m.MatchingMode))
+	}
+	if m.TriggerOnOraclePrice {
+		n += 2
+	}
 	return n
 }
 
@@ -495,6 +639,158 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePreventionMode", wireType)
+			}
+			m.SelfTradePreventionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePreventionMode |= SelfTradePreventionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingAlgorithm", wireType)
+			}
+			m.MatchingAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingAlgorithm |= MatchingAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProRataMinAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ProRataMinAllocation = &v
+			if err := m.ProRataMinAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProRataRemainderRule", wireType)
+			}
+			m.ProRataRemainderRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProRataRemainderRule |= ProRataRemainderRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingMode", wireType)
+			}
+			m.MatchingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingMode |= MatchingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOnOraclePrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TriggerOnOraclePrice = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPair(dAtA[iNdEx:])
@@ -23,6 +23,10 @@ var (
 	KeyMaxOrderPerPrice           = []byte("KeyMaxOrderPerPrice")
 	KeyMaxPairsPerContract        = []byte("KeyMaxPairsPerContract")
 	KeyDefaultGasPerOrderDataByte = []byte("KeyDefaultGasPerOrderDataByte")
+	KeyTradeLogRetention          = []byte("KeyTradeLogRetention") // number of seconds to retain trades for; 0 disables the trade log
+	KeyCandleRetention            = []byte("KeyCandleRetention")   // number of candles to retain for each candle interval; 0 disables candles
+	KeyLowRentWarningFraction     = []byte("KeyLowRentWarningFraction")
+	KeyExecutionStatsRetention    = []byte("KeyExecutionStatsRetention") // number of blocks to retain contract execution stats for; 0 disables them
 )
 
 const (
@@ -39,9 +43,15 @@ const (
 	DefaultMaxOrderPerPrice           = 10000
 	DefaultMaxPairsPerContract        = 100
 	DefaultDefaultGasPerOrderDataByte = 30
+	DefaultTradeLogRetention          = 0    // trade log is disabled by default
+	DefaultCandleRetention            = 1440 // one day of 1m candles, 60 days of 1h candles
+	DefaultExecutionStatsRetention    = 1000
 )
 
-var DefaultSudoCallGasPrice = sdk.NewDecWithPrec(1, 1) // 0.1
+var (
+	DefaultSudoCallGasPrice       = sdk.NewDecWithPrec(1, 1) // 0.1
+	DefaultLowRentWarningFraction = sdk.NewDecWithPrec(1, 1) // warn below 10% of the minimum rent deposit
+)
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
@@ -67,6 +77,10 @@ func DefaultParams() Params {
 		MaxOrderPerPrice:           DefaultMaxOrderPerPrice,
 		MaxPairsPerContract:        DefaultMaxPairsPerContract,
 		DefaultGasPerOrderDataByte: DefaultDefaultGasPerOrderDataByte,
+		TradeLogRetention:          DefaultTradeLogRetention,
+		CandleRetention:            DefaultCandleRetention,
+		LowRentWarningFraction:     DefaultLowRentWarningFraction,
+		ExecutionStatsRetention:    DefaultExecutionStatsRetention,
 	}
 }
 
@@ -87,6 +101,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxOrderPerPrice, &p.MaxOrderPerPrice, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyMaxPairsPerContract, &p.MaxPairsPerContract, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyDefaultGasPerOrderDataByte, &p.DefaultGasPerOrderDataByte, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyTradeLogRetention, &p.TradeLogRetention, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyCandleRetention, &p.CandleRetention, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyLowRentWarningFraction, &p.LowRentWarningFraction, validateLowRentWarningFraction),
+		paramtypes.NewParamSetPair(KeyExecutionStatsRetention, &p.ExecutionStatsRetention, validateUint64Param),
 	}
 }
 
@@ -98,6 +116,9 @@ func (p Params) Validate() error {
 	if err := validateSudoCallGasPrice(p.SudoCallGasPrice); err != nil {
 		return err
 	}
+	if err := validateLowRentWarningFraction(p.LowRentWarningFraction); err != nil {
+		return err
+	}
 	// it's not possible for other params to fail validation if they've already
 	// made it into Params' fields.
 	return nil
@@ -133,6 +154,18 @@ func validateSudoCallGasPrice(i interface{}) error {
 	return nil
 }
 
+func validateLowRentWarningFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an unset fraction disables low rent warnings
+	if !fraction.IsNil() && fraction.IsNegative() {
+		return fmt.Errorf("low rent warning fraction must be non-negative")
+	}
+	return nil
+}
+
 func validateUint64Param(i interface{}) error {
 	_, ok := i.(uint64)
 	if !ok {
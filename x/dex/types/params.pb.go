@@ -40,6 +40,13 @@ type Params struct {
 	MaxOrderPerPrice           uint64                                 `protobuf:"varint,12,opt,name=max_order_per_price,json=maxOrderPerPrice,proto3" json:"max_order_per_price" yaml:"max_order_per_price"`
 	MaxPairsPerContract        uint64                                 `protobuf:"varint,13,opt,name=max_pairs_per_contract,json=maxPairsPerContract,proto3" json:"max_pairs_per_contract" yaml:"max_pairs_per_contract"`
 	DefaultGasPerOrderDataByte uint64                                 `protobuf:"varint,14,opt,name=default_gas_per_order_data_byte,json=defaultGasPerOrderDataByte,proto3" json:"default_gas_per_order_data_byte" yaml:"default_gas_per_order_data_byte"`
+	TradeLogRetention          uint64                                 `protobuf:"varint,15,opt,name=trade_log_retention,json=tradeLogRetention,proto3" json:"trade_log_retention" yaml:"trade_log_retention"`
+	CandleRetention            uint64                                 `protobuf:"varint,16,opt,name=candle_retention,json=candleRetention,proto3" json:"candle_retention" yaml:"candle_retention"`
+	// a low rent warning is emitted when the rent balance of a contract drops below
+	// this fraction of min_rent_deposit
+	LowRentWarningFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=low_rent_warning_fraction,json=lowRentWarningFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low_rent_warning_fraction" yaml:"low_rent_warning_fraction"`
+	// number of blocks to retain contract execution stats for; 0 disables them
+	ExecutionStatsRetention uint64 `protobuf:"varint,18,opt,name=execution_stats_retention,json=executionStatsRetention,proto3" json:"execution_stats_retention" yaml:"execution_stats_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -165,6 +172,27 @@ func (m *Params) GetDefaultGasPerOrderDataByte() uint64 {
 	return 0
 }
 
+func (m *Params) GetTradeLogRetention() uint64 {
+	if m != nil {
+		return m.TradeLogRetention
+	}
+	return 0
+}
+
+func (m *Params) GetCandleRetention() uint64 {
+	if m != nil {
+		return m.CandleRetention
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionStatsRetention() uint64 {
+	if m != nil {
+		return m.ExecutionStatsRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.dex.Params")
 }
@@ -172,57 +200,66 @@ func init() {
 func init() { proto.RegisterFile("dex/params.proto", fileDescriptor_e49286500ccff43e) }
 
 var fileDescriptor_e49286500ccff43e = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xc1, 0x6f, 0x1b, 0x45,
-	0x14, 0xc6, 0xbd, 0x50, 0x42, 0x3b, 0x94, 0xca, 0xda, 0x34, 0xc9, 0x92, 0x16, 0x4f, 0x35, 0x48,
-	0x55, 0x2f, 0xb1, 0x0f, 0x08, 0x21, 0x8a, 0x10, 0xc2, 0x49, 0x94, 0x4b, 0x11, 0xd6, 0x54, 0x1c,
-	0xe0, 0xb2, 0x1a, 0xef, 0x3e, 0x9c, 0x51, 0x66, 0x67, 0x56, 0x33, 0x63, 0x61, 0x9f, 0xb9, 0x70,
-	0x44, 0x9c, 0x38, 0xf6, 0xcf, 0xe9, 0xb1, 0x47, 0xc4, 0x61, 0x84, 0x92, 0x0b, 0xda, 0xe3, 0xfe,
-	0x05, 0x68, 0x66, 0x6d, 0x96, 0x24, 0x6b, 0xf7, 0x94, 0xcd, 0xf7, 0xfb, 0xb4, 0xdf, 0x7b, 0xde,
-	0x79, 0x6f, 0x50, 0x3f, 0x87, 0xc5, 0xa8, 0x64, 0x9a, 0x15, 0x66, 0x58, 0x6a, 0x65, 0x55, 0x9c,
-	0x18, 0xe0, 0xe1, 0x29, 0x53, 0x62, 0x68, 0x80, 0x67, 0xe7, 0x8c, 0xcb, 0x61, 0x0e, 0x8b, 0xc3,
-	0x87, 0x33, 0x35, 0x53, 0x01, 0x8d, 0xfc, 0x53, 0xe3, 0x27, 0xf5, 0x7d, 0xb4, 0x33, 0x09, 0x2f,
-	0x88, 0x97, 0x28, 0x29, 0x35, 0xcf, 0x20, 0x35, 0x92, 0x95, 0xe6, 0x5c, 0xd9, 0x54, 0x83, 0x05,
-	0x69, 0xb9, 0x92, 0x49, 0xf4, 0x24, 0x7a, 0x76, 0x67, 0xfc, 0x75, 0xe5, 0xf0, 0x46, 0x4f, 0xed,
-	0x30, 0x5e, 0xb2, 0x42, 0x3c, 0x27, 0x9b, 0x1c, 0x84, 0xee, 0x07, 0xf4, 0x72, 0x45, 0xe8, 0x1a,
-	0xc4, 0x16, 0xed, 0x9a, 0x79, 0xae, 0xd2, 0x8c, 0x09, 0x91, 0xce, 0x98, 0x49, 0x83, 0x2f, 0x79,
-	0xe7, 0x49, 0xf4, 0xec, 0xde, 0xf8, 0xf4, 0xb5, 0xc3, 0xbd, 0xbf, 0x1c, 0x7e, 0x3a, 0xe3, 0xf6,
-	0x7c, 0x3e, 0x1d, 0x66, 0xaa, 0x18, 0x65, 0xca, 0x14, 0xca, 0xac, 0xfe, 0x1c, 0x99, 0xfc, 0x62,
-	0x64, 0x97, 0x25, 0x98, 0xe1, 0x09, 0x64, 0x95, 0xc3, 0x5d, 0x2f, 0xa3, 0x7d, 0x2f, 0x1e, 0x33,
-	0x21, 0xce, 0x98, 0x99, 0x78, 0x25, 0x16, 0x68, 0x6f, 0x0a, 0x33, 0x2e, 0xd3, 0xa9, 0x50, 0xd9,
-	0x45, 0xb0, 0x0a, 0x5e, 0x70, 0x9b, 0xbc, 0x1b, 0xba, 0xfd, 0xa2, 0x72, 0xb8, 0xdb, 0x50, 0x3b,
-	0xfc, 0xb8, 0x69, 0xb5, 0x13, 0x13, 0x1a, 0x07, 0x7d, 0xec, 0xe5, 0x33, 0x66, 0x5e, 0x78, 0x31,
-	0xce, 0xd1, 0x2e, 0xc8, 0xfc, 0x56, 0xd6, 0x9d, 0x90, 0xf5, 0x99, 0xaf, 0xba, 0x03, 0xd7, 0x0e,
-	0x1f, 0x36, 0x49, 0x1d, 0x90, 0xd0, 0x3e, 0xc8, 0xfc, 0x7a, 0x8a, 0x40, 0x7b, 0x39, 0xfc, 0xc4,
-	0xe6, 0xc2, 0x36, 0xad, 0x83, 0x4e, 0x95, 0xce, 0x41, 0x27, 0xef, 0xb5, 0x3d, 0x75, 0x1a, 0xda,
-	0x9e, 0x3a, 0x31, 0xa1, 0xf1, 0x4a, 0xf7, 0x3f, 0x1f, 0xe8, 0xef, 0xbc, 0x18, 0x97, 0x68, 0xff,
-	0xa6, 0x3b, 0x63, 0x32, 0x03, 0x91, 0xec, 0x84, 0xb8, 0x2f, 0x2b, 0x87, 0x37, 0x38, 0x6a, 0x87,
-	0x3f, 0xee, 0xce, 0x6b, 0x38, 0xa1, 0xbb, 0xd7, 0x02, 0x8f, 0x83, 0x1a, 0xff, 0x80, 0xfa, 0x05,
-	0x97, 0xa9, 0x06, 0x69, 0xd3, 0x1c, 0x4a, 0x65, 0xb8, 0x4d, 0xde, 0x0f, 0x59, 0xa3, 0xca, 0xe1,
-	0x5b, 0xac, 0x76, 0xf8, 0xa0, 0x49, 0xb9, 0x49, 0x08, 0x7d, 0x50, 0x70, 0x49, 0x41, 0xda, 0x93,
-	0x46, 0x88, 0x7f, 0x8d, 0xd0, 0x63, 0x5f, 0x03, 0x13, 0x42, 0xfd, 0xec, 0xd3, 0x42, 0x35, 0x06,
-	0xac, 0x15, 0x50, 0x80, 0xb4, 0xc9, 0xdd, 0x90, 0x73, 0x56, 0x39, 0xbc, 0xd5, 0x57, 0x3b, 0xfc,
-	0x49, 0x93, 0xb9, 0xcd, 0x45, 0xe8, 0x47, 0x33, 0x66, 0xbe, 0x59, 0xd3, 0x09, 0xe8, 0x97, 0xff,
-	0xb1, 0x98, 0xa3, 0x87, 0xbe, 0xde, 0x52, 0xab, 0x0c, 0x8c, 0x61, 0x53, 0x01, 0xa1, 0xf6, 0xe4,
-	0x5e, 0xa8, 0xe0, 0xf3, 0xca, 0xe1, 0x4e, 0x5e, 0x3b, 0xfc, 0xa8, 0xed, 0xf6, 0x26, 0x25, 0x34,
-	0x2e, 0xb8, 0x9c, 0xb4, 0xaa, 0x6f, 0x3e, 0xfe, 0x25, 0x42, 0x8f, 0xc2, 0x17, 0x4e, 0xa7, 0x4a,
-	0x5d, 0xa4, 0x20, 0xad, 0xe6, 0xd0, 0x7c, 0x08, 0xa1, 0x58, 0x9e, 0xa0, 0x10, 0x79, 0x5a, 0x39,
-	0xbc, 0xcd, 0x56, 0x3b, 0x4c, 0x9a, 0xe4, 0x2d, 0x26, 0x42, 0x0f, 0x02, 0x1d, 0x2b, 0x75, 0x71,
-	0xda, 0xb0, 0x09, 0xe8, 0x17, 0x8a, 0xe5, 0xf1, 0x1c, 0x1d, 0x64, 0x4a, 0x5a, 0xcd, 0x32, 0x9b,
-	0xce, 0xa5, 0x99, 0x9b, 0xd2, 0x9f, 0xf7, 0x4c, 0x19, 0x9b, 0x7c, 0x10, 0x0a, 0xf8, 0xaa, 0x72,
-	0x78, 0x93, 0xa5, 0x76, 0x78, 0xd0, 0x84, 0x6f, 0x30, 0x10, 0xba, 0xb7, 0x26, 0xdf, 0xaf, 0xc1,
-	0xb1, 0x32, 0x61, 0x26, 0x0b, 0xb6, 0x68, 0x4e, 0x78, 0x28, 0xb3, 0xd9, 0x3b, 0xf7, 0xdb, 0x99,
-	0xec, 0xc0, 0xed, 0x4c, 0x76, 0x40, 0x42, 0xfb, 0x05, 0x5b, 0x84, 0xe9, 0x98, 0x80, 0x6e, 0xf6,
-	0x4c, 0x89, 0xf6, 0xbd, 0xb3, 0x64, 0x5c, 0xaf, 0x4e, 0xf8, 0xaa, 0x98, 0xe4, 0xc3, 0x76, 0x4a,
-	0xba, 0x1d, 0xed, 0x94, 0x74, 0x73, 0x42, 0x7d, 0x85, 0x13, 0xaf, 0xfb, 0x19, 0x59, 0xa9, 0xf1,
-	0xef, 0x11, 0xc2, 0x9d, 0x63, 0x9c, 0xe6, 0xcc, 0xb2, 0x74, 0xba, 0xb4, 0x90, 0x3c, 0x08, 0xd9,
-	0xdf, 0x56, 0x0e, 0xbf, 0xcd, 0x5a, 0x3b, 0xfc, 0x74, 0xcb, 0x6a, 0x68, 0x8d, 0x84, 0x1e, 0xde,
-	0x5e, 0x12, 0x27, 0xcc, 0xb2, 0xf1, 0xd2, 0xc2, 0xf3, 0xbb, 0x7f, 0xbc, 0xc2, 0xbd, 0x7f, 0x5e,
-	0xe1, 0x68, 0x7c, 0xf6, 0xfa, 0x72, 0x10, 0xbd, 0xb9, 0x1c, 0x44, 0x7f, 0x5f, 0x0e, 0xa2, 0xdf,
-	0xae, 0x06, 0xbd, 0x37, 0x57, 0x83, 0xde, 0x9f, 0x57, 0x83, 0xde, 0x8f, 0x47, 0xff, 0xdb, 0xf1,
-	0x06, 0xf8, 0xd1, 0xfa, 0x2a, 0x0b, 0xff, 0x84, 0xbb, 0x6c, 0xb4, 0x18, 0xf9, 0x4b, 0x2f, 0xac,
-	0xfb, 0xe9, 0x4e, 0xe0, 0x9f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xff, 0x27, 0x82, 0x3c, 0x08,
-	0x07, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x50, 0x42, 0x3b, 0x40, 0xeb, 0x6e, 0x9a, 0x64, 0x93, 0x16, 0x4f, 0x34, 0x48,
+	0x55, 0x2f, 0xb1, 0x0f, 0x08, 0x21, 0x8a, 0x10, 0xaa, 0x93, 0x90, 0x4b, 0x10, 0xd6, 0x44, 0x08,
+	0xd1, 0xcb, 0x6a, 0xbc, 0xfb, 0xea, 0xac, 0x32, 0x3b, 0xb3, 0xda, 0x19, 0x2b, 0xf6, 0x81, 0x13,
+	0x17, 0x8e, 0x88, 0x13, 0xc7, 0x9e, 0xf9, 0x24, 0x3d, 0xf6, 0x88, 0x38, 0x8c, 0x20, 0xb9, 0xa0,
+	0x3d, 0xee, 0x27, 0x40, 0x33, 0x6b, 0x67, 0x53, 0x67, 0x6d, 0xd4, 0x93, 0xbd, 0xef, 0xf7, 0xd7,
+	0xfc, 0xdf, 0xbc, 0x9d, 0xf7, 0x66, 0x51, 0x3b, 0x86, 0x49, 0x2f, 0x63, 0x39, 0x4b, 0x55, 0x37,
+	0xcb, 0xa5, 0x96, 0x7e, 0xa0, 0x20, 0x71, 0xff, 0x22, 0xc9, 0xbb, 0x0a, 0x92, 0xe8, 0x94, 0x25,
+	0xa2, 0x1b, 0xc3, 0x64, 0xe7, 0xc1, 0x48, 0x8e, 0xa4, 0x43, 0x3d, 0xfb, 0xaf, 0xd2, 0x93, 0x7f,
+	0xda, 0x68, 0x6d, 0xe0, 0x16, 0xf0, 0xa7, 0x28, 0xc8, 0xf2, 0x24, 0x82, 0x50, 0x09, 0x96, 0xa9,
+	0x53, 0xa9, 0xc3, 0x1c, 0x34, 0x08, 0x9d, 0x48, 0x11, 0x78, 0xbb, 0xde, 0x93, 0x5b, 0xfd, 0xaf,
+	0x0b, 0x83, 0x97, 0x6a, 0x4a, 0x83, 0xf1, 0x94, 0xa5, 0xfc, 0x29, 0x59, 0xa6, 0x20, 0x74, 0xd3,
+	0xa1, 0x93, 0x19, 0xa1, 0x73, 0xe0, 0x6b, 0xb4, 0xae, 0xc6, 0xb1, 0x0c, 0x23, 0xc6, 0x79, 0x38,
+	0x62, 0x2a, 0x74, 0xba, 0xe0, 0x9d, 0x5d, 0xef, 0xc9, 0x9d, 0xfe, 0xe1, 0x2b, 0x83, 0x5b, 0x7f,
+	0x19, 0xfc, 0x78, 0x94, 0xe8, 0xd3, 0xf1, 0xb0, 0x1b, 0xc9, 0xb4, 0x17, 0x49, 0x95, 0x4a, 0x35,
+	0xfb, 0xd9, 0x53, 0xf1, 0x59, 0x4f, 0x4f, 0x33, 0x50, 0xdd, 0x03, 0x88, 0x0a, 0x83, 0x9b, 0x16,
+	0xa3, 0x6d, 0x1b, 0xdc, 0x67, 0x9c, 0x1f, 0x31, 0x35, 0xb0, 0x11, 0x9f, 0xa3, 0x8d, 0x21, 0x8c,
+	0x12, 0x11, 0x0e, 0xb9, 0x8c, 0xce, 0x9c, 0x94, 0x27, 0x69, 0xa2, 0x83, 0x77, 0xdd, 0x6e, 0xbf,
+	0x28, 0x0c, 0x6e, 0x16, 0x94, 0x06, 0x3f, 0xaa, 0xb6, 0xda, 0x88, 0x09, 0xf5, 0x5d, 0xbc, 0x6f,
+	0xc3, 0x47, 0x4c, 0x1d, 0xdb, 0xa0, 0x1f, 0xa3, 0x75, 0x10, 0xf1, 0x0d, 0xaf, 0x5b, 0xce, 0xeb,
+	0x33, 0x9b, 0x75, 0x03, 0x2e, 0x0d, 0xde, 0xa9, 0x9c, 0x1a, 0x20, 0xa1, 0x6d, 0x10, 0xf1, 0x9b,
+	0x2e, 0x1c, 0x6d, 0xc4, 0xf0, 0x82, 0x8d, 0xb9, 0xae, 0xb6, 0x0e, 0x79, 0x28, 0xf3, 0x18, 0xf2,
+	0xe0, 0xbd, 0x7a, 0x4f, 0x8d, 0x82, 0x7a, 0x4f, 0x8d, 0x98, 0x50, 0x7f, 0x16, 0xb7, 0xe5, 0x83,
+	0xfc, 0x3b, 0x1b, 0xf4, 0x33, 0xb4, 0xb9, 0xa8, 0x8e, 0x98, 0x88, 0x80, 0x07, 0x6b, 0xce, 0xee,
+	0xcb, 0xc2, 0xe0, 0x25, 0x8a, 0xd2, 0xe0, 0x8f, 0x9b, 0xfd, 0x2a, 0x4e, 0xe8, 0xfa, 0x1b, 0x86,
+	0xfb, 0x2e, 0xea, 0xff, 0x88, 0xda, 0x69, 0x22, 0xc2, 0x1c, 0x84, 0x0e, 0x63, 0xc8, 0xa4, 0x4a,
+	0x74, 0xf0, 0xbe, 0xf3, 0xea, 0x15, 0x06, 0xdf, 0x60, 0xa5, 0xc1, 0x5b, 0x95, 0xcb, 0x22, 0x21,
+	0xf4, 0x6e, 0x9a, 0x08, 0x0a, 0x42, 0x1f, 0x54, 0x01, 0xff, 0x17, 0x0f, 0x3d, 0xb2, 0x39, 0x30,
+	0xce, 0xe5, 0xb9, 0x75, 0x73, 0xd9, 0x28, 0xd0, 0x9a, 0x43, 0x0a, 0x42, 0x07, 0xb7, 0x9d, 0xcf,
+	0x51, 0x61, 0xf0, 0x4a, 0x5d, 0x69, 0xf0, 0x27, 0x95, 0xe7, 0x2a, 0x15, 0xa1, 0xdb, 0x23, 0xa6,
+	0x9e, 0xcd, 0xe9, 0x00, 0xf2, 0x93, 0x2b, 0xe6, 0x27, 0xe8, 0x81, 0xcd, 0x37, 0xcb, 0x65, 0x04,
+	0x4a, 0xb1, 0x21, 0x07, 0x97, 0x7b, 0x70, 0xc7, 0x65, 0xf0, 0x79, 0x61, 0x70, 0x23, 0x2f, 0x0d,
+	0x7e, 0x58, 0xef, 0x76, 0x91, 0x12, 0xea, 0xa7, 0x89, 0x18, 0xd4, 0x51, 0xbb, 0x79, 0xff, 0x67,
+	0x0f, 0x3d, 0x74, 0x6f, 0x38, 0x1c, 0x4a, 0x79, 0x16, 0x82, 0xd0, 0x79, 0x02, 0xd5, 0x8b, 0xe0,
+	0x92, 0xc5, 0x01, 0x72, 0x96, 0x87, 0x85, 0xc1, 0xab, 0x64, 0xa5, 0xc1, 0xa4, 0x72, 0x5e, 0x21,
+	0x22, 0x74, 0xcb, 0xd1, 0xbe, 0x94, 0x67, 0x87, 0x15, 0x1b, 0x40, 0x7e, 0x2c, 0x59, 0xec, 0x8f,
+	0xd1, 0x56, 0x24, 0x85, 0xce, 0x59, 0xa4, 0xc3, 0xb1, 0x50, 0x63, 0x95, 0xd9, 0xf3, 0x1e, 0x49,
+	0xa5, 0x83, 0x0f, 0x5c, 0x02, 0x5f, 0x15, 0x06, 0x2f, 0x93, 0x94, 0x06, 0x77, 0x2a, 0xf3, 0x25,
+	0x02, 0x42, 0x37, 0xe6, 0xe4, 0xfb, 0x39, 0xd8, 0x97, 0xca, 0xf5, 0x64, 0xca, 0x26, 0xd5, 0x09,
+	0x77, 0x69, 0x56, 0x73, 0xe7, 0xc3, 0xba, 0x27, 0x1b, 0x70, 0xdd, 0x93, 0x0d, 0x90, 0xd0, 0x76,
+	0xca, 0x26, 0xae, 0x3b, 0x06, 0x90, 0x57, 0x73, 0x26, 0x43, 0x9b, 0x56, 0x99, 0xb1, 0x24, 0x9f,
+	0x9d, 0xf0, 0x59, 0x32, 0xc1, 0x47, 0x75, 0x97, 0x34, 0x2b, 0xea, 0x2e, 0x69, 0xe6, 0x84, 0xda,
+	0x0c, 0x07, 0x36, 0x6e, 0x7b, 0x64, 0x16, 0xf5, 0x7f, 0xf3, 0x10, 0x6e, 0x6c, 0xe3, 0x30, 0x66,
+	0x9a, 0x85, 0xc3, 0xa9, 0x86, 0xe0, 0xae, 0xf3, 0xfe, 0xb6, 0x30, 0xf8, 0xff, 0xa4, 0xa5, 0xc1,
+	0x8f, 0x57, 0x8c, 0x86, 0x5a, 0x48, 0xe8, 0xce, 0xcd, 0x21, 0x71, 0xc0, 0x34, 0xeb, 0x4f, 0x35,
+	0xf8, 0x80, 0xd6, 0x75, 0xce, 0x62, 0x08, 0xb9, 0x1c, 0x5d, 0xbb, 0x5a, 0xee, 0xd5, 0xc5, 0x6e,
+	0xc0, 0x75, 0xb1, 0x1b, 0x20, 0xa1, 0xf7, 0x5d, 0xf4, 0x58, 0x8e, 0xea, 0xbb, 0xe4, 0x39, 0x6a,
+	0x47, 0x4c, 0xc4, 0xee, 0xd0, 0xcf, 0x3d, 0xda, 0xf5, 0x84, 0x58, 0x64, 0xf5, 0x84, 0x58, 0x24,
+	0x84, 0xde, 0xab, 0x42, 0xf5, 0xda, 0x7f, 0x78, 0x68, 0x9b, 0xcb, 0xf3, 0x6a, 0x90, 0x9c, 0xb3,
+	0x5c, 0x24, 0x62, 0x14, 0xbe, 0xb0, 0x25, 0xb7, 0x2e, 0xf7, 0xdd, 0x75, 0x25, 0xdf, 0xfa, 0xba,
+	0x5a, 0xbe, 0x64, 0x69, 0xf0, 0x6e, 0x95, 0xdc, 0x52, 0x09, 0xa1, 0x9b, 0x5c, 0x9e, 0xdb, 0x56,
+	0xfe, 0xa1, 0x22, 0xdf, 0xcc, 0x80, 0xff, 0x13, 0xda, 0x86, 0x09, 0x44, 0x63, 0xfb, 0x10, 0x2a,
+	0xcd, 0xb4, 0xba, 0x56, 0x11, 0xdf, 0x55, 0xe4, 0x99, 0x75, 0x5f, 0x2a, 0xaa, 0xdd, 0x97, 0x4a,
+	0x08, 0xdd, 0xba, 0x62, 0x27, 0x16, 0x5d, 0xd5, 0xea, 0xe9, 0xed, 0xdf, 0x5f, 0xe2, 0xd6, 0xbf,
+	0x2f, 0xb1, 0xd7, 0x3f, 0x7a, 0x75, 0xd1, 0xf1, 0x5e, 0x5f, 0x74, 0xbc, 0xbf, 0x2f, 0x3a, 0xde,
+	0xaf, 0x97, 0x9d, 0xd6, 0xeb, 0xcb, 0x4e, 0xeb, 0xcf, 0xcb, 0x4e, 0xeb, 0xf9, 0xde, 0xb5, 0x1a,
+	0x29, 0x48, 0xf6, 0xe6, 0x5f, 0x2e, 0xee, 0xc1, 0x7d, 0xba, 0xf4, 0x26, 0x3d, 0xfb, 0x8d, 0xe3,
+	0xca, 0x35, 0x5c, 0x73, 0xfc, 0xd3, 0xff, 0x06, 0x00, 0x7e, 0xfb, 0xcd, 0xb6, 0xf7, 0x08, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -286,6 +323,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DefaultGasPerOrderDataByte != that1.DefaultGasPerOrderDataByte {
 		return false
 	}
+	if this.TradeLogRetention != that1.TradeLogRetention {
+		return false
+	}
+	if this.CandleRetention != that1.CandleRetention {
+		return false
+	}
+	if !this.LowRentWarningFraction.Equal(that1.LowRentWarningFraction) {
+		return false
+	}
+	if this.ExecutionStatsRetention != that1.ExecutionStatsRetention {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -308,6 +357,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionStatsRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExecutionStatsRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.LowRentWarningFraction.Size()
+		i -= size
+		if _, err := m.LowRentWarningFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.CandleRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CandleRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.TradeLogRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TradeLogRetention))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.DefaultGasPerOrderDataByte != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DefaultGasPerOrderDataByte))
 		i--
@@ -444,6 +524,17 @@ func (m *Params) Size() (n int) {
 	if m.DefaultGasPerOrderDataByte != 0 {
 		n += 1 + sovParams(uint64(m.DefaultGasPerOrderDataByte))
 	}
+	if m.TradeLogRetention != 0 {
+		n += 1 + sovParams(uint64(m.TradeLogRetention))
+	}
+	if m.CandleRetention != 0 {
+		n += 2 + sovParams(uint64(m.CandleRetention))
+	}
+	l = m.LowRentWarningFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ExecutionStatsRetention != 0 {
+		n += 2 + sovParams(uint64(m.ExecutionStatsRetention))
+	}
 	return n
 }
 
@@ -763,6 +854,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLogRetention", wireType)
+			}
+			m.TradeLogRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeLogRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetention", wireType)
+			}
+			m.CandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowRentWarningFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowRentWarningFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStatsRetention", wireType)
+			}
+			m.ExecutionStatsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionStatsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
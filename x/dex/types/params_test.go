@@ -15,3 +15,17 @@ func TestParamsValidate(t *testing.T) {
 	p = types.Params{SudoCallGasPrice: sdk.ZeroDec()}
 	require.Error(t, p.Validate())
 }
+
+func TestParamsValidateLowRentWarningFraction(t *testing.T) {
+	p := types.DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.LowRentWarningFraction = sdk.ZeroDec()
+	require.NoError(t, p.Validate())
+
+	p.LowRentWarningFraction = sdk.NewDec(-1)
+	require.Error(t, p.Validate())
+
+	p.LowRentWarningFraction = sdk.Dec{}
+	require.NoError(t, p.Validate())
+}
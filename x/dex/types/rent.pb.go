@@ -0,0 +1,1175 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/rent.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RentTopUp allows the rent of a contract to be drawn from a source account
+// whenever the rent balance of the contract would fall below `threshold`.
+type RentTopUp struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	// account the rent is drawn from
+	Source    string `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	// amount drawn per top-up. A larger amount is drawn if needed to cover a charge.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount"`
+	// total amount that can still be drawn from the source account
+	SpendLimit uint64 `protobuf:"varint,5,opt,name=spendLimit,proto3" json:"spend_limit"`
+	// unix timestamp in seconds after which nothing is drawn, 0 means no expiration
+	Expiration uint64 `protobuf:"varint,6,opt,name=expiration,proto3" json:"expiration"`
+	// amount credited to the rent balance during the current block that has yet
+	// to be transferred from the source account
+	Pending uint64 `protobuf:"varint,7,opt,name=pending,proto3" json:"pending"`
+}
+
+func (m *RentTopUp) Reset()         { *m = RentTopUp{} }
+func (m *RentTopUp) String() string { return proto.CompactTextString(m) }
+func (*RentTopUp) ProtoMessage()    {}
+func (*RentTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{0}
+}
+func (m *RentTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RentTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RentTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RentTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RentTopUp.Merge(m, src)
+}
+func (m *RentTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RentTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RentTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RentTopUp proto.InternalMessageInfo
+
+func (m *RentTopUp) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *RentTopUp) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *RentTopUp) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *RentTopUp) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RentTopUp) GetSpendLimit() uint64 {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return 0
+}
+
+func (m *RentTopUp) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *RentTopUp) GetPending() uint64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+// EventRentTopUp is emitted when rent is drawn from the source account of a contract.
+type EventRentTopUp struct {
+	ContractAddr        string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	Source              string `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	Amount              uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
+	RentBalance         uint64 `protobuf:"varint,4,opt,name=rentBalance,proto3" json:"rent_balance"`
+	RemainingSpendLimit uint64 `protobuf:"varint,5,opt,name=remainingSpendLimit,proto3" json:"remaining_spend_limit"`
+}
+
+func (m *EventRentTopUp) Reset()         { *m = EventRentTopUp{} }
+func (m *EventRentTopUp) String() string { return proto.CompactTextString(m) }
+func (*EventRentTopUp) ProtoMessage()    {}
+func (*EventRentTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{1}
+}
+func (m *EventRentTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRentTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRentTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRentTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRentTopUp.Merge(m, src)
+}
+func (m *EventRentTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRentTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRentTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRentTopUp proto.InternalMessageInfo
+
+func (m *EventRentTopUp) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *EventRentTopUp) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventRentTopUp) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EventRentTopUp) GetRentBalance() uint64 {
+	if m != nil {
+		return m.RentBalance
+	}
+	return 0
+}
+
+func (m *EventRentTopUp) GetRemainingSpendLimit() uint64 {
+	if m != nil {
+		return m.RemainingSpendLimit
+	}
+	return 0
+}
+
+// EventLowRentBalance is emitted when a charge brings the rent balance of a
+// contract below the warning level.
+type EventLowRentBalance struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	RentBalance  uint64 `protobuf:"varint,2,opt,name=rentBalance,proto3" json:"rent_balance"`
+	WarningLevel uint64 `protobuf:"varint,3,opt,name=warningLevel,proto3" json:"warning_level"`
+	// rent balance below which the contract is no longer processed
+	MinProcessableRent uint64 `protobuf:"varint,4,opt,name=minProcessableRent,proto3" json:"min_processable_rent"`
+}
+
+func (m *EventLowRentBalance) Reset()         { *m = EventLowRentBalance{} }
+func (m *EventLowRentBalance) String() string { return proto.CompactTextString(m) }
+func (*EventLowRentBalance) ProtoMessage()    {}
+func (*EventLowRentBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{2}
+}
+func (m *EventLowRentBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLowRentBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLowRentBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLowRentBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLowRentBalance.Merge(m, src)
+}
+func (m *EventLowRentBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLowRentBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLowRentBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLowRentBalance proto.InternalMessageInfo
+
+func (m *EventLowRentBalance) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *EventLowRentBalance) GetRentBalance() uint64 {
+	if m != nil {
+		return m.RentBalance
+	}
+	return 0
+}
+
+func (m *EventLowRentBalance) GetWarningLevel() uint64 {
+	if m != nil {
+		return m.WarningLevel
+	}
+	return 0
+}
+
+func (m *EventLowRentBalance) GetMinProcessableRent() uint64 {
+	if m != nil {
+		return m.MinProcessableRent
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RentTopUp)(nil), "seiprotocol.seichain.dex.RentTopUp")
+	proto.RegisterType((*EventRentTopUp)(nil), "seiprotocol.seichain.dex.EventRentTopUp")
+	proto.RegisterType((*EventLowRentBalance)(nil), "seiprotocol.seichain.dex.EventLowRentBalance")
+}
+
+func init() { proto.RegisterFile("dex/rent.proto", fileDescriptor_a7b7f75d2683d900) }
+
+var fileDescriptor_a7b7f75d2683d900 = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0xc7, 0x77, 0xba, 0x6b, 0x97, 0xa6, 0xdd, 0xba, 0xce, 0x56, 0x18, 0x3d, 0x4c, 0x96, 0x82,
+	0xb0, 0x20, 0xdb, 0x01, 0x45, 0xf0, 0x6a, 0x41, 0x14, 0xec, 0x41, 0xa2, 0x5e, 0xbc, 0x0c, 0xe9,
+	0xcc, 0xa3, 0x0d, 0xcc, 0x24, 0x43, 0x92, 0xee, 0xd6, 0xbb, 0x1f, 0x40, 0xf0, 0x1b, 0x79, 0xf2,
+	0xb8, 0x47, 0x4f, 0x41, 0xda, 0x5b, 0x3e, 0x85, 0x24, 0x9d, 0xda, 0xd9, 0x65, 0x05, 0xf1, 0xe0,
+	0x29, 0xaf, 0xbf, 0xf7, 0xff, 0x27, 0x7d, 0x7f, 0xe6, 0xa1, 0x7e, 0x0e, 0xcb, 0x44, 0x02, 0xd7,
+	0xa3, 0x4a, 0x0a, 0x2d, 0xc2, 0x48, 0x01, 0xf3, 0x55, 0x26, 0x8a, 0x91, 0x02, 0x96, 0xcd, 0x29,
+	0xe3, 0xa3, 0x1c, 0x96, 0x0f, 0x07, 0x33, 0x31, 0x13, 0xbe, 0x95, 0xb8, 0x6a, 0xa3, 0x1f, 0x7e,
+	0x6b, 0xa1, 0x0e, 0x01, 0xae, 0xdf, 0x8b, 0xea, 0x43, 0x15, 0x3e, 0x47, 0xbd, 0x4c, 0x70, 0x2d,
+	0x69, 0xa6, 0x5f, 0xe4, 0xb9, 0x8c, 0x82, 0xd3, 0xe0, 0xac, 0x33, 0x1e, 0x58, 0x83, 0x8f, 0xb7,
+	0x3c, 0xa5, 0x79, 0x2e, 0x41, 0x29, 0x72, 0x4d, 0x19, 0x0e, 0x51, 0x5b, 0x89, 0x85, 0xcc, 0x20,
+	0x6a, 0x79, 0x0f, 0xb2, 0x06, 0xd7, 0x84, 0xd4, 0x67, 0xf8, 0x18, 0x75, 0xf4, 0x5c, 0x82, 0x9a,
+	0x8b, 0x22, 0x8f, 0xf6, 0x4f, 0x83, 0xb3, 0x83, 0xf1, 0x91, 0x35, 0x78, 0x07, 0xc9, 0xae, 0x74,
+	0x17, 0xd2, 0x52, 0x2c, 0xb8, 0x8e, 0x0e, 0xbc, 0xd2, 0x5f, 0xb8, 0x21, 0xa4, 0x3e, 0xc3, 0x04,
+	0x21, 0x55, 0x01, 0xcf, 0x27, 0xac, 0x64, 0x3a, 0xba, 0xe3, 0x75, 0x77, 0xad, 0xc1, 0x5d, 0x4f,
+	0xd3, 0xc2, 0x61, 0xd2, 0x90, 0x84, 0x23, 0x84, 0x60, 0x59, 0x31, 0x49, 0x35, 0x13, 0x3c, 0x6a,
+	0x7b, 0x43, 0xdf, 0x1a, 0xdc, 0xa0, 0xa4, 0x51, 0x87, 0x8f, 0xd0, 0xa1, 0x33, 0x33, 0x3e, 0x8b,
+	0x0e, 0xbd, 0xb8, 0x6b, 0x0d, 0xde, 0x22, 0xb2, 0x2d, 0x86, 0x5f, 0x5b, 0xa8, 0xff, 0xf2, 0x02,
+	0xb8, 0xfe, 0x5f, 0x49, 0xee, 0xc2, 0xd9, 0xff, 0x63, 0x38, 0x4f, 0x50, 0xd7, 0x7d, 0x17, 0x63,
+	0x5a, 0x50, 0x9e, 0x41, 0x9d, 0xe2, 0xb1, 0x35, 0xb8, 0xe7, 0x70, 0x3a, 0xdd, 0x70, 0xd2, 0x14,
+	0x85, 0x6f, 0xd0, 0x89, 0x84, 0x92, 0x32, 0xce, 0xf8, 0xec, 0xdd, 0xcd, 0x64, 0x1f, 0x58, 0x83,
+	0xef, 0xff, 0x6e, 0xa7, 0xcd, 0x8c, 0x6f, 0x73, 0x0d, 0x3f, 0xb7, 0xd0, 0x89, 0x4f, 0x65, 0x22,
+	0x2e, 0x49, 0xe3, 0x91, 0x7f, 0x8f, 0xe6, 0xc6, 0x48, 0xad, 0xbf, 0x19, 0xe9, 0x19, 0xea, 0x5d,
+	0x52, 0xe9, 0xfe, 0xda, 0x04, 0x2e, 0xa0, 0xa8, 0x03, 0xbb, 0x67, 0x0d, 0x3e, 0xaa, 0x79, 0x5a,
+	0xb8, 0x06, 0xb9, 0x26, 0x0b, 0x5f, 0xa3, 0xb0, 0x64, 0xfc, 0xad, 0x14, 0x19, 0x28, 0x45, 0xa7,
+	0x05, 0xb8, 0x09, 0xea, 0x10, 0x23, 0x6b, 0xf0, 0xa0, 0x64, 0x3c, 0xad, 0x76, 0xed, 0xd4, 0xbd,
+	0x49, 0x6e, 0xf1, 0x8c, 0x5f, 0x7d, 0x5f, 0xc5, 0xc1, 0xd5, 0x2a, 0x0e, 0x7e, 0xae, 0xe2, 0xe0,
+	0xcb, 0x3a, 0xde, 0xbb, 0x5a, 0xc7, 0x7b, 0x3f, 0xd6, 0xf1, 0xde, 0xc7, 0xf3, 0x19, 0xd3, 0xf3,
+	0xc5, 0x74, 0x94, 0x89, 0x32, 0x51, 0xc0, 0xce, 0xb7, 0x7b, 0xeb, 0x7f, 0xf8, 0xc5, 0x4d, 0x96,
+	0x89, 0xdb, 0x6f, 0xfd, 0xa9, 0x02, 0x35, 0x6d, 0xfb, 0xfe, 0xd3, 0x5f, 0x03, 0x00, 0x8d, 0xad,
+	0xef, 0x5e, 0xf3, 0x03, 0x00, 0x00,
+}
+
+func (m *RentTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RentTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RentTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SpendLimit != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.SpendLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRentTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRentTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRentTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingSpendLimit != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.RemainingSpendLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RentBalance != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.RentBalance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLowRentBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLowRentBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLowRentBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinProcessableRent != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.MinProcessableRent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WarningLevel != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.WarningLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RentBalance != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.RentBalance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RentTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovRent(uint64(m.Threshold))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRent(uint64(m.Amount))
+	}
+	if m.SpendLimit != 0 {
+		n += 1 + sovRent(uint64(m.SpendLimit))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovRent(uint64(m.Expiration))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovRent(uint64(m.Pending))
+	}
+	return n
+}
+
+func (m *EventRentTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRent(uint64(m.Amount))
+	}
+	if m.RentBalance != 0 {
+		n += 1 + sovRent(uint64(m.RentBalance))
+	}
+	if m.RemainingSpendLimit != 0 {
+		n += 1 + sovRent(uint64(m.RemainingSpendLimit))
+	}
+	return n
+}
+
+func (m *EventLowRentBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.RentBalance != 0 {
+		n += 1 + sovRent(uint64(m.RentBalance))
+	}
+	if m.WarningLevel != 0 {
+		n += 1 + sovRent(uint64(m.WarningLevel))
+	}
+	if m.MinProcessableRent != 0 {
+		n += 1 + sovRent(uint64(m.MinProcessableRent))
+	}
+	return n
+}
+
+func sovRent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRent(x uint64) (n int) {
+	return sovRent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RentTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RentTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RentTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			m.SpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRentTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRentTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRentTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentBalance", wireType)
+			}
+			m.RentBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendLimit", wireType)
+			}
+			m.RemainingSpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingSpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLowRentBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLowRentBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLowRentBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentBalance", wireType)
+			}
+			m.RentBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningLevel", wireType)
+			}
+			m.WarningLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProcessableRent", wireType)
+			}
+			m.MinProcessableRent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProcessableRent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRent = fmt.Errorf("proto: unexpected end of group")
+)
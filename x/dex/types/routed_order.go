@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetRouteHopDirections returns the direction of the market order of each hop when
+// `inputDenom` is swapped through `hops`, as well as the denom the route ends in.
+// A hop buys the asset of its pair if its input is the price denom of the pair, and
+// sells the asset if its input is the asset denom.
+func GetRouteHopDirections(inputDenom string, hops []*RouteHop) ([]PositionDirection, string, error) {
+	directions := []PositionDirection{}
+	denom := inputDenom
+	for i, hop := range hops {
+		switch denom {
+		case hop.PriceDenom:
+			directions = append(directions, PositionDirection_LONG)
+			denom = hop.AssetDenom
+		case hop.AssetDenom:
+			directions = append(directions, PositionDirection_SHORT)
+			denom = hop.PriceDenom
+		default:
+			return nil, "", sdkerrors.Wrapf(ErrInvalidRoute, "hop %d on {price:%s,asset:%s} does not take %s", i, hop.PriceDenom, hop.AssetDenom, denom)
+		}
+	}
+	return directions, denom, nil
+}
+
+// GetHopOrder returns the market order a hop places with `input` of the output of
+// the previous hop. Buying hops spend at most `input` since the quantity to buy is
+// derived from the hop's worst price.
+func (r *RoutedOrder) GetHopOrder(hopIdx int, direction PositionDirection, input sdk.Dec) *Order {
+	hop := r.Hops[hopIdx]
+	quantity := input
+	if direction == PositionDirection_LONG {
+		quantity = input.Quo(hop.Price)
+	}
+	return &Order{
+		Account:           r.Creator,
+		ContractAddr:      hop.ContractAddr,
+		Price:             hop.Price,
+		Quantity:          quantity,
+		PriceDenom:        hop.PriceDenom,
+		AssetDenom:        hop.AssetDenom,
+		OrderType:         OrderType_MARKET,
+		PositionDirection: direction,
+		Data:              hop.Data,
+		Nominal:           sdk.ZeroDec(),
+		TriggerPrice:      sdk.ZeroDec(),
+	}
+}
+
+// GetHopContractAddrs returns the distinct contracts the hops of the route are
+// placed on, in route order.
+func (r *RoutedOrder) GetHopContractAddrs() []string {
+	contractAddrs := []string{}
+	seen := map[string]struct{}{}
+	for _, hop := range r.Hops {
+		if _, ok := seen[hop.ContractAddr]; ok {
+			continue
+		}
+		seen[hop.ContractAddr] = struct{}{}
+		contractAddrs = append(contractAddrs, hop.ContractAddr)
+	}
+	return contractAddrs
+}
+
+// GetHopOutput returns how much of the next denom of the route a hop's order got
+// out of its settlements.
+func GetHopOutput(direction PositionDirection, settlements []*SettlementEntry) sdk.Dec {
+	output := sdk.ZeroDec()
+	for _, settlement := range settlements {
+		if direction == PositionDirection_LONG {
+			output = output.Add(settlement.Quantity)
+		} else {
+			output = output.Add(settlement.Quantity.Mul(settlement.ExecutionCostOrProceed))
+		}
+	}
+	return output
+}
@@ -0,0 +1,957 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/routed_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RouteHop is a single leg of a routed order, executed as a market order on a
+// registered pair of a contract.
+type RouteHop struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom   string `protobuf:"bytes,2,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom   string `protobuf:"bytes,3,opt,name=assetDenom,proto3" json:"asset_denom"`
+	// worst price of the hop's market order. It's required if the hop buys the
+	// asset since the quantity to buy is derived from it, and 0 means no worst
+	// price if the hop sells the asset.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// data passed to the contract along with the hop's order
+	Data string `protobuf:"bytes,5,opt,name=data,proto3" json:"data"`
+}
+
+func (m *RouteHop) Reset()         { *m = RouteHop{} }
+func (m *RouteHop) String() string { return proto.CompactTextString(m) }
+func (*RouteHop) ProtoMessage()    {}
+func (*RouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76e36aa004e107f2, []int{0}
+}
+func (m *RouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHop.Merge(m, src)
+}
+func (m *RouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteHop proto.InternalMessageInfo
+
+func (m *RouteHop) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *RouteHop) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *RouteHop) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *RouteHop) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// RoutedOrder is a routed order waiting to be executed at the end of the block.
+type RoutedOrder struct {
+	// index of the routed order in the block
+	Id            uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Creator       string                                 `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator"`
+	Hops          []*RouteHop                            `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops"`
+	InputDenom    string                                 `protobuf:"bytes,4,opt,name=inputDenom,proto3" json:"input_denom"`
+	InputQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=inputQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"input_quantity" yaml:"input_quantity"`
+	MinOutput     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=minOutput,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_output" yaml:"min_output"`
+}
+
+func (m *RoutedOrder) Reset()         { *m = RoutedOrder{} }
+func (m *RoutedOrder) String() string { return proto.CompactTextString(m) }
+func (*RoutedOrder) ProtoMessage()    {}
+func (*RoutedOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76e36aa004e107f2, []int{1}
+}
+func (m *RoutedOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutedOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutedOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutedOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutedOrder.Merge(m, src)
+}
+func (m *RoutedOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutedOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutedOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutedOrder proto.InternalMessageInfo
+
+func (m *RoutedOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoutedOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *RoutedOrder) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *RoutedOrder) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RouteHop)(nil), "seiprotocol.seichain.dex.RouteHop")
+	proto.RegisterType((*RoutedOrder)(nil), "seiprotocol.seichain.dex.RoutedOrder")
+}
+
+func init() { proto.RegisterFile("dex/routed_order.proto", fileDescriptor_76e36aa004e107f2) }
+
+var fileDescriptor_76e36aa004e107f2 = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6b, 0xdb, 0x30,
+	0x18, 0xc6, 0x63, 0xc7, 0xcd, 0x5a, 0xa5, 0xfb, 0x27, 0xba, 0x62, 0xc6, 0xb0, 0x8a, 0x61, 0xa3,
+	0x97, 0xd8, 0xb0, 0x5d, 0xc6, 0x4e, 0x5b, 0x28, 0x74, 0xb7, 0x52, 0xdd, 0x36, 0x18, 0xc1, 0xb5,
+	0x44, 0x22, 0x56, 0x5b, 0x9e, 0x24, 0x43, 0x72, 0xda, 0x57, 0xd8, 0x77, 0xd8, 0x27, 0xd8, 0xb7,
+	0xe8, 0xb1, 0xc7, 0xb1, 0x83, 0x18, 0xc9, 0xcd, 0xc7, 0x7c, 0x82, 0xe1, 0xd7, 0x36, 0x6e, 0x0e,
+	0x3b, 0xf4, 0x22, 0xeb, 0x7d, 0xf4, 0x3e, 0xef, 0x63, 0xff, 0x84, 0xd1, 0x31, 0xe3, 0xcb, 0x58,
+	0xc9, 0xd2, 0x70, 0x36, 0x93, 0x8a, 0x71, 0x15, 0x15, 0x4a, 0x1a, 0x89, 0x7d, 0xcd, 0x05, 0xec,
+	0x52, 0x79, 0x1d, 0x69, 0x2e, 0xd2, 0x45, 0x22, 0xf2, 0x88, 0xf1, 0xe5, 0xf3, 0xa3, 0xb9, 0x9c,
+	0x4b, 0x38, 0x8a, 0xeb, 0x5d, 0xd3, 0x1f, 0xfe, 0x74, 0xd1, 0x3e, 0xad, 0xc7, 0x7c, 0x94, 0x05,
+	0x7e, 0x8b, 0x0e, 0x53, 0x99, 0x1b, 0x95, 0xa4, 0xe6, 0x03, 0x63, 0xca, 0x77, 0x4e, 0x9c, 0xd3,
+	0x83, 0xe9, 0x51, 0x65, 0xc9, 0x93, 0x4e, 0x9f, 0x25, 0x8c, 0x29, 0xae, 0x35, 0xdd, 0xe9, 0xc4,
+	0x31, 0x42, 0x85, 0x12, 0x29, 0x3f, 0xe3, 0xb9, 0xcc, 0x7c, 0x17, 0x7c, 0x8f, 0x2b, 0x4b, 0xc6,
+	0xa0, 0xce, 0x58, 0x2d, 0xd3, 0x3b, 0x2d, 0xb5, 0x21, 0xd1, 0x9a, 0x9b, 0xc6, 0x30, 0xec, 0x0d,
+	0xa0, 0x76, 0x86, 0xbe, 0x05, 0x7f, 0x41, 0x7b, 0x60, 0xf7, 0x3d, 0xe8, 0x3d, 0xbf, 0xb1, 0x64,
+	0xf0, 0xc7, 0x92, 0x57, 0x73, 0x61, 0x16, 0xe5, 0x55, 0x94, 0xca, 0x2c, 0x4e, 0xa5, 0xce, 0xa4,
+	0x6e, 0x1f, 0x13, 0xcd, 0xbe, 0xc6, 0x66, 0x55, 0x70, 0x1d, 0x9d, 0xf1, 0xb4, 0xb2, 0xa4, 0xb1,
+	0x6f, 0x2d, 0x39, 0x5c, 0x25, 0xd9, 0xf5, 0xbb, 0x10, 0xca, 0x90, 0x36, 0x32, 0x7e, 0x81, 0x3c,
+	0x96, 0x98, 0xc4, 0xdf, 0x83, 0xe9, 0xfb, 0x95, 0x25, 0x50, 0x53, 0x58, 0xc3, 0x5f, 0x43, 0x34,
+	0x06, 0x4a, 0xec, 0xa2, 0x66, 0x8d, 0x8f, 0x91, 0x2b, 0x18, 0xe0, 0xf1, 0xa6, 0xa3, 0xca, 0x12,
+	0x57, 0x30, 0xea, 0x0a, 0x86, 0x5f, 0xa2, 0x07, 0xa9, 0xe2, 0x89, 0x91, 0xaa, 0x65, 0x30, 0xae,
+	0x2c, 0xe9, 0x24, 0xda, 0x6d, 0xf0, 0x7b, 0xe4, 0x2d, 0x64, 0xa1, 0xfd, 0xe1, 0xc9, 0xf0, 0x74,
+	0xfc, 0x3a, 0x8c, 0xfe, 0x77, 0x67, 0x51, 0x77, 0x33, 0xcd, 0x0b, 0xd5, 0x1e, 0x0a, 0x6b, 0x8d,
+	0x4f, 0xe4, 0x45, 0xd9, 0xe2, 0xf3, 0x7a, 0x7c, 0xa0, 0x76, 0xf8, 0xfa, 0x16, 0xfc, 0x1d, 0x3d,
+	0x84, 0xea, 0xb2, 0x4c, 0x72, 0x23, 0xcc, 0xaa, 0xfd, 0xd0, 0x4f, 0xf7, 0xc6, 0xf8, 0xa8, 0x49,
+	0xf8, 0xd6, 0xce, 0xd9, 0x5a, 0xf2, 0xac, 0xe1, 0xb9, 0xab, 0x87, 0x74, 0x37, 0x0f, 0x4b, 0x74,
+	0x90, 0x89, 0xfc, 0xa2, 0x34, 0x45, 0x69, 0xfc, 0x11, 0x84, 0x5f, 0xde, 0x3b, 0x1c, 0x65, 0x22,
+	0x9f, 0x49, 0x98, 0xb1, 0xb5, 0xe4, 0x69, 0x13, 0xdc, 0x6b, 0x21, 0xed, 0x33, 0xa6, 0xe7, 0x37,
+	0xeb, 0xc0, 0xb9, 0x5d, 0x07, 0xce, 0xdf, 0x75, 0xe0, 0xfc, 0xd8, 0x04, 0x83, 0xdb, 0x4d, 0x30,
+	0xf8, 0xbd, 0x09, 0x06, 0x9f, 0x27, 0x77, 0xf2, 0x34, 0x17, 0x93, 0x8e, 0x3d, 0x14, 0x00, 0x3f,
+	0x5e, 0xc6, 0xf5, 0xff, 0x05, 0xd1, 0x57, 0x23, 0x38, 0x7f, 0xf3, 0x6f, 0x00, 0x04, 0xde, 0xda,
+	0x7e, 0x73, 0x03, 0x00, 0x00,
+}
+
+func (m *RouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoutedOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutedOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutedOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinOutput.Size()
+		i -= size
+		if _, err := m.MinOutput.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InputQuantity.Size()
+		i -= size
+		if _, err := m.InputQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRoutedOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRoutedOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoutedOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoutedOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovRoutedOrder(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *RoutedOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRoutedOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovRoutedOrder(uint64(l))
+		}
+	}
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovRoutedOrder(uint64(l))
+	}
+	l = m.InputQuantity.Size()
+	n += 1 + l + sovRoutedOrder(uint64(l))
+	l = m.MinOutput.Size()
+	n += 1 + l + sovRoutedOrder(uint64(l))
+	return n
+}
+
+func sovRoutedOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoutedOrder(x uint64) (n int) {
+	return sovRoutedOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoutedOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoutedOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoutedOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoutedOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoutedOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoutedOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, &RouteHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InputQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoutedOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoutedOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoutedOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoutedOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoutedOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoutedOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoutedOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoutedOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoutedOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoutedOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoutedOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1484 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StreamBlockUpdatesRequest struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	// pairs to stream updates for, all registered pairs of the contract if empty
+	Pairs []*Pair `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs"`
+	// updates are only kept in memory for a limited number of recent blocks
+	StartHeight int64 `protobuf:"varint,3,opt,name=startHeight,proto3" json:"start_height"`
+}
+
+func (m *StreamBlockUpdatesRequest) Reset()         { *m = StreamBlockUpdatesRequest{} }
+func (m *StreamBlockUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBlockUpdatesRequest) ProtoMessage()    {}
+func (*StreamBlockUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{0}
+}
+func (m *StreamBlockUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlockUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlockUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlockUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlockUpdatesRequest.Merge(m, src)
+}
+func (m *StreamBlockUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlockUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlockUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlockUpdatesRequest proto.InternalMessageInfo
+
+func (m *StreamBlockUpdatesRequest) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *StreamBlockUpdatesRequest) GetPairs() []*Pair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *StreamBlockUpdatesRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// OrderBookLevelUpdate is the quantity resting at a price level after a block.
+// A zero quantity means that the level has been removed from the book.
+type OrderBookLevelUpdate struct {
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+}
+
+func (m *OrderBookLevelUpdate) Reset()         { *m = OrderBookLevelUpdate{} }
+func (m *OrderBookLevelUpdate) String() string { return proto.CompactTextString(m) }
+func (*OrderBookLevelUpdate) ProtoMessage()    {}
+func (*OrderBookLevelUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{1}
+}
+func (m *OrderBookLevelUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookLevelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookLevelUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookLevelUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookLevelUpdate.Merge(m, src)
+}
+func (m *OrderBookLevelUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookLevelUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookLevelUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookLevelUpdate proto.InternalMessageInfo
+
+type PairBlockUpdate struct {
+	PriceDenom string `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom string `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
+	Height     int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	// long levels that changed in the block, in descending price order
+	Longs []*OrderBookLevelUpdate `protobuf:"bytes,4,rep,name=longs,proto3" json:"longs"`
+	// short levels that changed in the block, in ascending price order
+	Shorts []*OrderBookLevelUpdate `protobuf:"bytes,5,rep,name=shorts,proto3" json:"shorts"`
+	Trades []*Trade                `protobuf:"bytes,6,rep,name=trades,proto3" json:"trades"`
+}
+
+func (m *PairBlockUpdate) Reset()         { *m = PairBlockUpdate{} }
+func (m *PairBlockUpdate) String() string { return proto.CompactTextString(m) }
+func (*PairBlockUpdate) ProtoMessage()    {}
+func (*PairBlockUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{2}
+}
+func (m *PairBlockUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairBlockUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairBlockUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairBlockUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairBlockUpdate.Merge(m, src)
+}
+func (m *PairBlockUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairBlockUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairBlockUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairBlockUpdate proto.InternalMessageInfo
+
+func (m *PairBlockUpdate) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *PairBlockUpdate) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *PairBlockUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PairBlockUpdate) GetLongs() []*OrderBookLevelUpdate {
+	if m != nil {
+		return m.Longs
+	}
+	return nil
+}
+
+func (m *PairBlockUpdate) GetShorts() []*OrderBookLevelUpdate {
+	if m != nil {
+		return m.Shorts
+	}
+	return nil
+}
+
+func (m *PairBlockUpdate) GetTrades() []*Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+type BlockUpdate struct {
+	Height       int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	ContractAddr string             `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	PairUpdates  []*PairBlockUpdate `protobuf:"bytes,3,rep,name=pairUpdates,proto3" json:"pair_updates"`
+}
+
+func (m *BlockUpdate) Reset()         { *m = BlockUpdate{} }
+func (m *BlockUpdate) String() string { return proto.CompactTextString(m) }
+func (*BlockUpdate) ProtoMessage()    {}
+func (*BlockUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{3}
+}
+func (m *BlockUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockUpdate.Merge(m, src)
+}
+func (m *BlockUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockUpdate proto.InternalMessageInfo
+
+func (m *BlockUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockUpdate) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *BlockUpdate) GetPairUpdates() []*PairBlockUpdate {
+	if m != nil {
+		return m.PairUpdates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamBlockUpdatesRequest)(nil), "seiprotocol.seichain.dex.StreamBlockUpdatesRequest")
+	proto.RegisterType((*OrderBookLevelUpdate)(nil), "seiprotocol.seichain.dex.OrderBookLevelUpdate")
+	proto.RegisterType((*PairBlockUpdate)(nil), "seiprotocol.seichain.dex.PairBlockUpdate")
+	proto.RegisterType((*BlockUpdate)(nil), "seiprotocol.seichain.dex.BlockUpdate")
+}
+
+func init() { proto.RegisterFile("dex/stream.proto", fileDescriptor_930408297a610595) }
+
+var fileDescriptor_930408297a610595 = []byte{
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3b, 0x8f, 0xd3, 0x4c,
+	0x14, 0x8d, 0x93, 0x2f, 0xd6, 0xb7, 0x93, 0x15, 0x89, 0x46, 0x29, 0x4c, 0x0a, 0x3b, 0x8a, 0x04,
+	0x0a, 0x45, 0x6c, 0x94, 0x2d, 0x40, 0x34, 0x08, 0xb3, 0x12, 0x14, 0xa0, 0x45, 0xc3, 0xa3, 0x40,
+	0x42, 0x91, 0xd7, 0x33, 0x8a, 0xad, 0x3c, 0x26, 0x3b, 0x33, 0x41, 0xd9, 0x06, 0x89, 0x7f, 0xc0,
+	0x1f, 0xa2, 0x5f, 0x51, 0x6d, 0x89, 0x28, 0x2c, 0x94, 0x74, 0xf9, 0x15, 0x68, 0xee, 0x38, 0x1b,
+	0xf3, 0xb0, 0x78, 0x54, 0x77, 0xe6, 0xf8, 0x9e, 0x73, 0xaf, 0xef, 0xf5, 0x31, 0x6a, 0x51, 0xb6,
+	0x0a, 0xa4, 0x12, 0x2c, 0x9a, 0xf9, 0x0b, 0xc1, 0x15, 0xc7, 0x8e, 0x64, 0x29, 0x9c, 0x62, 0x3e,
+	0xf5, 0x25, 0x4b, 0xe3, 0x24, 0x4a, 0xe7, 0x3e, 0x65, 0xab, 0x4e, 0x7b, 0xcc, 0xc7, 0x1c, 0x1e,
+	0x05, 0xfa, 0x64, 0xf2, 0x3b, 0xd7, 0xb4, 0xc2, 0x22, 0x4a, 0x45, 0x7e, 0x6f, 0xea, 0xbb, 0x12,
+	0x11, 0x65, 0x06, 0xe8, 0x7d, 0xb2, 0xd0, 0xf5, 0xe7, 0x50, 0x21, 0x9c, 0xf2, 0x78, 0xf2, 0x72,
+	0x41, 0x23, 0xc5, 0x24, 0x61, 0x67, 0x4b, 0x26, 0x15, 0xbe, 0x8b, 0x0e, 0x63, 0x3e, 0x57, 0x22,
+	0x8a, 0xd5, 0x03, 0x4a, 0x85, 0x63, 0x75, 0xad, 0xfe, 0x41, 0xd8, 0xde, 0x66, 0x5e, 0x6b, 0x87,
+	0x8f, 0x22, 0x4a, 0x05, 0x93, 0x92, 0x7c, 0x97, 0x89, 0xef, 0xa3, 0xba, 0x2e, 0x2b, 0x9d, 0x6a,
+	0xb7, 0xd6, 0x6f, 0x0c, 0x5d, 0xbf, 0xac, 0x71, 0xff, 0x59, 0x94, 0x8a, 0xf0, 0x60, 0x9b, 0x79,
+	0x86, 0x40, 0x4c, 0xc0, 0x43, 0xd4, 0x90, 0x2a, 0x12, 0xea, 0x31, 0x4b, 0xc7, 0x89, 0x72, 0x6a,
+	0x5d, 0xab, 0x5f, 0x0b, 0x5b, 0xdb, 0xcc, 0x3b, 0x04, 0x78, 0x94, 0x00, 0x4e, 0x8a, 0x49, 0xbd,
+	0x8f, 0x16, 0x6a, 0x9f, 0x08, 0xca, 0x44, 0xc8, 0xf9, 0xe4, 0x09, 0x7b, 0xcb, 0xa6, 0xe6, 0x7d,
+	0xf0, 0x53, 0x54, 0x5f, 0x88, 0x34, 0x66, 0xf9, 0x0b, 0xdc, 0xb9, 0xc8, 0xbc, 0xca, 0x97, 0xcc,
+	0xbb, 0x39, 0x4e, 0x55, 0xb2, 0x3c, 0xf5, 0x63, 0x3e, 0x0b, 0x62, 0x2e, 0x67, 0x5c, 0xe6, 0x61,
+	0x20, 0xe9, 0x24, 0x50, 0xe7, 0x0b, 0x26, 0xfd, 0x63, 0x16, 0x43, 0x6f, 0x9a, 0x4e, 0x4c, 0xc0,
+	0xaf, 0xd0, 0xff, 0x67, 0xcb, 0x68, 0xae, 0x52, 0x75, 0xee, 0x54, 0x41, 0xf1, 0xde, 0x5f, 0x2b,
+	0x5e, 0x29, 0x90, 0xab, 0x53, 0xef, 0x7d, 0x0d, 0x35, 0x61, 0x1c, 0xfb, 0x55, 0xe0, 0x00, 0x21,
+	0x28, 0x7a, 0xcc, 0xe6, 0x7c, 0x96, 0xf7, 0xdf, 0xdc, 0x66, 0x5e, 0x03, 0xd0, 0x11, 0xd5, 0x30,
+	0x29, 0xa4, 0x68, 0x42, 0x24, 0x25, 0x53, 0x86, 0x50, 0xdd, 0x13, 0x00, 0xdd, 0x11, 0xf6, 0x29,
+	0xb8, 0x87, 0xec, 0xa4, 0x38, 0x64, 0xb4, 0xcd, 0xbc, 0x1c, 0x21, 0x79, 0xc4, 0x27, 0xa8, 0x3e,
+	0xe5, 0xf3, 0xb1, 0x74, 0xfe, 0x83, 0x75, 0xfa, 0xe5, 0xeb, 0xfc, 0xd5, 0xfc, 0xcd, 0x7a, 0x41,
+	0x80, 0x98, 0x80, 0x09, 0xb2, 0x65, 0xc2, 0x85, 0x92, 0x4e, 0xfd, 0x9f, 0x14, 0xa1, 0x49, 0xa3,
+	0x40, 0xf2, 0x88, 0x1f, 0x22, 0x1b, 0x3e, 0x6d, 0xe9, 0xd8, 0xa0, 0xe9, 0x95, 0x6b, 0xbe, 0xd0,
+	0x79, 0x46, 0xc4, 0x50, 0x48, 0x1e, 0xb5, 0x21, 0x1a, 0xc5, 0xf9, 0xef, 0xa7, 0x63, 0x95, 0x4e,
+	0xe7, 0x47, 0x9b, 0x54, 0xff, 0xd8, 0x26, 0x6f, 0x50, 0x43, 0x7f, 0xee, 0xb9, 0xed, 0x9c, 0x1a,
+	0xf4, 0x7d, 0xeb, 0x37, 0x66, 0xd9, 0x77, 0x67, 0x0c, 0xa1, 0x15, 0x46, 0xcb, 0xdc, 0xb9, 0x45,
+	0xbd, 0xe1, 0x3b, 0x64, 0x1b, 0x73, 0x63, 0x85, 0xf0, 0xcf, 0x36, 0xc7, 0x47, 0xe5, 0x95, 0x4a,
+	0x7f, 0x0a, 0x9d, 0x1b, 0xe5, 0xa4, 0x42, 0x7a, 0xaf, 0x72, 0xdb, 0x0a, 0x1f, 0x5d, 0xac, 0x5d,
+	0xeb, 0x72, 0xed, 0x5a, 0x5f, 0xd7, 0xae, 0xf5, 0x61, 0xe3, 0x56, 0x2e, 0x37, 0x6e, 0xe5, 0xf3,
+	0xc6, 0xad, 0xbc, 0x1e, 0x14, 0x8c, 0x22, 0x59, 0x3a, 0xd8, 0xe9, 0xc1, 0x05, 0x04, 0x83, 0x55,
+	0x00, 0x3f, 0x2b, 0xed, 0x99, 0x53, 0x1b, 0x9e, 0x1f, 0x7d, 0x1b, 0x00, 0x53, 0x33, 0xac, 0xbb,
+	0x12, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// Streams order book level changes and fills of a contract's pairs block by
+	// block, starting at `startHeight` or at the next block if it is 0.
+	StreamBlockUpdates(ctx context.Context, in *StreamBlockUpdatesRequest, opts ...grpc.CallOption) (Stream_StreamBlockUpdatesClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) StreamBlockUpdates(ctx context.Context, in *StreamBlockUpdatesRequest, opts ...grpc.CallOption) (Stream_StreamBlockUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/seiprotocol.seichain.dex.Stream/StreamBlockUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamStreamBlockUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_StreamBlockUpdatesClient interface {
+	Recv() (*BlockUpdate, error)
+	grpc.ClientStream
+}
+
+type streamStreamBlockUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamStreamBlockUpdatesClient) Recv() (*BlockUpdate, error) {
+	m := new(BlockUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// Streams order book level changes and fills of a contract's pairs block by
+	// block, starting at `startHeight` or at the next block if it is 0.
+	StreamBlockUpdates(*StreamBlockUpdatesRequest, Stream_StreamBlockUpdatesServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) StreamBlockUpdates(req *StreamBlockUpdatesRequest, srv Stream_StreamBlockUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlockUpdates not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_StreamBlockUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlockUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).StreamBlockUpdates(m, &streamStreamBlockUpdatesServer{stream})
+}
+
+type Stream_StreamBlockUpdatesServer interface {
+	Send(*BlockUpdate) error
+	grpc.ServerStream
+}
+
+type streamStreamBlockUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamStreamBlockUpdatesServer) Send(m *BlockUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.dex.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlockUpdates",
+			Handler:       _Stream_StreamBlockUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dex/stream.proto",
+}
+
+func (m *StreamBlockUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlockUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlockUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookLevelUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookLevelUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookLevelUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairBlockUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairBlockUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairBlockUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Shorts) > 0 {
+		for iNdEx := len(m.Shorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairUpdates) > 0 {
+		for iNdEx := len(m.PairUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamBlockUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStream(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *OrderBookLevelUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func (m *PairBlockUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Shorts) > 0 {
+		for _, e := range m.Shorts {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if len(m.PairUpdates) > 0 {
+		for _, e := range m.PairUpdates {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamBlockUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlockUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlockUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &Pair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookLevelUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookLevelUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookLevelUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairBlockUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairBlockUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairBlockUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longs = append(m.Longs, &OrderBookLevelUpdate{})
+			if err := m.Longs[len(m.Longs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shorts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shorts = append(m.Shorts, &OrderBookLevelUpdate{})
+			if err := m.Shorts[len(m.Shorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairUpdates = append(m.PairUpdates, &PairBlockUpdate{})
+			if err := m.PairUpdates[len(m.PairUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
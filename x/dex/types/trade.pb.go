@@ -0,0 +1,793 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/trade.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Trade struct {
+	PriceDenom     string                                 `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom     string                                 `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
+	Height         uint64                                 `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	Timestamp      uint64                                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp"`
+	TakerOrderId   uint64                                 `protobuf:"varint,5,opt,name=takerOrderId,proto3" json:"taker_order_id"`
+	TakerAccount   string                                 `protobuf:"bytes,6,opt,name=takerAccount,proto3" json:"taker_account"`
+	MakerOrderId   uint64                                 `protobuf:"varint,7,opt,name=makerOrderId,proto3" json:"maker_order_id"`
+	MakerAccount   string                                 `protobuf:"bytes,8,opt,name=makerAccount,proto3" json:"maker_account"`
+	TakerDirection PositionDirection                      `protobuf:"varint,9,opt,name=takerDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"taker_direction"`
+	Price          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Sequence       uint64                                 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_534ab4dd86b8fc44, []int{0}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *Trade) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *Trade) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Trade) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Trade) GetTakerOrderId() uint64 {
+	if m != nil {
+		return m.TakerOrderId
+	}
+	return 0
+}
+
+func (m *Trade) GetTakerAccount() string {
+	if m != nil {
+		return m.TakerAccount
+	}
+	return ""
+}
+
+func (m *Trade) GetMakerOrderId() uint64 {
+	if m != nil {
+		return m.MakerOrderId
+	}
+	return 0
+}
+
+func (m *Trade) GetMakerAccount() string {
+	if m != nil {
+		return m.MakerAccount
+	}
+	return ""
+}
+
+func (m *Trade) GetTakerDirection() PositionDirection {
+	if m != nil {
+		return m.TakerDirection
+	}
+	return PositionDirection_LONG
+}
+
+func (m *Trade) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Trade)(nil), "seiprotocol.seichain.dex.Trade")
+}
+
+func init() { proto.RegisterFile("dex/trade.proto", fileDescriptor_534ab4dd86b8fc44) }
+
+var fileDescriptor_534ab4dd86b8fc44 = []byte{
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0x74, 0x5b, 0xdb, 0xd9, 0xee, 0x16, 0x47, 0x0f, 0xc3, 0x1e, 0x92, 0xb2, 0x07, 0x29,
+	0x2c, 0x4d, 0x40, 0x51, 0xc1, 0x9b, 0xa5, 0x20, 0x1e, 0x44, 0x09, 0xe2, 0xc1, 0x4b, 0xc9, 0x4e,
+	0x1e, 0xcd, 0xb0, 0x4e, 0xa6, 0x9b, 0x99, 0x40, 0xf7, 0x5f, 0xf8, 0xb3, 0xf6, 0x58, 0xf0, 0x22,
+	0x1e, 0x06, 0x69, 0x6f, 0xf9, 0x15, 0x32, 0x33, 0x6d, 0x93, 0x16, 0x3c, 0xec, 0x69, 0xbe, 0x7c,
+	0xef, 0xfb, 0xde, 0xf7, 0xe6, 0x0d, 0x41, 0x83, 0x14, 0x96, 0x91, 0x2a, 0x92, 0x14, 0xc2, 0x45,
+	0x21, 0x94, 0xc0, 0x44, 0x02, 0xb3, 0x88, 0x8a, 0x1f, 0xa1, 0x04, 0x46, 0xb3, 0x84, 0xe5, 0x61,
+	0x0a, 0xcb, 0x0b, 0x2b, 0x85, 0xbc, 0xe4, 0xd2, 0x49, 0x2f, 0x9e, 0xcf, 0xc5, 0x5c, 0x58, 0x18,
+	0x19, 0xe4, 0xd8, 0xcb, 0x5f, 0x6d, 0xd4, 0xfe, 0x6a, 0x1a, 0xe2, 0x08, 0xa1, 0x45, 0xc1, 0x28,
+	0x4c, 0x21, 0x17, 0x9c, 0x78, 0x43, 0x6f, 0xd4, 0x9b, 0x0c, 0x2a, 0x1d, 0x9c, 0x5a, 0x76, 0x96,
+	0x1a, 0x3a, 0x6e, 0x48, 0x8c, 0x21, 0x91, 0x12, 0x94, 0x33, 0x3c, 0xaa, 0x0d, 0x96, 0xdd, 0x19,
+	0x6a, 0x09, 0xbe, 0x44, 0x9d, 0x0c, 0xd8, 0x3c, 0x53, 0xe4, 0xf1, 0xd0, 0x1b, 0x9d, 0x4c, 0x50,
+	0xa5, 0x83, 0x2d, 0x13, 0x6f, 0x4f, 0x7c, 0x85, 0x7a, 0x8a, 0x71, 0x90, 0x2a, 0xe1, 0x0b, 0x72,
+	0x62, 0x65, 0x67, 0x95, 0x0e, 0x6a, 0x32, 0xae, 0x21, 0x7e, 0x83, 0xfa, 0x2a, 0xb9, 0x81, 0xe2,
+	0x73, 0x91, 0x42, 0xf1, 0x31, 0x25, 0x6d, 0xab, 0xc7, 0x95, 0x0e, 0xce, 0x2d, 0x3f, 0x13, 0xa6,
+	0x30, 0x63, 0x69, 0x7c, 0xa0, 0xc3, 0xaf, 0xb7, 0xbe, 0xf7, 0x94, 0x8a, 0x32, 0x57, 0xa4, 0x63,
+	0x67, 0x7f, 0x5a, 0xe9, 0xe0, 0xcc, 0xf9, 0x12, 0x57, 0x88, 0x0f, 0x64, 0x26, 0x8e, 0x37, 0xe3,
+	0x9e, 0xd4, 0x71, 0xfc, 0x28, 0x8e, 0x1f, 0xc5, 0xf1, 0x66, 0x5c, 0xb7, 0x8e, 0xe3, 0x87, 0x71,
+	0x4d, 0x19, 0xce, 0x90, 0xbb, 0xc5, 0x94, 0x15, 0x40, 0x15, 0x13, 0x39, 0xe9, 0x0d, 0xbd, 0xd1,
+	0xf9, 0xcb, 0xab, 0xf0, 0x7f, 0x8f, 0x1e, 0x7e, 0x11, 0x92, 0x19, 0xe5, 0xde, 0x32, 0x79, 0x56,
+	0xe9, 0x60, 0xe0, 0x2e, 0x95, 0xee, 0xc8, 0xf8, 0xa8, 0x2f, 0xfe, 0x84, 0xda, 0xf6, 0x5d, 0x09,
+	0xb2, 0x93, 0xbd, 0xbd, 0xd7, 0x41, 0xeb, 0x8f, 0x0e, 0x5e, 0xcc, 0x99, 0xca, 0xca, 0xeb, 0x90,
+	0x0a, 0x1e, 0x51, 0x21, 0xb9, 0x90, 0xdb, 0x63, 0x2c, 0xd3, 0x9b, 0x48, 0xdd, 0x2d, 0x40, 0x86,
+	0x53, 0xa0, 0x95, 0x0e, 0x9c, 0x3d, 0x76, 0x07, 0xfe, 0x86, 0xba, 0xb7, 0x65, 0x92, 0x2b, 0xa6,
+	0xee, 0xc8, 0xa9, 0xed, 0xf8, 0xee, 0xc1, 0x1d, 0xf7, 0x1d, 0xe2, 0x3d, 0xc2, 0x23, 0xd4, 0x95,
+	0x70, 0x5b, 0x42, 0x4e, 0x81, 0xf4, 0xed, 0xee, 0xfb, 0x46, 0xb9, 0xe3, 0xe2, 0x3d, 0x9a, 0x7c,
+	0xb8, 0x5f, 0xfb, 0xde, 0x6a, 0xed, 0x7b, 0x7f, 0xd7, 0xbe, 0xf7, 0x73, 0xe3, 0xb7, 0x56, 0x1b,
+	0xbf, 0xf5, 0x7b, 0xe3, 0xb7, 0xbe, 0x8f, 0x1b, 0x13, 0x48, 0x60, 0xe3, 0xdd, 0x1e, 0xed, 0x87,
+	0x5d, 0x64, 0xb4, 0x8c, 0xec, 0x5f, 0x66, 0x86, 0xb9, 0xee, 0xd8, 0xfa, 0xab, 0x7f, 0x03, 0x00,
+	0x32, 0x4e, 0x25, 0xdd, 0x79, 0x03, 0x00, 0x00,
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.TakerDirection != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.TakerDirection))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MakerAccount) > 0 {
+		i -= len(m.MakerAccount)
+		copy(dAtA[i:], m.MakerAccount)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.MakerAccount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MakerOrderId != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.MakerOrderId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TakerAccount) > 0 {
+		i -= len(m.TakerAccount)
+		copy(dAtA[i:], m.TakerAccount)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.TakerAccount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TakerOrderId != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.TakerOrderId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTrade(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTrade(uint64(m.Timestamp))
+	}
+	if m.TakerOrderId != 0 {
+		n += 1 + sovTrade(uint64(m.TakerOrderId))
+	}
+	l = len(m.TakerAccount)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	if m.MakerOrderId != 0 {
+		n += 1 + sovTrade(uint64(m.MakerOrderId))
+	}
+	l = len(m.MakerAccount)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	if m.TakerDirection != 0 {
+		n += 1 + sovTrade(uint64(m.TakerDirection))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovTrade(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovTrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrade(x uint64) (n int) {
+	return sovTrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderId", wireType)
+			}
+			m.TakerOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderId", wireType)
+			}
+			m.MakerOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerDirection", wireType)
+			}
+			m.TakerDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerDirection |= PositionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrade = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+const DexExecutionStatsContextKey MemStateKeyType = MemStateKeyType("dex-execution-stats")
+
+// ExecutionStatsCollector accumulates the execution stats of contracts during a
+// run of the dex EndBlocker. The stats are only persisted for contracts whose
+// run is persisted, the same way as their rent charges.
+type ExecutionStatsCollector struct {
+	mu    sync.Mutex
+	stats map[string]*types.ContractExecutionStats
+}
+
+func NewExecutionStatsCollector() *ExecutionStatsCollector {
+	return &ExecutionStatsCollector{stats: map[string]*types.ContractExecutionStats{}}
+}
+
+// Returns the collector on the context, or nil if execution stats are not collected.
+func GetExecutionStatsCollector(ctx context.Context) *ExecutionStatsCollector {
+	if val := ctx.Value(DexExecutionStatsContextKey); val != nil {
+		return val.(*ExecutionStatsCollector)
+	}
+	return nil
+}
+
+func (c *ExecutionStatsCollector) RecordSudoCall(contractAddr string, msgType string, gasUsed uint64, numOrders int, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.contractStats(contractAddr)
+	stats.AddSudoCall(msgType, gasUsed, failed)
+	stats.OrdersProcessed += uint64(numOrders)
+}
+
+func (c *ExecutionStatsCollector) RecordFailure(contractAddr string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contractStats(contractAddr).Failures++
+}
+
+// Get returns the collected stats of a contract, if any.
+func (c *ExecutionStatsCollector) Get(contractAddr string) (types.ContractExecutionStats, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats, ok := c.stats[contractAddr]
+	if !ok {
+		return types.ContractExecutionStats{}, false
+	}
+	return *stats, true
+}
+
+func (c *ExecutionStatsCollector) contractStats(contractAddr string) *types.ContractExecutionStats {
+	stats, ok := c.stats[contractAddr]
+	if !ok {
+		stats = &types.ContractExecutionStats{ContractAddr: contractAddr, SudoCallStats: []types.SudoCallStats{}}
+		c.stats[contractAddr] = stats
+	}
+	return stats
+}
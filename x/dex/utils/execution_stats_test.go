@@ -0,0 +1,35 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionStatsCollector(t *testing.T) {
+	require.Nil(t, utils.GetExecutionStatsCollector(context.Background()))
+	collector := utils.NewExecutionStatsCollector()
+	ctx := context.WithValue(context.Background(), utils.DexExecutionStatsContextKey, collector)
+	require.Equal(t, collector, utils.GetExecutionStatsCollector(ctx))
+
+	collector.RecordSudoCall("contract", "deposit", 10, 0, false)
+	collector.RecordSudoCall("contract", "bulk_order_placements", 20, 3, false)
+	collector.RecordSudoCall("contract", "bulk_order_placements", 5, 1, true)
+	collector.RecordFailure("contract")
+
+	stats, ok := collector.Get("contract")
+	require.True(t, ok)
+	require.Equal(t, "contract", stats.ContractAddr)
+	require.Equal(t, uint64(4), stats.OrdersProcessed)
+	require.Equal(t, uint64(1), stats.Failures)
+	require.Equal(t, []types.SudoCallStats{
+		{MsgType: "deposit", Calls: 1, GasUsed: 10},
+		{MsgType: "bulk_order_placements", Calls: 2, GasUsed: 25, Failures: 1},
+	}, stats.SudoCallStats)
+
+	_, ok = collector.Get("other")
+	require.False(t, ok)
+}
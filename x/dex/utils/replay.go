@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+const DexReplayRecorderContextKey MemStateKeyType = MemStateKeyType("dex-replay-recorder")
+
+// ReplayRecorder collects a detailed trace of the dex EndBlocker when a block is
+// replayed offline. Its presence on the context also makes the EndBlocker run
+// contracts sequentially in a deterministic order. It is never set on contexts
+// used by a running node.
+type ReplayRecorder struct {
+	mu       sync.Mutex
+	attempt  int
+	attempts []*ReplayAttempt
+}
+
+// ReplayAttempt is the trace of a single `EndBlockerAtomic` run. The dex EndBlocker
+// may run it multiple times in a block if some contracts fail.
+type ReplayAttempt struct {
+	Attempt   int                              `json:"attempt"`
+	Contracts map[string]*ContractReplayRecord `json:"contracts"`
+}
+
+type ContractReplayRecord struct {
+	GasUsed     uint64                   `json:"gas_used"`
+	SudoCalls   []SudoCallRecord         `json:"sudo_calls"`
+	Settlements []*types.SettlementEntry `json:"settlements"`
+	Errors      []string                 `json:"errors"`
+}
+
+type SudoCallRecord struct {
+	Type         string          `json:"type"`
+	Request      json.RawMessage `json:"request"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	GasUsed      uint64          `json:"gas_used"`
+	GasAllowance uint64          `json:"gas_allowance"`
+	Error        string          `json:"error,omitempty"`
+}
+
+func NewReplayRecorder() *ReplayRecorder {
+	return &ReplayRecorder{}
+}
+
+// Returns the recorder on the context, or nil if the block is not being replayed.
+func GetReplayRecorder(ctx context.Context) *ReplayRecorder {
+	if val := ctx.Value(DexReplayRecorderContextKey); val != nil {
+		return val.(*ReplayRecorder)
+	}
+	return nil
+}
+
+func (r *ReplayRecorder) StartAttempt() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempt++
+	r.attempts = append(r.attempts, &ReplayAttempt{Attempt: r.attempt, Contracts: map[string]*ContractReplayRecord{}})
+}
+
+func (r *ReplayRecorder) RecordSudoCall(contractAddr string, call SudoCallRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record := r.contractRecord(contractAddr)
+	record.GasUsed += call.GasUsed
+	record.SudoCalls = append(record.SudoCalls, call)
+}
+
+func (r *ReplayRecorder) RecordSettlements(contractAddr string, settlements []*types.SettlementEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record := r.contractRecord(contractAddr)
+	record.Settlements = append(record.Settlements, settlements...)
+}
+
+func (r *ReplayRecorder) RecordError(contractAddr string, err string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record := r.contractRecord(contractAddr)
+	record.Errors = append(record.Errors, err)
+}
+
+// Attempts returns the recorded attempts in the order they ran.
+func (r *ReplayRecorder) Attempts() []*ReplayAttempt {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*ReplayAttempt{}, r.attempts...)
+}
+
+func (r *ReplayRecorder) contractRecord(contractAddr string) *ContractReplayRecord {
+	if len(r.attempts) == 0 {
+		r.attempt++
+		r.attempts = append(r.attempts, &ReplayAttempt{Attempt: r.attempt, Contracts: map[string]*ContractReplayRecord{}})
+	}
+	attempt := r.attempts[len(r.attempts)-1]
+	record, ok := attempt.Contracts[contractAddr]
+	if !ok {
+		record = &ContractReplayRecord{SudoCalls: []SudoCallRecord{}, Settlements: []*types.SettlementEntry{}, Errors: []string{}}
+		attempt.Contracts[contractAddr] = record
+	}
+	return record
+}
@@ -0,0 +1,35 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayRecorder(t *testing.T) {
+	require.Nil(t, utils.GetReplayRecorder(context.Background()))
+	recorder := utils.NewReplayRecorder()
+	ctx := context.WithValue(context.Background(), utils.DexReplayRecorderContextKey, recorder)
+	require.Equal(t, recorder, utils.GetReplayRecorder(ctx))
+
+	recorder.StartAttempt()
+	recorder.RecordSudoCall("contract", utils.SudoCallRecord{Type: "settlement", GasUsed: 10})
+	recorder.RecordSudoCall("contract", utils.SudoCallRecord{Type: "bulk_order_placements", GasUsed: 5})
+	recorder.RecordSettlements("contract", []*types.SettlementEntry{{OrderId: 1}})
+	recorder.RecordError("contract", errors.New("failed").Error())
+	recorder.StartAttempt()
+	recorder.RecordSudoCall("contract", utils.SudoCallRecord{Type: "settlement", GasUsed: 3})
+
+	attempts := recorder.Attempts()
+	require.Len(t, attempts, 2)
+	require.Equal(t, 1, attempts[0].Attempt)
+	require.Equal(t, uint64(15), attempts[0].Contracts["contract"].GasUsed)
+	require.Len(t, attempts[0].Contracts["contract"].SudoCalls, 2)
+	require.Len(t, attempts[0].Contracts["contract"].Settlements, 1)
+	require.Equal(t, []string{"failed"}, attempts[0].Contracts["contract"].Errors)
+	require.Equal(t, uint64(3), attempts[1].Contracts["contract"].GasUsed)
+}
@@ -29,14 +29,11 @@ const (
 
 // GenerateGenesisState creates a randomized GenState of the module
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	epochGenesis := types.GenesisState{
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&epochGenesis)
+	epochGenesis := types.DefaultGenesis()
+	// start the first epoch at the simulated genesis time rather than the wall clock
+	epochGenesis.Epoch.GenesisTime = simState.GenTimestamp
+	epochGenesis.Epoch.CurrentEpochStartTime = simState.GenTimestamp
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochGenesis)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
@@ -23,8 +23,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	for i := 1; i <= 10; i++ {
 		scheduledTokenRelease := types.ScheduledTokenRelease{
-			StartDate:          currentDate.AddDate(1, 0, 0).Format(types.TokenReleaseDateFormat),
-			EndDate:            currentDate.AddDate(3, 0, 0).Format(types.TokenReleaseDateFormat),
+			StartDate:          currentDate.AddDate(2*i-1, 0, 0).Format(types.TokenReleaseDateFormat),
+			EndDate:            currentDate.AddDate(2*i+1, 0, 0).Format(types.TokenReleaseDateFormat),
 			TokenReleaseAmount: randomProvision / uint64(i),
 		}
 		tokenReleaseSchedule = append(tokenReleaseSchedule, scheduledTokenRelease)
@@ -46,9 +46,12 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		voteTargets := make(map[string]types.Denom)
+		// voteTargets is pruned of the ballots below threshold, keep the policies of all the denoms for their tally
+		denomPolicies := make(map[string]types.Denom)
 		totalTargets := 0
 		k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
 			voteTargets[denom] = denomInfo
+			denomPolicies[denom] = denomInfo
 			totalTargets++
 			return false
 		})
@@ -67,6 +70,9 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 			exchangeRateRD := ballotRD.WeightedMedianWithAssertion()
 
+			// twaps the tallied exchange rates are checked against by the circuit breakers
+			twaps := k.GetCircuitBreakerTwaps(ctx, denomPolicies)
+
 			// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
 			keys := make([]string, len(voteMap))
 			j := 0
@@ -83,19 +89,24 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				exchangeRate := Tally(ctx, ballot, denomPolicies[denom].EffectiveRewardBand(params.RewardBand), validatorClaimMap)
 
 				// Transform into the original form base/quote
 				if denom != referenceDenom {
 					exchangeRate = exchangeRateRD.Quo(exchangeRate)
 				}
 
-				// Set the exchange rate, emit ABCI event
-				metrics.IncrPriceUpdateDenom(denom)
-				k.SetBaseExchangeRateWithEvent(ctx, denom, exchangeRate)
+				// Set the exchange rate unless held or halted by the circuit breaker, emit ABCI event
+				if k.SetTalliedExchangeRate(ctx, denomPolicies[denom], exchangeRate, twaps) {
+					metrics.IncrPriceUpdateDenom(denom)
+				}
 			}
 		}
 
+		// Distribute rewards to ballot winners. This happens before the assets below
+		// threshold are tallied so that only votes on passing ballots earn weight.
+		k.RewardBallotWinners(ctx, validatorClaimMap)
+
 		belowThresholdKeys := make([]string, len(belowThresholdVoteMap))
 		n := 0
 		for denom := range belowThresholdVoteMap {
@@ -107,7 +118,7 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		for _, denom := range belowThresholdKeys {
 			ballot := belowThresholdVoteMap[denom]
 			// perform tally for below threshold assets to calculate total win count
-			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+			Tally(ctx, ballot, denomPolicies[denom].EffectiveRewardBand(params.RewardBand), validatorClaimMap)
 		}
 
 		//---------------------------
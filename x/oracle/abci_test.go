@@ -634,6 +634,192 @@ func TestOraclePriceSnapshot(t *testing.T) {
 	require.Equal(t, expected2, input.OracleKeeper.GetPriceSnapshot(input.Ctx, 200))
 }
 
+func TestOracleRewardDistribution(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.RewardDistributionWindow = 10
+	params.RewardPoolFraction = sdk.OneDec()
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	rewardPool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000))
+	require.NoError(t, keeper.FundAccount(input, keeper.Addrs[3], rewardPool))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[3], types.ModuleName, rewardPool))
+
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 1)
+	// out of the reward band for atom, and alone on the eth ballot that does not pass
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: anotherRandomExchangeRate}, {Denom: utils.MicroEthDenom, Amount: randomExchangeRate}}, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// 10% of the pool is released for a single vote period and split among the winners
+	expectedReward := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroSeiDenom, 50))
+	require.Equal(t, expectedReward, input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, expectedReward, input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[1]))
+	require.True(t, input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[2]).IsZero())
+	require.Equal(t, sdk.NewInt64Coin(utils.MicroSeiDenom, 900), input.OracleKeeper.GetRewardPool(input.Ctx, utils.MicroSeiDenom))
+}
+
+func TestOracleDenomPolicy(t *testing.T) {
+	input, h := setup(t)
+
+	voteThreshold := sdk.NewDecWithPrec(9, 1)
+	rewardBand := sdk.OneDec()
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: utils.MicroAtomDenom, MinVoters: 3, RewardBand: &rewardBand},
+		{Name: utils.MicroEthDenom, VoteThreshold: &voteThreshold},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	for _, denom := range params.Whitelist {
+		input.OracleKeeper.SetVoteTargetDenom(input.Ctx, denom)
+	}
+
+	// Case 1.
+	// Two thirds of the voting power votes, which passes the global threshold but not the denom policies
+	for i := 0; i < 2; i++ {
+		makeAggregateVote(t, input, h, 1, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}, {Denom: utils.MicroEthDenom, Amount: randomExchangeRate}}, i)
+	}
+
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	_, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.Error(t, err)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.Error(t, err)
+
+	// Case 2.
+	// All the validators vote, the third one far from the others on atom but within the atom reward band.
+	// Its eth vote keeps the same cross rate to atom as the other votes.
+	farExchangeRate := randomExchangeRate.Mul(sdk.NewDecWithPrec(14, 1))
+	for i := 0; i < 2; i++ {
+		makeAggregateVote(t, input, h, 3, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}, {Denom: utils.MicroEthDenom, Amount: randomExchangeRate}}, i)
+	}
+	makeAggregateVote(t, input, h, 3, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: farExchangeRate}, {Denom: utils.MicroEthDenom, Amount: farExchangeRate}}, 2)
+
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(3), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(3), input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	rate, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[2]))
+}
+
+func TestOracleCircuitBreaker(t *testing.T) {
+	input, h := setup(t)
+
+	maxRateChange := sdk.NewDecWithPrec(1, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom, MaxRateChange: &maxRateChange, ConfirmationPeriods: 1}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, params.Whitelist[0])
+
+	// Case 1.
+	// The first exchange rate has no previous rate to be checked against
+	for i := 0; i < 3; i++ {
+		makeAggregateVote(t, input, h, 1, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, i)
+	}
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	// Case 2.
+	// A doubled exchange rate breaches the max rate change and is held, the votes are still rewarded
+	doubledExchangeRate := randomExchangeRate.MulInt64(2)
+	for i := 0; i < 3; i++ {
+		makeAggregateVote(t, input, h, 3, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: doubledExchangeRate}}, i)
+	}
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(3), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(3), input.OracleKeeper)
+
+	rate, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	status, tripped := input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.True(t, tripped)
+	require.Equal(t, doubledExchangeRate, status.HeldExchangeRate)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[0]))
+
+	// Case 3.
+	// The doubled exchange rate is set once confirmed by the next vote period
+	for i := 0; i < 3; i++ {
+		makeAggregateVote(t, input, h, 5, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: doubledExchangeRate}}, i)
+	}
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(5), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(5), input.OracleKeeper)
+
+	rate, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, doubledExchangeRate, rate)
+	_, tripped = input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.False(t, tripped)
+}
+
+func TestOracleCommitReveal(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevote(t, input, h, 0, "salt", rates, i)
+	}
+
+	// the third validator reveals different exchange rates than the ones it committed to
+	makeAggregateReveal(t, input, h, 1, "salt", rates, 0)
+	makeAggregateReveal(t, input, h, 1, "salt", rates, 1)
+	makeAggregateReveal(t, input, h, 1, "salt", sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: anotherRandomExchangeRate}}, 2)
+
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[1]))
+	// the mismatching reveal is not counted in the ballot
+	require.Equal(t, uint64(0), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetAbstainCount(input.Ctx, keeper.ValAddrs[2]))
+
+	// the revealed prevotes are cleared with the ballot
+	for i := 0; i < 3; i++ {
+		_, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[i], 0)
+		require.Error(t, err)
+	}
+}
+
+func makeAggregatePrevote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, salt string, rates sdk.DecCoins, idx int) {
+	exchangeRates, err := types.ParseExchangeRateTuples(rates.String())
+	require.NoError(t, err)
+	hash := types.GetAggregateVoteHash(salt, exchangeRates, keeper.ValAddrs[idx])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[idx], keeper.ValAddrs[idx])
+	_, err = h(input.Ctx.WithBlockHeight(height), prevoteMsg)
+	require.NoError(t, err)
+}
+
+func makeAggregateReveal(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, salt string, rates sdk.DecCoins, idx int) {
+	voteMsg := types.NewMsgAggregateExchangeRateReveal(salt, rates.String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
+	_, err := h(input.Ctx.WithBlockHeight(height), voteMsg)
+	require.NoError(t, err)
+}
+
 func makeAggregateVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	voteMsg := types.NewMsgAggregateExchangeRateVote(rates.String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
 	_, err := h(input.Ctx.WithBlockHeight(height), voteMsg)
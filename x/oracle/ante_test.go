@@ -20,6 +20,7 @@ import (
 func TestOracleVoteAloneAnteHandler(t *testing.T) {
 
 	testOracleMsg := oracletypes.MsgAggregateExchangeRateVote{}
+	testOraclePrevoteMsg := oracletypes.MsgAggregateExchangeRatePrevote{}
 	testNonOracleMsg := banktypes.MsgSend{}
 	testNonOracleMsg2 := banktypes.MsgSend{}
 
@@ -31,8 +32,10 @@ func TestOracleVoteAloneAnteHandler(t *testing.T) {
 		tx     sdk.Tx
 	}{
 		{"only oracle vote", false, app.NewTestTx([]sdk.Msg{&testOracleMsg})},
+		{"oracle prevote and vote", false, app.NewTestTx([]sdk.Msg{&testOraclePrevoteMsg, &testOracleMsg})},
 		{"only non-oracle msgs", false, app.NewTestTx([]sdk.Msg{&testNonOracleMsg, &testNonOracleMsg2})},
 		{"mixed messages", true, app.NewTestTx([]sdk.Msg{&testNonOracleMsg, &testOracleMsg, &testNonOracleMsg2})},
+		{"mixed prevote messages", true, app.NewTestTx([]sdk.Msg{&testOraclePrevoteMsg, &testNonOracleMsg})},
 	}
 
 	for _, tc := range testCases {
@@ -91,6 +94,41 @@ func TestSpammingPreventionAnteHandler(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSpammingPreventionPrevoteAnteHandler(t *testing.T) {
+	input, _ := setup(t)
+
+	exchangeRates, err := types.ParseExchangeRateTuples(randomExchangeRate.String() + utils.MicroAtomDenom)
+	require.NoError(t, err)
+	hash := types.GetAggregateVoteHash("salt", exchangeRates, keeper.ValAddrs[0])
+
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+	invalidPrevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[3], keeper.ValAddrs[2])
+	voteMsg := types.NewMsgAggregateExchangeRateVote(randomExchangeRate.String()+utils.MicroAtomDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+
+	spd := oracle.NewSpammingPreventionDecorator(input.OracleKeeper)
+	anteHandler, _ := sdk.ChainAnteDecorators(spd)
+
+	ctx := input.Ctx.WithIsCheckTx(true)
+	_, err = anteHandler(ctx, app.NewTestTx([]sdk.Msg{prevoteMsg}), false)
+	require.NoError(t, err)
+
+	// invalid because bad feeder val combo
+	_, err = anteHandler(ctx, app.NewTestTx([]sdk.Msg{invalidPrevoteMsg}), false)
+	require.Error(t, err)
+
+	// a second prevote at the same height fails
+	_, err = anteHandler(ctx, app.NewTestTx([]sdk.Msg{prevoteMsg}), false)
+	require.Error(t, err)
+
+	// prevotes and votes are tracked separately
+	_, err = anteHandler(ctx, app.NewTestTx([]sdk.Msg{voteMsg}), false)
+	require.NoError(t, err)
+
+	// a prevote at the next height succeeds
+	_, err = anteHandler(ctx.WithBlockHeight(ctx.BlockHeight()+1), app.NewTestTx([]sdk.Msg{prevoteMsg}), false)
+	require.NoError(t, err)
+}
+
 func TestSpammingPreventionAnteDeps(t *testing.T) {
 	input, _ := setup(t)
 
@@ -28,11 +28,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryExchangeRates(),
 		GetCmdQueryPriceSnapshotHistory(),
 		GetCmdQueryTwaps(),
+		GetCmdQueryPriceStats(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryVotePenaltyCounter(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryProjectedRewards(),
+		GetCmdQueryCircuitBreakerStatus(),
 	)
 
 	return oracleQueryCmd
@@ -157,6 +160,50 @@ $ seid query oracle twaps
 	return cmd
 }
 
+func GetCmdQueryPriceStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-stats [lookback-seconds] [half-life-seconds]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the ema, realized volatility, high, low and sample count of the price snapshots of denoms",
+		Long: strings.TrimSpace(`
+Query the ema, realized volatility, high, low and sample count of the price snapshots of denoms within the lookback window,
+the weight of a snapshot price in the ema halves with every half-life of snapshot age
+Example:
+
+$ seid query oracle price-stats 3600 600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			halfLifeSeconds, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceStats(
+				context.Background(),
+				&types.QueryPriceStatsRequest{LookbackSeconds: lookbackSeconds, HalfLifeSeconds: halfLifeSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -322,3 +369,94 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryProjectedRewards implements the query projected rewards command.
+func GetCmdQueryProjectedRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-rewards [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the oracle rewards paid out at the end of the current vote period",
+		Long: strings.TrimSpace(`
+Query the oracle reward pool and the rewards split among ballot winners at the end of the current vote period.
+
+$ seid query oracle projected-rewards
+
+Or, can project the rewards of a validator assuming every bonded validator votes within the reward band
+
+$ seid query oracle projected-rewards seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProjectedRewardsRequest{}
+			if len(args) == 1 {
+				validator, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = validator.String()
+			}
+
+			res, err := queryClient.ProjectedRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCircuitBreakerStatus implements the query circuit breaker status command.
+func GetCmdQueryCircuitBreakerStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker-status [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query whether exchange rates are held or halted by their circuit breaker",
+		Long: strings.TrimSpace(`
+Query all the denoms whose exchange rate is held or halted by their circuit breaker.
+
+$ seid query oracle circuit-breaker-status
+
+Or, can filter with denom
+
+$ seid query oracle circuit-breaker-status uatom
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.CircuitBreakerStatuses(context.Background(), &types.QueryCircuitBreakerStatusesRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.CircuitBreakerStatus(
+				context.Background(),
+				&types.QueryCircuitBreakerStatusRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -28,12 +28,16 @@ func GetTxCmd() *cobra.Command {
 
 	oracleTxCmd.AddCommand(
 		GetCmdDelegateFeederPermission(),
+		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
 	)
 
 	return oracleTxCmd
 }
 
+// FlagSalt is the salt revealed by an aggregate vote when commit-reveal voting is enabled
+const FlagSalt = "salt"
+
 // GetCmdDelegateFeederPermission will create a feeder permission delegation tx and sign it with the given key.
 func GetCmdDelegateFeederPermission() *cobra.Command {
 	cmd := &cobra.Command{
@@ -83,6 +87,70 @@ where "terra1..." is the address you want to delegate your voting rights to.
 	return cmd
 }
 
+// GetCmdAggregateExchangeRatePrevote will create a aggregateExchangeRatePrevote tx and sign it with the given key.
+func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote for the exchange_rates of the base denom",
+		Long: strings.TrimSpace(`
+Submit an aggregate prevote with the hash of the exchange rates to be revealed in the next vote period.
+Prevotes are only accepted when commit-reveal voting is enabled.
+
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr
+
+where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro USD in micro denoms from the voter's point of view.
+The exchange rates are revealed in the next vote period with the same salt:
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr --salt 1234
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			exchangeRatesStr := args[1]
+			exchangeRates, err := types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rate {%s} is not a valid format; exchange rate should be formatted as DecCoin; %s", exchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(salt, exchangeRates, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRatePrevote(hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAggregateExchangeRateVote will create a aggregateExchangeRateVote tx and sign it with the given key.
 func GetCmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -98,6 +166,8 @@ where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" i
 
 If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
 $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+
+If commit-reveal voting is enabled, set "--salt" to the salt of the prevote submitted in the previous vote period.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -126,7 +196,12 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 				validator = parsedVal
 			}
 
-			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)}
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateReveal(salt, exchangeRatesStr, voter, validator)}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
 					return err
@@ -137,6 +212,7 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 		},
 	}
 
+	cmd.Flags().String(FlagSalt, "", "salt of the prevote revealed by this vote, if commit-reveal voting is enabled")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
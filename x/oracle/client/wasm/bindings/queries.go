@@ -7,4 +7,8 @@ type SeiOracleQuery struct {
 	ExchangeRates *types.QueryExchangeRatesRequest `json:"exchange_rates,omitempty"`
 	// queries the oracle TWAPs
 	OracleTwaps *types.QueryTwapsRequest `json:"oracle_twaps,omitempty"`
+	// queries the oracle price stats
+	OraclePriceStats *types.QueryPriceStatsRequest `json:"oracle_price_stats,omitempty"`
+	// queries whether the exchange rate of a denom is held or halted by its circuit breaker
+	CircuitBreakerStatus *types.QueryCircuitBreakerStatusRequest `json:"circuit_breaker_status,omitempty"`
 }
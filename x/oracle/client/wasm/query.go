@@ -27,3 +27,15 @@ func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types
 	c := sdk.WrapSDKContext(ctx)
 	return querier.Twaps(c, req)
 }
+
+func (handler OracleWasmQueryHandler) GetOraclePriceStats(ctx sdk.Context, req *types.QueryPriceStatsRequest) (*types.QueryPriceStatsResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.PriceStats(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetCircuitBreakerStatus(ctx sdk.Context, req *types.QueryCircuitBreakerStatusRequest) (*types.QueryCircuitBreakerStatusResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.CircuitBreakerStatus(c, req)
+}
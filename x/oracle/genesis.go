@@ -49,10 +49,23 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, ap := range data.AggregateExchangeRatePrevotes {
+		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddr, ap)
+	}
+
 	for _, priceSnapshot := range data.PriceSnapshots {
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
 	}
 
+	for _, status := range data.CircuitBreakerStatuses {
+		keeper.SetCircuitBreakerStatus(ctx, status)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -95,12 +108,24 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(_ sdk.ValAddress, _ uint64, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	priceSnapshots := types.PriceSnapshots{}
 	keeper.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
 		priceSnapshots = append(priceSnapshots, snapshot)
 		return false
 	})
 
+	circuitBreakerStatuses := []types.CircuitBreakerStatus{}
+	keeper.IterateCircuitBreakerStatuses(ctx, func(status types.CircuitBreakerStatus) bool {
+		circuitBreakerStatuses = append(circuitBreakerStatuses, status)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -108,5 +133,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		penaltyCounters,
 		aggregateExchangeRateVotes,
 		priceSnapshots,
+		aggregateExchangeRatePrevotes,
+		circuitBreakerStatuses,
 	)
 }
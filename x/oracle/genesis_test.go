@@ -22,6 +22,7 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetVoteTarget(input.Ctx, "denom2")
 	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, keeper.ValAddrs[0], 2, 3, 0)
 	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, keeper.ValAddrs[1], 4, 5, 0)
+	input.OracleKeeper.SetCircuitBreakerStatus(input.Ctx, types.CircuitBreakerStatus{Denom: "denom", HeldExchangeRate: sdk.NewDec(246), Confirmations: 1})
 	input.OracleKeeper.AddPriceSnapshot(input.Ctx, types.NewPriceSnapshot(
 		types.PriceSnapshotItems{
 			{
@@ -67,4 +68,5 @@ func TestExportInitGenesis(t *testing.T) {
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.Len(t, newGenesis.CircuitBreakerStatuses, 1)
 }
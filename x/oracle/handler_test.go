@@ -68,3 +68,50 @@ func TestFeederDelegation(t *testing.T) {
 	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestCommitReveal(t *testing.T) {
+	input, h := setup(t)
+	exchangeRates := randomExchangeRate.String() + utils.MicroAtomDenom
+	exchangeRateTuples, err := types.ParseExchangeRateTuples(exchangeRates)
+	require.NoError(t, err)
+	hash := types.GetAggregateVoteHash("1234", exchangeRateTuples, keeper.ValAddrs[0])
+
+	// Case 1: prevote fails when commit-reveal is disabled
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.ErrorIs(t, err, types.ErrCommitRevealDisabled)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Case 2: prevote from a non-delegated feeder fails
+	prevoteMsg = types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[1], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.Error(t, err)
+
+	// Case 3: vote without a prevote in the previous vote period fails
+	voteMsg := types.NewMsgAggregateExchangeRateReveal("1234", exchangeRates, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// Case 4: normal prevote succeeds
+	prevoteMsg = types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.NoError(t, err)
+
+	// Case 5: vote in the same vote period as the prevote fails
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// Case 6: vote without a salt fails
+	_, err = h(input.Ctx.WithBlockHeight(2), types.NewMsgAggregateExchangeRateVote(exchangeRates, keeper.Addrs[0], keeper.ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrInvalidSaltLength)
+
+	// Case 7: vote in the next vote period succeeds
+	_, err = h(input.Ctx.WithBlockHeight(2), voteMsg)
+	require.NoError(t, err)
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, "1234", vote.Salt)
+}
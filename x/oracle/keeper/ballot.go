@@ -14,8 +14,16 @@ import (
 func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[string]types.Claim) (votes map[string]types.ExchangeRateBallot) {
 	votes = map[string]types.ExchangeRateBallot{}
 
+	commitRevealEnabled := k.CommitRevealEnabled(ctx)
+	period := uint64(ctx.BlockHeight()) / k.VotePeriod(ctx)
+
 	// Organize aggregate votes
 	aggregateHandler := func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+		// with commit-reveal, only count votes that reveal the prevote of the previous vote period
+		if commitRevealEnabled && !k.isValidReveal(ctx, voterAddr, vote, period) {
+			return false
+		}
+
 		// organize ballot only for the active validators
 		claim, ok := validatorClaimMap[vote.Voter]
 
@@ -54,16 +62,38 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all tallied votes from the store
-func (k Keeper) ClearBallots(ctx sdk.Context, _ uint64) {
+// isValidReveal checks that the vote matches the hash of the voter's prevote from the previous vote period
+func (k Keeper) isValidReveal(ctx sdk.Context, voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote, period uint64) bool {
+	if period == 0 {
+		return false
+	}
+	prevote, err := k.GetAggregateExchangeRatePrevote(ctx, voterAddr, period-1)
+	if err != nil {
+		return false
+	}
+	hash := types.GetAggregateVoteHash(vote.Salt, vote.ExchangeRateTuples, voterAddr)
+	return hash.String() == prevote.Hash
+}
+
+// ClearBallots clears all tallied votes and the revealed prevotes from the store
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool) {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
 		return false
 	})
+
+	// Clear the prevotes of past vote periods, prevotes of the current period are revealed in the next one
+	period := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, prevotePeriod uint64, _ types.AggregateExchangeRatePrevote) (stop bool) {
+		if prevotePeriod < period {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr, prevotePeriod)
+		}
+		return false
+	})
 }
 
-// ApplyWhitelist update vote target denom list with params whitelist
+// ApplyWhitelist update vote target denom list and their voting policies with params whitelist
 func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteTargets map[string]types.Denom) {
 	// check is there any update in whitelist params
 	updateRequired := false
@@ -71,7 +101,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		updateRequired = true
 	} else {
 		for _, item := range whitelist {
-			if _, ok := voteTargets[item.Name]; !ok {
+			if voteTarget, ok := voteTargets[item.Name]; !ok || !voteTarget.PolicyEqual(item) {
 				updateRequired = true
 				break
 			}
@@ -82,7 +112,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		k.ClearVoteTargets(ctx)
 
 		for _, item := range whitelist {
-			k.SetVoteTarget(ctx, item.Name)
+			k.SetVoteTargetDenom(ctx, item)
 
 			// Register meta data to bank module
 			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name); !ok {
@@ -156,3 +156,24 @@ func TestApplyWhitelist(t *testing.T) {
 	require.Equal(t, len(metadata.DenomUnits), 3)
 	require.Equal(t, metadata.Description, "usdc")
 }
+
+func TestApplyWhitelistPolicyUpdate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	whitelist := types.DenomList{{Name: "uatom"}}
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, whitelist, map[string]types.Denom{})
+	voteTargets := map[string]types.Denom{}
+	input.OracleKeeper.IterateVoteTargets(input.Ctx, func(denom string, denomInfo types.Denom) bool {
+		voteTargets[denom] = denomInfo
+		return false
+	})
+
+	// a policy change on the same denoms updates the vote targets
+	whitelist = types.DenomList{{Name: "uatom", MinVoters: 2, MaxAge: 60}}
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, whitelist, voteTargets)
+
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), voteTarget.MinVoters)
+	require.Equal(t, uint64(60), voteTarget.MaxAge)
+}
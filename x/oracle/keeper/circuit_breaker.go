@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// GetCircuitBreakerStatus returns the circuit breaker status of a denom, and whether its breaker is tripped
+func (k Keeper) GetCircuitBreakerStatus(ctx sdk.Context, denom string) (types.CircuitBreakerStatus, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCircuitBreakerStatusKey(denom))
+	if bz == nil {
+		return types.CircuitBreakerStatus{Denom: denom, HeldExchangeRate: sdk.ZeroDec()}, false
+	}
+
+	status := types.CircuitBreakerStatus{}
+	k.cdc.MustUnmarshal(bz, &status)
+	return status, true
+}
+
+func (k Keeper) SetCircuitBreakerStatus(ctx sdk.Context, status types.CircuitBreakerStatus) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(types.GetCircuitBreakerStatusKey(status.Denom), bz)
+}
+
+func (k Keeper) DeleteCircuitBreakerStatus(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCircuitBreakerStatusKey(denom))
+}
+
+func (k Keeper) IterateCircuitBreakerStatuses(ctx sdk.Context, handler func(status types.CircuitBreakerStatus) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CircuitBreakerStatusKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var status types.CircuitBreakerStatus
+		k.cdc.MustUnmarshal(iter.Value(), &status)
+		if handler(status) {
+			break
+		}
+	}
+}
+
+// GetCircuitBreakerTwaps returns the twaps over the LookbackDuration param that the tallied exchange rates
+// are checked against, they are only calculated when one of the vote targets limits its twap deviation
+func (k Keeper) GetCircuitBreakerTwaps(ctx sdk.Context, voteTargets map[string]types.Denom) map[string]sdk.Dec {
+	twaps := make(map[string]sdk.Dec)
+	needsTwaps := false
+	for _, denomInfo := range voteTargets {
+		if denomInfo.MaxTwapDeviation != nil {
+			needsTwaps = true
+			break
+		}
+	}
+	if !needsTwaps {
+		return twaps
+	}
+
+	oracleTwaps, err := k.CalculateTwaps(ctx, k.LookbackDuration(ctx))
+	if err != nil {
+		// no twap history to check against yet
+		return twaps
+	}
+	for _, oracleTwap := range oracleTwaps {
+		twaps[oracleTwap.Denom] = oracleTwap.Twap
+	}
+	return twaps
+}
+
+// SetTalliedExchangeRate sets the exchange rate tallied for a denom unless it breaches the circuit breaker
+// limits of the denom. A breaching rate is held until it is confirmed by ConfirmationPeriods consecutive
+// breaching tallies, or halts the denom at its previous rate if the denom requires no confirmations,
+// until a tally lands within the limits again. Returns whether the exchange rate was set.
+func (k Keeper) SetTalliedExchangeRate(ctx sdk.Context, denomInfo types.Denom, exchangeRate sdk.Dec, twaps map[string]sdk.Dec) bool {
+	denom := denomInfo.Name
+	status, tripped := k.GetCircuitBreakerStatus(ctx, denom)
+
+	// previous rate and twap are zero when the denom has none yet, which skips their check
+	previousRate, _, _, _ := k.GetBaseExchangeRate(ctx, denom)
+	twap, ok := twaps[denom]
+	if !ok {
+		twap = sdk.ZeroDec()
+	}
+
+	if !denomInfo.BreachesCircuitBreaker(exchangeRate, previousRate, twap) {
+		if tripped {
+			k.DeleteCircuitBreakerStatus(ctx, denom)
+			k.emitCircuitBreakerEvent(ctx, status, exchangeRate, types.AttributeValueResumed)
+		}
+		k.SetBaseExchangeRateWithEvent(ctx, denom, exchangeRate)
+		return true
+	}
+
+	if denomInfo.ConfirmationPeriods == 0 {
+		status = types.CircuitBreakerStatus{Denom: denom, Halted: true, HeldExchangeRate: exchangeRate}
+		k.SetCircuitBreakerStatus(ctx, status)
+		k.emitCircuitBreakerEvent(ctx, status, exchangeRate, types.AttributeValueHalted)
+		return false
+	}
+
+	if tripped && !status.Halted {
+		status.Confirmations++
+	} else {
+		status = types.CircuitBreakerStatus{Denom: denom}
+	}
+	status.HeldExchangeRate = exchangeRate
+
+	if status.Confirmations >= denomInfo.ConfirmationPeriods {
+		k.DeleteCircuitBreakerStatus(ctx, denom)
+		k.emitCircuitBreakerEvent(ctx, status, exchangeRate, types.AttributeValueConfirmed)
+		k.SetBaseExchangeRateWithEvent(ctx, denom, exchangeRate)
+		return true
+	}
+
+	k.SetCircuitBreakerStatus(ctx, status)
+	k.emitCircuitBreakerEvent(ctx, status, exchangeRate, types.AttributeValueHeld)
+	return false
+}
+
+// emitCircuitBreakerEvent emits the circuit breaker status of a denom after tallying the given exchange rate
+func (k Keeper) emitCircuitBreakerEvent(ctx sdk.Context, status types.CircuitBreakerStatus, exchangeRate sdk.Dec, value string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyDenom, status.Denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, value),
+			sdk.NewAttribute(types.AttributeKeyConfirmations, fmt.Sprintf("%d", status.Confirmations)),
+		),
+	)
+}
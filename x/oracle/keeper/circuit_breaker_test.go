@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestCircuitBreakerStatusGetSet(t *testing.T) {
+	input := CreateTestInput(t)
+
+	status, tripped := input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.False(t, tripped)
+	require.Equal(t, types.CircuitBreakerStatus{Denom: utils.MicroAtomDenom, HeldExchangeRate: sdk.ZeroDec()}, status)
+
+	statuses := []types.CircuitBreakerStatus{
+		{Denom: utils.MicroAtomDenom, Halted: true, HeldExchangeRate: sdk.NewDec(10)},
+		{Denom: utils.MicroEthDenom, HeldExchangeRate: sdk.NewDec(20), Confirmations: 1},
+	}
+	for _, status := range statuses {
+		input.OracleKeeper.SetCircuitBreakerStatus(input.Ctx, status)
+	}
+
+	status, tripped = input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.True(t, tripped)
+	require.Equal(t, statuses[0], status)
+
+	iterated := []types.CircuitBreakerStatus{}
+	input.OracleKeeper.IterateCircuitBreakerStatuses(input.Ctx, func(status types.CircuitBreakerStatus) bool {
+		iterated = append(iterated, status)
+		return false
+	})
+	require.Equal(t, statuses, iterated)
+
+	input.OracleKeeper.DeleteCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	_, tripped = input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.False(t, tripped)
+}
+
+func TestSetTalliedExchangeRateHeld(t *testing.T) {
+	input := CreateTestInput(t)
+	maxRateChange := sdk.NewDecWithPrec(1, 1)
+	denom := types.Denom{Name: utils.MicroAtomDenom, MaxRateChange: &maxRateChange, ConfirmationPeriods: 2}
+	twaps := map[string]sdk.Dec{}
+
+	// the first exchange rate has nothing to be checked against
+	require.True(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(100), twaps))
+
+	// a breaching rate is held
+	require.False(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(150), twaps))
+	status, tripped := input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.True(t, tripped)
+	require.Equal(t, types.CircuitBreakerStatus{Denom: utils.MicroAtomDenom, HeldExchangeRate: sdk.NewDec(150)}, status)
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), rate)
+
+	// a rate within the limits resets the breaker
+	require.True(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(105), twaps))
+	_, tripped = input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.False(t, tripped)
+
+	// and a breaching rate is set once confirmed by ConfirmationPeriods consecutive breaching tallies
+	require.False(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(150), twaps))
+	require.False(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(151), twaps))
+	status, _ = input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.Equal(t, uint64(1), status.Confirmations)
+	require.Equal(t, sdk.NewDec(151), status.HeldExchangeRate)
+	require.True(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(152), twaps))
+	_, tripped = input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.False(t, tripped)
+	rate, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(152), rate)
+}
+
+func TestSetTalliedExchangeRateHalted(t *testing.T) {
+	input := CreateTestInput(t)
+	maxTwapDeviation := sdk.NewDecWithPrec(2, 1)
+	denom := types.Denom{Name: utils.MicroAtomDenom, MaxTwapDeviation: &maxTwapDeviation}
+	twaps := map[string]sdk.Dec{utils.MicroAtomDenom: sdk.NewDec(100)}
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, sdk.NewDec(100))
+
+	// a breaching rate halts the denom at its previous rate for as long as the tallies breach
+	for i := 0; i < 3; i++ {
+		require.False(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(50), twaps))
+		status, tripped := input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+		require.True(t, tripped)
+		require.Equal(t, types.CircuitBreakerStatus{Denom: utils.MicroAtomDenom, Halted: true, HeldExchangeRate: sdk.NewDec(50)}, status)
+	}
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), rate)
+
+	// the denom resumes once a tally lands within the limits
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.True(t, input.OracleKeeper.SetTalliedExchangeRate(input.Ctx, denom, sdk.NewDec(90), twaps))
+	_, tripped := input.OracleKeeper.GetCircuitBreakerStatus(input.Ctx, utils.MicroAtomDenom)
+	require.False(t, tripped)
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(types.EventTypeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyDenom, utils.MicroAtomDenom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, sdk.NewDec(90).String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, types.AttributeValueResumed),
+			sdk.NewAttribute(types.AttributeKeyConfirmations, "0"),
+		),
+		sdk.NewEvent(types.EventTypeExchangeRateUpdate,
+			sdk.NewAttribute(types.AttributeKeyDenom, utils.MicroAtomDenom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, sdk.NewDec(90).String()),
+		),
+	}, input.Ctx.EventManager().Events())
+}
+
+func TestGetCircuitBreakerTwaps(t *testing.T) {
+	input := CreateTestInput(t)
+	maxTwapDeviation := sdk.NewDecWithPrec(2, 1)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(40),
+			LastUpdate:   sdk.NewInt(1800),
+		}),
+	}, 1200))
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(1800, 0))
+
+	// twaps are only calculated when a denom limits its deviation from them
+	voteTargets := map[string]types.Denom{utils.MicroAtomDenom: {Name: utils.MicroAtomDenom}}
+	require.Empty(t, input.OracleKeeper.GetCircuitBreakerTwaps(input.Ctx, voteTargets))
+
+	voteTargets[utils.MicroAtomDenom] = types.Denom{Name: utils.MicroAtomDenom, MaxTwapDeviation: &maxTwapDeviation}
+	require.Equal(t, map[string]sdk.Dec{utils.MicroAtomDenom: sdk.NewDec(40)}, input.OracleKeeper.GetCircuitBreakerTwaps(input.Ctx, voteTargets))
+}
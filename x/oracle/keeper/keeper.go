@@ -142,6 +142,7 @@ func (k Keeper) RemoveExcessFeeds(ctx sdk.Context) {
 	for _, denom := range activesToClear {
 		// clear exchange rates
 		k.DeleteBaseExchangeRate(ctx, denom)
+		k.DeleteCircuitBreakerStatus(ctx, denom)
 	}
 }
 
@@ -270,6 +271,52 @@ func (k Keeper) IterateVotePenaltyCounters(ctx sdk.Context,
 	}
 }
 
+//-----------------------------------
+// AggregateExchangeRatePrevote logic
+
+// GetAggregateExchangeRatePrevote retrieves an oracle prevote submitted in the given vote period from the store
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, period uint64) (aggregatePrevote types.AggregateExchangeRatePrevote, err error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter, period))
+	if b == nil {
+		err = sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return
+	}
+	k.cdc.MustUnmarshal(b, &aggregatePrevote)
+	return
+}
+
+// SetAggregateExchangeRatePrevote adds an oracle aggregate prevote to the store, keyed by the vote period it was submitted in
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter, prevote.SubmitBlock/k.VotePeriod(ctx)), bz)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle prevote submitted in the given vote period from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter, period))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates rate over prevotes in the store
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, period uint64, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		voterAddr := sdk.ValAddress(key[2 : len(key)-8])
+		period := sdk.BigEndianToUint64(key[len(key)-8:])
+
+		var aggregatePrevote types.AggregateExchangeRatePrevote
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, period, aggregatePrevote) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // AggregateExchangeRateVote logic
 
@@ -329,9 +376,14 @@ func (k Keeper) GetVoteTarget(ctx sdk.Context, denom string) (types.Denom, error
 }
 
 func (k Keeper) SetVoteTarget(ctx sdk.Context, denom string) {
+	k.SetVoteTargetDenom(ctx, types.Denom{Name: denom})
+}
+
+// SetVoteTargetDenom sets a vote target along with its voting policy
+func (k Keeper) SetVoteTargetDenom(ctx sdk.Context, denom types.Denom) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.Denom{Name: denom})
-	store.Set(types.GetVoteTargetKey(denom), bz)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.GetVoteTargetKey(denom.Name), bz)
 }
 
 func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string, denomInfo types.Denom) (stop bool)) {
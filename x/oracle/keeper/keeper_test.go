@@ -152,6 +152,8 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	rewardDistributionWindow := uint64(10000)
+	rewardPoolFraction := sdk.NewDecWithPrec(1, 1)
 	whitelist := types.DenomList{
 		{Name: utils.MicroEthDenom},
 		{Name: utils.MicroAtomDenom},
@@ -166,6 +168,9 @@ func TestParams(t *testing.T) {
 		SlashFraction:     slashFraction,
 		SlashWindow:       slashWindow,
 		MinValidPerWindow: minValidPerWindow,
+
+		RewardDistributionWindow: rewardDistributionWindow,
+		RewardPoolFraction:       rewardPoolFraction,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -338,6 +343,48 @@ func TestAggregateVoteIterate(t *testing.T) {
 	})
 }
 
+func TestAggregatePrevoteAddDelete(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(25)
+	period := uint64(25) / input.OracleKeeper.VotePeriod(input.Ctx)
+
+	hash := types.GetAggregateVoteHash("salt", types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(1)}}, ValAddrs[0])
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 25)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], aggregatePrevote)
+
+	KPrevote, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], period)
+	require.NoError(t, err)
+	require.Equal(t, aggregatePrevote, KPrevote)
+
+	// prevotes are kept per vote period
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], period+1)
+	require.Error(t, err)
+
+	input.OracleKeeper.DeleteAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], period)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], period)
+	require.Error(t, err)
+}
+
+func TestAggregatePrevoteIterate(t *testing.T) {
+	input := CreateTestInput(t)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+
+	hash := types.GetAggregateVoteHash("salt", types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(1)}}, ValAddrs[0])
+	aggregatePrevote1 := types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 0)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], aggregatePrevote1)
+	aggregatePrevote2 := types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], votePeriod)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], aggregatePrevote2)
+
+	periods := []uint64{}
+	input.OracleKeeper.IterateAggregateExchangeRatePrevotes(input.Ctx, func(voter sdk.ValAddress, period uint64, p types.AggregateExchangeRatePrevote) (stop bool) {
+		require.Equal(t, ValAddrs[0], voter)
+		require.Equal(t, p.SubmitBlock/votePeriod, period)
+		periods = append(periods, period)
+		return false
+	})
+	require.Equal(t, []uint64{0, 1}, periods)
+}
+
 func TestVoteTargetGetSet(t *testing.T) {
 	input := CreateTestInput(t)
 
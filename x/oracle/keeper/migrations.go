@@ -94,3 +94,27 @@ func (m Migrator) Migrate5To6(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate6To7 adds the reward distribution params. Reward distribution starts disabled.
+func (m Migrator) Migrate6To7(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardDistributionWindow, types.DefaultRewardDistributionWindow)
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardPoolFraction, types.DefaultRewardPoolFraction)
+	return nil
+}
+
+// Migrate7To8 adds the commit-reveal voting param. Voting stays single phase.
+func (m Migrator) Migrate7To8(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyCommitRevealEnabled, types.DefaultCommitRevealEnabled)
+	return nil
+}
+
+// Migrate8To9 stores the voting policy of the whitelisted denoms along with their vote targets.
+// Existing whitelist entries have no policy, so they keep following the global params.
+func (m Migrator) Migrate8To9(ctx sdk.Context) error {
+	for _, denom := range m.keeper.Whitelist(ctx) {
+		if m.keeper.IsVoteTarget(ctx, denom.Name) {
+			m.keeper.SetVoteTargetDenom(ctx, denom)
+		}
+	}
+	return nil
+}
@@ -152,3 +152,63 @@ func TestMigrate5to6(t *testing.T) {
 		SuccessCount: 9975,
 	}, votePenaltyCounter)
 }
+
+func TestMigrate6to7(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.LookbackDuration = 100
+	input.OracleKeeper.SetParams(input.Ctx, prevParams)
+
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate6To7(input.Ctx))
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	require.Equal(t, types.DefaultRewardDistributionWindow, params.RewardDistributionWindow)
+	require.Equal(t, types.DefaultRewardPoolFraction, params.RewardPoolFraction)
+	// existing params are left untouched
+	require.Equal(t, uint64(100), params.LookbackDuration)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.LookbackDuration = 100
+	input.OracleKeeper.SetParams(input.Ctx, prevParams)
+
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate7To8(input.Ctx))
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	require.False(t, params.CommitRevealEnabled)
+	// existing params are left untouched
+	require.Equal(t, uint64(100), params.LookbackDuration)
+}
+
+func TestMigrate8to9(t *testing.T) {
+	input := CreateTestInput(t)
+
+	voteThreshold := sdk.NewDecWithPrec(67, 2)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: utils.MicroAtomDenom, MinVoters: 2, VoteThreshold: &voteThreshold, MaxAge: 60},
+		{Name: utils.MicroEthDenom},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// vote targets were stored without a policy
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate8To9(input.Ctx))
+
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.True(t, params.Whitelist[0].PolicyEqual(voteTarget))
+	// denoms that are not vote targets are left to the next whitelist update
+	require.False(t, input.OracleKeeper.IsVoteTarget(input.Ctx, utils.MicroEthDenom))
+}
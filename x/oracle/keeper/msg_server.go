@@ -20,6 +20,53 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
+func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.CommitRevealEnabled(ctx) {
+		return nil, types.ErrCommitRevealDisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	// Convert hex string to votehash
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	// The prevote is revealed in the next vote period, and replaces any prevote
+	// the validator already submitted in the current vote period
+	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight())))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyAggregateHash, msg.Hash),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -49,7 +96,24 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		}
 	}
 
-	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
+	// With commit-reveal, the vote reveals a prevote submitted in the previous vote period.
+	// The hash is verified against the prevote when the ballots are organized.
+	if ms.CommitRevealEnabled(ctx) {
+		if len(msg.Salt) == 0 {
+			return nil, types.ErrInvalidSaltLength
+		}
+		period := uint64(ctx.BlockHeight()) / ms.VotePeriod(ctx)
+		if period == 0 {
+			return nil, types.ErrRevealPeriodMissMatch
+		}
+		if _, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr, period-1); err != nil {
+			return nil, err
+		}
+	}
+
+	aggregateVote := types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr)
+	aggregateVote.Salt = msg.Salt
+	ms.SetAggregateExchangeRateVote(ctx, valAddr, aggregateVote)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -59,6 +59,24 @@ func (k Keeper) LookbackDuration(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// RewardDistributionWindow returns the number of blocks over which released rewards are paid out
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
+	return
+}
+
+// RewardPoolFraction returns the fraction of the reward pool released per reward distribution window
+func (k Keeper) RewardPoolFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyRewardPoolFraction, &res)
+	return
+}
+
+// CommitRevealEnabled returns whether votes must be committed with a prevote before they are revealed
+func (k Keeper) CommitRevealEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyCommitRevealEnabled, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
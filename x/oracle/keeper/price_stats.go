@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// priceSample is the price of a denom in a price snapshot
+type priceSample struct {
+	timestamp int64
+	price     sdk.Dec
+}
+
+// CalculatePriceStats returns the statistics of the price snapshots of the vote targets taken within the lookback
+// window. The weight of a snapshot price in the ema halves with every halfLifeSeconds of snapshot age.
+func (k Keeper) CalculatePriceStats(ctx sdk.Context, lookbackSeconds uint64, halfLifeSeconds uint64) (types.OraclePriceStatsList, error) {
+	priceStats := types.OraclePriceStatsList{}
+	currentTime := ctx.BlockTime().Unix()
+	err := k.ValidateLookbackSeconds(ctx, lookbackSeconds)
+	if err != nil {
+		return priceStats, err
+	}
+	if halfLifeSeconds == 0 {
+		return priceStats, types.ErrInvalidHalfLife
+	}
+	// the weight of a snapshot price decays by this factor per second of age, so that it halves every half-life
+	decayPerSecond, err := sdk.NewDecWithPrec(5, 1).ApproxRoot(halfLifeSeconds)
+	if err != nil {
+		return priceStats, err
+	}
+
+	// get targets - only calculate for the targets
+	targetsMap := make(map[string]struct{})
+	k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) (stop bool) {
+		targetsMap[denom] = struct{}{}
+		return false
+	})
+
+	// samples of each denom, from the most recent one
+	denomSamples := make(map[string][]priceSample)
+	k.IteratePriceSnapshotsReverse(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		if currentTime-int64(lookbackSeconds) > snapshot.SnapshotTimestamp {
+			return true
+		}
+		for _, priceItem := range snapshot.PriceSnapshotItems {
+			if _, ok := targetsMap[priceItem.Denom]; !ok {
+				continue
+			}
+			denomSamples[priceItem.Denom] = append(denomSamples[priceItem.Denom], priceSample{
+				timestamp: snapshot.SnapshotTimestamp,
+				price:     priceItem.OracleExchangeRate.ExchangeRate,
+			})
+		}
+		return false
+	})
+
+	denomKeys := make([]string, 0, len(denomSamples))
+	for denom := range denomSamples {
+		denomKeys = append(denomKeys, denom)
+	}
+	sort.Strings(denomKeys)
+
+	for _, denom := range denomKeys {
+		samples := denomSamples[denom]
+		stats := types.OraclePriceStats{
+			Denom:           denom,
+			High:            samples[0].price,
+			Low:             samples[0].price,
+			SampleCount:     uint64(len(samples)),
+			LookbackSeconds: currentTime - samples[len(samples)-1].timestamp,
+		}
+
+		weightedSum, weightSum := sdk.ZeroDec(), sdk.ZeroDec()
+		squaredReturnSum := sdk.ZeroDec()
+		for i, sample := range samples {
+			age := currentTime - sample.timestamp
+			if age < 0 {
+				age = 0
+			}
+			weight := decayPerSecond.Power(uint64(age))
+			weightedSum = weightedSum.Add(sample.price.Mul(weight))
+			weightSum = weightSum.Add(weight)
+
+			if sample.price.GT(stats.High) {
+				stats.High = sample.price
+			}
+			if sample.price.LT(stats.Low) {
+				stats.Low = sample.price
+			}
+
+			// return from the previous, older, sample to this one
+			if i+1 < len(samples) && samples[i+1].price.IsPositive() {
+				periodReturn := sample.price.Quo(samples[i+1].price).Sub(sdk.OneDec())
+				squaredReturnSum = squaredReturnSum.Add(periodReturn.Mul(periodReturn))
+			}
+		}
+
+		if weightSum.IsPositive() {
+			stats.Ema = weightedSum.Quo(weightSum)
+		} else {
+			// the weights of samples many half-lives old round down to zero, fall back to the most recent price
+			stats.Ema = samples[0].price
+		}
+		stats.RealizedVolatility, err = squaredReturnSum.ApproxSqrt()
+		if err != nil {
+			return types.OraclePriceStatsList{}, err
+		}
+
+		priceStats = append(priceStats, stats)
+	}
+
+	if len(priceStats) == 0 {
+		return priceStats, types.ErrNoPriceStatsData
+	}
+
+	return priceStats, nil
+}
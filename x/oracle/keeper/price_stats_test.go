@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestCalculatePriceStats(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.CalculatePriceStats(input.Ctx, 3600, 1000)
+	require.Equal(t, types.ErrNoPriceStatsData, err)
+	_, err = input.OracleKeeper.CalculatePriceStats(input.Ctx, 3601, 1000)
+	require.Equal(t, types.ErrInvalidTwapLookback, err)
+	_, err = input.OracleKeeper.CalculatePriceStats(input.Ctx, 3600, 0)
+	require.Equal(t, types.ErrInvalidHalfLife, err)
+
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroEthDenom)
+	priceSnapshots := types.PriceSnapshots{
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(10),
+				LastUpdate:   sdk.NewInt(1000),
+			}),
+		}, 1000),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(20),
+				LastUpdate:   sdk.NewInt(2000),
+			}),
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(30),
+				LastUpdate:   sdk.NewInt(2000),
+			}),
+		}, 2000),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(10),
+				LastUpdate:   sdk.NewInt(3000),
+			}),
+			// not a vote target
+			types.NewPriceSnapshotItem(utils.MicroSeiDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(40),
+				LastUpdate:   sdk.NewInt(3000),
+			}),
+		}, 3000),
+	}
+	for _, snap := range priceSnapshots {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, snap)
+	}
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(3000, 0))
+
+	priceStats, err := input.OracleKeeper.CalculatePriceStats(input.Ctx, 3600, 1000)
+	require.NoError(t, err)
+	require.Len(t, priceStats, 2)
+
+	// weights of 1/4, 1/2 and 1 for ages of 2000, 1000 and 0 seconds
+	atomStats := priceStats[0]
+	require.Equal(t, utils.MicroAtomDenom, atomStats.Denom)
+	requireDecApproxEqual(t, sdk.NewDecWithPrec(225, 1).Quo(sdk.NewDecWithPrec(175, 2)), atomStats.Ema)
+	// returns of 100% and -50%
+	expectedVolatility, err := sdk.NewDecWithPrec(125, 2).ApproxSqrt()
+	require.NoError(t, err)
+	require.Equal(t, expectedVolatility, atomStats.RealizedVolatility)
+	require.Equal(t, sdk.NewDec(20), atomStats.High)
+	require.Equal(t, sdk.NewDec(10), atomStats.Low)
+	require.Equal(t, uint64(3), atomStats.SampleCount)
+	require.Equal(t, int64(2000), atomStats.LookbackSeconds)
+
+	ethStats := priceStats[1]
+	require.Equal(t, types.OraclePriceStats{
+		Denom:              utils.MicroEthDenom,
+		Ema:                sdk.NewDec(30),
+		RealizedVolatility: sdk.ZeroDec(),
+		High:               sdk.NewDec(30),
+		Low:                sdk.NewDec(30),
+		SampleCount:        1,
+		LookbackSeconds:    1000,
+	}, ethStats)
+
+	// only the snapshots within the lookback are sampled
+	priceStats, err = input.OracleKeeper.CalculatePriceStats(input.Ctx, 1500, 1000)
+	require.NoError(t, err)
+	atomStats = priceStats[0]
+	requireDecApproxEqual(t, sdk.NewDec(20).Quo(sdk.NewDecWithPrec(15, 1)), atomStats.Ema)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), atomStats.RealizedVolatility)
+	require.Equal(t, sdk.NewDec(20), atomStats.High)
+	require.Equal(t, sdk.NewDec(10), atomStats.Low)
+	require.Equal(t, uint64(2), atomStats.SampleCount)
+	require.Equal(t, int64(1000), atomStats.LookbackSeconds)
+}
+
+func requireDecApproxEqual(t *testing.T, expected sdk.Dec, actual sdk.Dec) {
+	require.True(t, expected.Sub(actual).Abs().LTE(sdk.NewDecWithPrec(1, 9)), "expected %s, got %s", expected, actual)
+}
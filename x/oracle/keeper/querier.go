@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
@@ -49,17 +50,26 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		return nil, err
 	}
 
+	// serve the last exchange rate only if it is recent enough for the denom's policy
+	if voteTarget, err := q.GetVoteTarget(ctx, req.Denom); err == nil && voteTarget.IsStale(lastUpdateTimestamp, ctx.BlockTime().UnixMilli()) {
+		return nil, sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s was last updated at %d", req.Denom, lastUpdateTimestamp)
+	}
+
 	return &types.QueryExchangeRateResponse{OracleExchangeRate: types.OracleExchangeRate{
 		ExchangeRate: exchangeRate, LastUpdate: lastUpdate, LastUpdateTimestamp: lastUpdateTimestamp,
 	}}, nil
 }
 
-// ExchangeRates queries exchange rates of all denoms
+// ExchangeRates queries exchange rates of all denoms, leaving out the ones that are
+// stale under their denom's policy
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	exchangeRates := []types.DenomOracleExchangeRatePair{}
 	q.IterateBaseExchangeRates(ctx, func(denom string, rate types.OracleExchangeRate) (stop bool) {
+		if voteTarget, err := q.GetVoteTarget(ctx, denom); err == nil && voteTarget.IsStale(rate.LastUpdateTimestamp, ctx.BlockTime().UnixMilli()) {
+			return false
+		}
 		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate})
 		return false
 	})
@@ -157,3 +167,82 @@ func (q querier) SlashWindow(
 			params.VotePeriod,
 	}, nil
 }
+
+// ProjectedRewards queries the oracle rewards paid out at the end of the current vote period
+func (q querier) ProjectedRewards(c context.Context, req *types.QueryProjectedRewardsRequest) (*types.QueryProjectedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	periodRewards := q.GetPeriodRewards(ctx)
+	response := &types.QueryProjectedRewardsResponse{
+		RewardPool:       q.GetRewardPoolLegacy(ctx),
+		PeriodRewards:    periodRewards,
+		ValidatorRewards: sdk.NewDecCoins(),
+	}
+	if len(req.ValidatorAddr) == 0 {
+		return response, nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	validator := q.StakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	// Rewards are split pro-rata to ballot power, so assuming every bonded validator
+	// votes within the reward band the share of a validator is its share of bonded power
+	powerReduction := q.StakingKeeper.PowerReduction(ctx)
+	totalBondedPower := sdk.TokensToConsensusPower(q.StakingKeeper.TotalBondedTokens(ctx), powerReduction)
+	if validator.IsBonded() && totalBondedPower > 0 {
+		share := sdk.NewDec(validator.GetConsensusPower(powerReduction)).QuoInt64(totalBondedPower)
+		response.ValidatorRewards = periodRewards.MulDec(share)
+	}
+	return response, nil
+}
+
+// CircuitBreakerStatus queries whether the exchange rate of a denom is held or halted by its circuit breaker
+func (q querier) CircuitBreakerStatus(c context.Context, req *types.QueryCircuitBreakerStatusRequest) (*types.QueryCircuitBreakerStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	circuitBreakerStatus, _ := q.GetCircuitBreakerStatus(ctx, req.Denom)
+	return &types.QueryCircuitBreakerStatusResponse{CircuitBreakerStatus: circuitBreakerStatus}, nil
+}
+
+// CircuitBreakerStatuses queries all the denoms whose exchange rate is held or halted by their circuit breaker
+func (q querier) CircuitBreakerStatuses(c context.Context, _ *types.QueryCircuitBreakerStatusesRequest) (*types.QueryCircuitBreakerStatusesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	circuitBreakerStatuses := []types.CircuitBreakerStatus{}
+	q.IterateCircuitBreakerStatuses(ctx, func(circuitBreakerStatus types.CircuitBreakerStatus) (stop bool) {
+		circuitBreakerStatuses = append(circuitBreakerStatuses, circuitBreakerStatus)
+		return false
+	})
+
+	return &types.QueryCircuitBreakerStatusesResponse{CircuitBreakerStatuses: circuitBreakerStatuses}, nil
+}
+
+// PriceStats queries the ema, realized volatility, high, low and sample count of the price snapshots of all denoms
+func (q querier) PriceStats(c context.Context, req *types.QueryPriceStatsRequest) (*types.QueryPriceStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	priceStats, err := q.CalculatePriceStats(ctx, req.LookbackSeconds, req.HalfLifeSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPriceStatsResponse{PriceStats: priceStats}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 	"github.com/sei-protocol/sei-chain/x/oracle/utils"
@@ -39,6 +40,30 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
 }
 
+func TestQueryStaleExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, types.Denom{Name: utils.MicroAtomDenom, MaxAge: 60})
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, rate)
+
+	// the exchange rate is served within its max age
+	ctx := input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(60 * time.Second))
+	res, err := querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{
+		Denom: utils.MicroAtomDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
+
+	// and is stale afterwards
+	ctx = input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(61 * time.Second))
+	_, err = querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{
+		Denom: utils.MicroAtomDenom,
+	})
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+}
+
 func TestQueryEmptyExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -68,6 +93,30 @@ func TestQueryExchangeRates(t *testing.T) {
 	}, res.DenomOracleExchangeRatePairs)
 }
 
+func TestQueryExchangeRatesSkipsStaleDenoms(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, types.Denom{Name: utils.MicroAtomDenom, MaxAge: 60})
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, rate)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroSeiDenom, rate)
+
+	// both exchange rates are served within the max age
+	ctx := input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(60 * time.Second))
+	res, err := querier.ExchangeRates(sdk.WrapSDKContext(ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.DenomOracleExchangeRatePairs, 2)
+
+	// and only the one without a max age afterwards
+	ctx = input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(61 * time.Second))
+	res, err = querier.ExchangeRates(sdk.WrapSDKContext(ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DenomOracleExchangeRatePairs{
+		types.NewDenomOracleExchangeRatePair(utils.MicroSeiDenom, rate, sdk.ZeroInt(), input.Ctx.BlockTime().UnixMilli()),
+	}, res.DenomOracleExchangeRatePairs)
+}
+
 func TestQueryFeederDelegation(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -215,3 +264,101 @@ func TestQueryTwaps(t *testing.T) {
 	require.Equal(t, int64(1800), ethTwap.LookbackSeconds)
 	require.Equal(t, sdk.NewDec(15), ethTwap.Twap)
 }
+
+func TestQueryPriceStats(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+	_, err := querier.PriceStats(ctx, &types.QueryPriceStatsRequest{LookbackSeconds: 3600, HalfLifeSeconds: 900})
+	require.Error(t, err)
+
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(20),
+			LastUpdate:   sdk.NewInt(3600),
+		}),
+	}, 3600))
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(40),
+			LastUpdate:   sdk.NewInt(4500),
+		}),
+	}, 4500))
+
+	res, err := querier.PriceStats(ctx, &types.QueryPriceStatsRequest{LookbackSeconds: 3600, HalfLifeSeconds: 900})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.PriceStats))
+	atomStats := res.PriceStats[0]
+	require.Equal(t, utils.MicroAtomDenom, atomStats.Denom)
+	require.Equal(t, uint64(2), atomStats.SampleCount)
+	require.Equal(t, int64(1800), atomStats.LookbackSeconds)
+	require.Equal(t, sdk.NewDec(40), atomStats.High)
+	require.Equal(t, sdk.NewDec(20), atomStats.Low)
+	require.Equal(t, sdk.OneDec(), atomStats.RealizedVolatility)
+	// weights of 1/4 and 1/2 for ages of 1800 and 900 seconds
+	requireDecApproxEqual(t, sdk.NewDec(25).Quo(sdk.NewDecWithPrec(75, 2)), atomStats.Ema)
+}
+
+func TestQueryProjectedRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	_, err = sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], amt.MulRaw(3)))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	fundRewardPool(t, input, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000)))
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 1
+	params.RewardDistributionWindow = 10
+	params.RewardPoolFraction = sdk.OneDec()
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+	res, err := querier.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000)), res.RewardPool)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroSeiDenom, 100)), res.PeriodRewards)
+	require.True(t, res.ValidatorRewards.IsZero())
+
+	res, err = querier.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{ValidatorAddr: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroSeiDenom, 75)), res.ValidatorRewards)
+
+	_, err = querier.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{ValidatorAddr: ValAddrs[2].String()})
+	require.Error(t, err)
+}
+
+func TestQueryCircuitBreakerStatus(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.CircuitBreakerStatus(ctx, &types.QueryCircuitBreakerStatusRequest{})
+	require.Error(t, err)
+
+	// a denom within its limits is neither held nor halted
+	res, err := querier.CircuitBreakerStatus(ctx, &types.QueryCircuitBreakerStatusRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.CircuitBreakerStatus{Denom: utils.MicroAtomDenom, HeldExchangeRate: sdk.ZeroDec()}, res.CircuitBreakerStatus)
+
+	statusesRes, err := querier.CircuitBreakerStatuses(ctx, &types.QueryCircuitBreakerStatusesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, statusesRes.CircuitBreakerStatuses)
+
+	halted := types.CircuitBreakerStatus{Denom: utils.MicroAtomDenom, Halted: true, HeldExchangeRate: sdk.NewDec(10)}
+	input.OracleKeeper.SetCircuitBreakerStatus(input.Ctx, halted)
+
+	res, err = querier.CircuitBreakerStatus(ctx, &types.QueryCircuitBreakerStatusRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, halted, res.CircuitBreakerStatus)
+
+	statusesRes, err = querier.CircuitBreakerStatuses(ctx, &types.QueryCircuitBreakerStatusesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.CircuitBreakerStatus{halted}, statusesRes.CircuitBreakerStatuses)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// GetPeriodRewards returns the rewards released from the reward pool at the end of a vote period,
+// which is RewardPoolFraction of the pool spread evenly over the vote periods of a RewardDistributionWindow
+func (k Keeper) GetPeriodRewards(ctx sdk.Context) sdk.DecCoins {
+	params := k.GetParams(ctx)
+	distributionRatio := params.RewardPoolFraction.MulInt64(int64(params.VotePeriod)).QuoInt64(int64(params.RewardDistributionWindow))
+
+	periodRewards := sdk.NewDecCoins()
+	if !distributionRatio.IsPositive() {
+		return periodRewards
+	}
+	for _, coin := range k.GetRewardPoolLegacy(ctx) {
+		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.ToDec().Mul(distributionRatio)))
+	}
+	return periodRewards
+}
+
+// RewardBallotWinners distributes the period rewards to the validators that voted within the
+// reward band, pro-rata to the weight they accumulated during tally, through the distribution keeper
+func (k Keeper) RewardBallotWinners(ctx sdk.Context, validatorClaimMap map[string]types.Claim) {
+	// Sum weight of the claims and sort the winners for deterministic distribution
+	winners := []string{}
+	ballotPowerSum := int64(0)
+	for key, claim := range validatorClaimMap {
+		if claim.Weight > 0 {
+			winners = append(winners, key)
+			ballotPowerSum += claim.Weight
+		}
+	}
+	if ballotPowerSum == 0 {
+		return
+	}
+	sort.Strings(winners)
+
+	periodRewards := k.GetPeriodRewards(ctx)
+	if periodRewards.IsZero() {
+		return
+	}
+
+	distributedReward := sdk.NewCoins()
+	events := []sdk.Event{}
+	for _, key := range winners {
+		claim := validatorClaimMap[key]
+		receiverVal := k.StakingKeeper.Validator(ctx, claim.Recipient)
+		if receiverVal == nil {
+			continue
+		}
+		rewardCoins, _ := periodRewards.MulDec(sdk.NewDec(claim.Weight).QuoInt64(ballotPowerSum)).TruncateDecimal()
+		if rewardCoins.IsZero() {
+			continue
+		}
+		k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
+		distributedReward = distributedReward.Add(rewardCoins...)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeRewardDistribution,
+			sdk.NewAttribute(types.AttributeKeyOperator, key),
+			sdk.NewAttribute(types.AttributeKeyWeight, fmt.Sprint(claim.Weight)),
+			sdk.NewAttribute(types.AttributeKeyAmount, rewardCoins.String()),
+		))
+	}
+	if distributedReward.IsZero() {
+		return
+	}
+
+	// Move distributed reward to distribution module
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward); err != nil {
+		panic(fmt.Sprintf("failed to send oracle rewards to distribution module: %s", err))
+	}
+	ctx.EventManager().EmitEvents(events)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func fundRewardPool(t *testing.T, input TestInput, amounts sdk.Coins) {
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, amounts))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, amounts))
+}
+
+func TestGetPeriodRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	fundRewardPool(t, input, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000)))
+
+	// distribution is disabled by default
+	require.True(t, input.OracleKeeper.GetPeriodRewards(input.Ctx).IsZero())
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 2
+	params.RewardDistributionWindow = 10
+	params.RewardPoolFraction = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// 50% of the pool over 5 vote periods
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroSeiDenom, 100)), input.OracleKeeper.GetPeriodRewards(input.Ctx))
+}
+
+func TestRewardBallotWinners(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[2], ValPubKeys[2], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	fundRewardPool(t, input, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000)))
+	params := input.OracleKeeper.GetParams(ctx)
+	params.VotePeriod = 1
+	params.RewardDistributionWindow = 10
+	params.RewardPoolFraction = sdk.OneDec()
+	input.OracleKeeper.SetParams(ctx, params)
+
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 100, 1, ValAddrs[0], true),
+		ValAddrs[1].String(): types.NewClaim(100, 300, 1, ValAddrs[1], true),
+		// voted out of the reward band
+		ValAddrs[2].String(): types.NewClaim(100, 0, 0, ValAddrs[2], true),
+	}
+	input.OracleKeeper.RewardBallotWinners(ctx, claims)
+
+	// 100usei is released and split 1:3
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroSeiDenom, 25)), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroSeiDenom, 75)), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[1]))
+	require.True(t, input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[2]).IsZero())
+	require.Equal(t, sdk.NewInt64Coin(utils.MicroSeiDenom, 900), input.OracleKeeper.GetRewardPool(ctx, utils.MicroSeiDenom))
+
+	events := ctx.EventManager().Events()
+	rewardEvents := 0
+	for _, event := range events {
+		if event.Type == types.EventTypeRewardDistribution {
+			rewardEvents++
+		}
+	}
+	require.Equal(t, 2, rewardEvents)
+
+	// no winners, nothing is distributed
+	input.OracleKeeper.RewardBallotWinners(ctx, map[string]types.Claim{
+		ValAddrs[2].String(): types.NewClaim(100, 0, 0, ValAddrs[2], true),
+	})
+	require.Equal(t, sdk.NewInt64Coin(utils.MicroSeiDenom, 900), input.OracleKeeper.GetRewardPool(ctx, utils.MicroSeiDenom))
+}
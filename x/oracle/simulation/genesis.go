@@ -19,7 +19,8 @@ const (
 	votePeriodKey               = "vote_period"
 	voteThresholdKey            = "vote_threshold"
 	rewardBandKey               = "reward_band"
-	rewardDistributionWindowKey = "reward_distribution_window" //nolint:deadcode // we will use this later
+	rewardDistributionWindowKey = "reward_distribution_window"
+	rewardPoolFractionKey       = "reward_pool_fraction"
 	slashFractionKey            = "slash_fraction"
 	slashWindowKey              = "slash_window"
 	minValidPerWindowKey        = "min_valid_per_window"
@@ -40,6 +41,16 @@ func GenRewardBand(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
 }
 
+// GenRewardDistributionWindow randomized RewardDistributionWindow
+func GenRewardDistributionWindow(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
+// GenRewardPoolFraction randomized RewardPoolFraction
+func GenRewardPoolFraction(r *rand.Rand) sdk.Dec {
+	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(1000)), 3))
+}
+
 // GenSlashFraction randomized SlashFraction
 func GenSlashFraction(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
@@ -75,6 +86,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { rewardBand = GenRewardBand(r) },
 	)
 
+	var rewardDistributionWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardDistributionWindowKey, &rewardDistributionWindow, simState.Rand,
+		func(r *rand.Rand) { rewardDistributionWindow = GenRewardDistributionWindow(r) },
+	)
+
+	var rewardPoolFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardPoolFractionKey, &rewardPoolFraction, simState.Rand,
+		func(r *rand.Rand) { rewardPoolFraction = GenRewardPoolFraction(r) },
+	)
+
 	var slashFraction sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, slashFractionKey, &slashFraction, simState.Rand,
@@ -105,12 +128,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:     slashFraction,
 			SlashWindow:       slashWindow,
 			MinValidPerWindow: minValidPerWindow,
+
+			RewardDistributionWindow: rewardDistributionWindow,
+			RewardPoolFraction:       rewardPoolFraction,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.PenaltyCounter{},
 		[]types.AggregateExchangeRateVote{},
 		types.PriceSnapshots{},
+		[]types.AggregateExchangeRatePrevote{},
+		[]types.CircuitBreakerStatus{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -143,6 +143,17 @@ func TestPBPower(t *testing.T) {
 	require.Equal(t, ballotPower, pb.Power())
 }
 
+func TestPBNumVoters(t *testing.T) {
+	pb := ExchangeRateBallot{
+		NewVoteForTally(sdk.NewDec(1), utils.MicroAtomDenom, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 10),
+		NewVoteForTally(sdk.NewDec(2), utils.MicroAtomDenom, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 20),
+		// abstain vote
+		NewVoteForTally(sdk.ZeroDec(), utils.MicroAtomDenom, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 0),
+	}
+	require.Equal(t, uint64(2), pb.NumVoters())
+	require.Equal(t, uint64(0), ExchangeRateBallot{}.NumVoters())
+}
+
 func TestPBWeightedMedian(t *testing.T) {
 	tests := []struct {
 		inputs      []int64
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // String implements fmt.Stringer interface
@@ -17,6 +19,61 @@ func (d Denom) Equal(d1 *Denom) bool {
 	return d.Name == d1.Name
 }
 
+// EffectiveVoteThreshold returns the vote threshold of the denom, or the given default if it has none
+func (d Denom) EffectiveVoteThreshold(defaultVoteThreshold sdk.Dec) sdk.Dec {
+	if d.VoteThreshold == nil {
+		return defaultVoteThreshold
+	}
+	return *d.VoteThreshold
+}
+
+// EffectiveRewardBand returns the reward band of the denom, or the given default if it has none
+func (d Denom) EffectiveRewardBand(defaultRewardBand sdk.Dec) sdk.Dec {
+	if d.RewardBand == nil {
+		return defaultRewardBand
+	}
+	return *d.RewardBand
+}
+
+// IsStale returns whether an exchange rate last updated at the given timestamp is too old
+// to be served at blockTimestamp, both in milliseconds like OracleExchangeRate.LastUpdateTimestamp
+func (d Denom) IsStale(lastUpdateTimestamp int64, blockTimestamp int64) bool {
+	return d.MaxAge != 0 && blockTimestamp-lastUpdateTimestamp > int64(d.MaxAge)*1000
+}
+
+// PolicyEqual returns whether both denoms have the same name and voting policy
+func (d Denom) PolicyEqual(d1 Denom) bool {
+	return d.Name == d1.Name &&
+		d.MinVoters == d1.MinVoters &&
+		decPtrEqual(d.VoteThreshold, d1.VoteThreshold) &&
+		decPtrEqual(d.RewardBand, d1.RewardBand) &&
+		d.MaxAge == d1.MaxAge &&
+		decPtrEqual(d.MaxRateChange, d1.MaxRateChange) &&
+		decPtrEqual(d.MaxTwapDeviation, d1.MaxTwapDeviation) &&
+		d.ConfirmationPeriods == d1.ConfirmationPeriods
+}
+
+// BreachesCircuitBreaker returns whether a tallied exchange rate moved further from the previous rate or
+// from the twap than the denom allows, zero references (no previous rate or twap) are not checked
+func (d Denom) BreachesCircuitBreaker(exchangeRate sdk.Dec, previousRate sdk.Dec, twap sdk.Dec) bool {
+	return exceedsRelativeDeviation(exchangeRate, previousRate, d.MaxRateChange) ||
+		exceedsRelativeDeviation(exchangeRate, twap, d.MaxTwapDeviation)
+}
+
+func exceedsRelativeDeviation(value sdk.Dec, reference sdk.Dec, maxDeviation *sdk.Dec) bool {
+	if maxDeviation == nil || !reference.IsPositive() {
+		return false
+	}
+	return value.Sub(reference).Abs().Quo(reference).GT(*maxDeviation)
+}
+
+func decPtrEqual(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
 // DenomList is array of Denom
 type DenomList []Denom
 
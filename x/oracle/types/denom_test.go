@@ -1,6 +1,12 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func TestDenomListContains(t *testing.T) {
 	tests := []struct {
@@ -45,3 +51,52 @@ func TestDenomListContains(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomPolicy(t *testing.T) {
+	voteThreshold := sdk.NewDecWithPrec(67, 2)
+	rewardBand := sdk.NewDecWithPrec(5, 2)
+	defaultDenom := Denom{Name: "USD"}
+	policyDenom := Denom{Name: "USD", MinVoters: 3, VoteThreshold: &voteThreshold, RewardBand: &rewardBand, MaxAge: 60}
+
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), defaultDenom.EffectiveVoteThreshold(sdk.NewDecWithPrec(5, 1)))
+	require.Equal(t, voteThreshold, policyDenom.EffectiveVoteThreshold(sdk.NewDecWithPrec(5, 1)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), defaultDenom.EffectiveRewardBand(sdk.NewDecWithPrec(2, 2)))
+	require.Equal(t, rewardBand, policyDenom.EffectiveRewardBand(sdk.NewDecWithPrec(2, 2)))
+
+	require.False(t, defaultDenom.IsStale(0, 1000000))
+	require.False(t, policyDenom.IsStale(100000, 160000))
+	require.True(t, policyDenom.IsStale(100000, 160001))
+
+	require.True(t, defaultDenom.PolicyEqual(Denom{Name: "USD"}))
+	require.False(t, defaultDenom.PolicyEqual(policyDenom))
+	otherVoteThreshold := sdk.NewDecWithPrec(67, 2)
+	require.True(t, policyDenom.PolicyEqual(Denom{Name: "USD", MinVoters: 3, VoteThreshold: &otherVoteThreshold, RewardBand: &rewardBand, MaxAge: 60}))
+}
+
+func TestDenomCircuitBreaker(t *testing.T) {
+	maxRateChange := sdk.NewDecWithPrec(1, 1)
+	maxTwapDeviation := sdk.NewDecWithPrec(2, 1)
+	defaultDenom := Denom{Name: "USD"}
+	policyDenom := Denom{Name: "USD", MaxRateChange: &maxRateChange, MaxTwapDeviation: &maxTwapDeviation, ConfirmationPeriods: 2}
+
+	// no limits
+	require.False(t, defaultDenom.BreachesCircuitBreaker(sdk.NewDec(100), sdk.NewDec(1), sdk.NewDec(1)))
+
+	// within the limits, or without previous rate or twap to check against
+	require.False(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(110), sdk.NewDec(100), sdk.NewDec(120)))
+	require.False(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(90), sdk.NewDec(100), sdk.NewDec(75)))
+	require.False(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(100), sdk.ZeroDec(), sdk.ZeroDec()))
+
+	// rate of change breached
+	require.True(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(111), sdk.NewDec(100), sdk.NewDec(111)))
+	require.True(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(89), sdk.NewDec(100), sdk.ZeroDec()))
+
+	// twap deviation breached
+	require.True(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(100), sdk.NewDec(100), sdk.NewDec(80)))
+	require.True(t, policyDenom.BreachesCircuitBreaker(sdk.NewDec(100), sdk.ZeroDec(), sdk.NewDec(130)))
+
+	require.False(t, defaultDenom.PolicyEqual(policyDenom))
+	otherMaxRateChange := sdk.NewDecWithPrec(1, 1)
+	require.True(t, policyDenom.PolicyEqual(Denom{Name: "USD", MaxRateChange: &otherMaxRateChange, MaxTwapDeviation: &maxTwapDeviation, ConfirmationPeriods: 2}))
+	require.False(t, policyDenom.PolicyEqual(Denom{Name: "USD", MaxRateChange: &otherMaxRateChange, MaxTwapDeviation: &maxTwapDeviation}))
+}
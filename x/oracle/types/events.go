@@ -6,18 +6,30 @@ const (
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeRewardDistribution = "reward_distribution"
+	EventTypeCircuitBreaker     = "circuit_breaker"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
 	AttributeKeyExchangeRate  = "exchange_rate"
 	AttributeKeyExchangeRates = "exchange_rates"
+	AttributeKeyAggregateHash = "aggregate_hash"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
 	AttributeKeyMissCount     = "miss_count"
 	AttributeKeyAbstainCount  = "abstain_count"
 	AttributeKeyWinCount      = "win_count"
 	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyWeight        = "weight"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyStatus        = "status"
+	AttributeKeyConfirmations = "confirmations"
 
-	AttributeValueCategory = ModuleName
+	AttributeValueCategory  = ModuleName
+	AttributeValueHeld      = "held"
+	AttributeValueConfirmed = "confirmed"
+	AttributeValueHalted    = "halted"
+	AttributeValueResumed   = "resumed"
 )
@@ -12,6 +12,8 @@ func NewGenesisState(
 	feederDelegations []FeederDelegation, penaltyCounters []PenaltyCounter,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	priceSnapshots []PriceSnapshot,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
+	circuitBreakerStatuses []CircuitBreakerStatus,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                     params,
@@ -20,6 +22,9 @@ func NewGenesisState(
 		PenaltyCounters:            penaltyCounters,
 		AggregateExchangeRateVotes: aggregateExchangeRateVotes,
 		PriceSnapshots:             priceSnapshots,
+
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		CircuitBreakerStatuses:        circuitBreakerStatuses,
 	}
 }
 
@@ -32,6 +37,9 @@ func DefaultGenesisState() *GenesisState {
 		PenaltyCounters:            []PenaltyCounter{},
 		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
 		PriceSnapshots:             PriceSnapshots{},
+
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
+		CircuitBreakerStatuses:        []CircuitBreakerStatus{},
 	}
 }
 
@@ -25,12 +25,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FeederDelegations          []FeederDelegation          `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
-	ExchangeRates              ExchangeRateTuples          `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
-	PenaltyCounters            []PenaltyCounter            `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
-	AggregateExchangeRateVotes []AggregateExchangeRateVote `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
-	PriceSnapshots             PriceSnapshots              `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	Params                        Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FeederDelegations             []FeederDelegation             `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
+	ExchangeRates                 ExchangeRateTuples             `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	PenaltyCounters               []PenaltyCounter               `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	PriceSnapshots                PriceSnapshots                 `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,8,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
+	CircuitBreakerStatuses        []CircuitBreakerStatus         `protobuf:"bytes,9,rep,name=circuit_breaker_statuses,json=circuitBreakerStatuses,proto3" json:"circuit_breaker_statuses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +110,20 @@ func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
 	return nil
 }
 
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerStatuses() []CircuitBreakerStatus {
+	if m != nil {
+		return m.CircuitBreakerStatuses
+	}
+	return nil
+}
+
 type FeederDelegation struct {
 	FeederAddress    string `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -221,40 +237,44 @@ func init() {
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0x10, 0x60, 0x43, 0xd3, 0x74, 0x89, 0x50, 0x14, 0x84, 0x5b, 0x05, 0x21, 0x55,
-	0x54, 0xb5, 0x69, 0x90, 0xb8, 0x27, 0x7c, 0x49, 0x3d, 0x21, 0x17, 0x71, 0x40, 0x48, 0xd6, 0xda,
-	0x9e, 0x38, 0x2b, 0x1c, 0xaf, 0xf1, 0x6c, 0xa2, 0xf6, 0xc4, 0x5f, 0xe0, 0x27, 0x70, 0xe6, 0x97,
-	0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0xbf, 0xe0, 0x84, 0xbc, 0xbb, 0x2d, 0x75, 0xda, 0xfa, 0x94,
-	0xdd, 0x37, 0xef, 0xcd, 0xcb, 0x9b, 0x59, 0x93, 0x8e, 0xc8, 0x59, 0x98, 0x80, 0x1b, 0x43, 0x0a,
-	0xc8, 0xd1, 0xc9, 0x72, 0x21, 0x05, 0x7d, 0x88, 0xc0, 0xd5, 0x29, 0x14, 0x89, 0x83, 0xc0, 0xc3,
-	0x09, 0xe3, 0xa9, 0xa3, 0xa9, 0xbd, 0x4e, 0x2c, 0x62, 0xa1, 0xaa, 0x6e, 0x71, 0xd2, 0x92, 0xde,
-	0x7d, 0xd3, 0x48, 0xff, 0x18, 0xd0, 0x0e, 0x05, 0x4e, 0x05, 0xba, 0x01, 0x43, 0x70, 0xe7, 0x07,
-	0x01, 0x48, 0x76, 0xe0, 0x86, 0x82, 0xa7, 0xba, 0xde, 0xff, 0x5b, 0x27, 0xf7, 0xde, 0x6a, 0xe7,
-	0x23, 0xc9, 0x24, 0xd0, 0x21, 0x69, 0x64, 0x2c, 0x67, 0x53, 0xec, 0x5a, 0x3b, 0xd6, 0x6e, 0x73,
-	0xf0, 0xd8, 0xa9, 0xf8, 0x27, 0xce, 0x3b, 0x45, 0x1d, 0xd5, 0x4f, 0x7f, 0x6d, 0xd7, 0x3c, 0x23,
-	0xa4, 0x01, 0xa1, 0x63, 0x80, 0x08, 0x72, 0x3f, 0x82, 0x04, 0x62, 0x26, 0xb9, 0x48, 0xb1, 0xbb,
-	0xb6, 0xb3, 0xbe, 0xdb, 0x1c, 0xec, 0x57, 0xb6, 0x7b, 0xa3, 0x64, 0xaf, 0x2e, 0x54, 0xa6, 0xf1,
-	0xd6, 0x78, 0x05, 0x47, 0xfa, 0x85, 0xb4, 0xe0, 0x38, 0x9c, 0xb0, 0x34, 0x06, 0x3f, 0x67, 0x12,
-	0xb0, 0xbb, 0xae, 0xfa, 0x3b, 0x95, 0xfd, 0x5f, 0x1b, 0x89, 0xc7, 0x24, 0xbc, 0x9f, 0x65, 0x09,
-	0x8c, 0x7a, 0x85, 0xc1, 0x8f, 0xdf, 0xdb, 0xf4, 0x4a, 0x09, 0xbd, 0x0d, 0xb8, 0x84, 0x21, 0xfd,
-	0x44, 0xda, 0x19, 0xa4, 0x2c, 0x91, 0x27, 0x7e, 0x28, 0x66, 0xa9, 0x84, 0x1c, 0xbb, 0x75, 0x65,
-	0xba, 0x57, 0x3d, 0x23, 0x2d, 0x7a, 0xa9, 0x35, 0x26, 0xd2, 0x66, 0x56, 0x42, 0x91, 0x7e, 0x25,
-	0x8f, 0x58, 0x1c, 0xe7, 0x45, 0x40, 0xf0, 0x4b, 0xd1, 0xfc, 0xb9, 0x28, 0xf2, 0x35, 0x94, 0xd5,
-	0x8b, 0x4a, 0xab, 0xe1, 0x79, 0x87, 0xcb, 0x69, 0x3e, 0x08, 0x09, 0xc6, 0xb5, 0xc7, 0x6e, 0x22,
-	0x20, 0xfd, 0x4c, 0x36, 0xb3, 0x9c, 0x87, 0xe0, 0x63, 0xca, 0x32, 0x9c, 0x08, 0x89, 0xdd, 0xdb,
-	0xca, 0xf2, 0x69, 0x75, 0xba, 0x42, 0x73, 0x64, 0x24, 0xa3, 0x07, 0x66, 0x9c, 0xad, 0x12, 0x8c,
-	0x5e, 0x2b, 0x2b, 0xdd, 0x0f, 0xeb, 0x77, 0x6e, 0xb5, 0x1b, 0xfd, 0x31, 0x69, 0xaf, 0x6e, 0x9c,
-	0x3e, 0x21, 0x2d, 0xf3, 0x78, 0x58, 0x14, 0xe5, 0x80, 0xfa, 0x1d, 0xde, 0xf5, 0x36, 0x34, 0x3a,
-	0xd4, 0x20, 0xdd, 0x23, 0x5b, 0x73, 0x96, 0xf0, 0x88, 0x49, 0xf1, 0x9f, 0xb9, 0xa6, 0x98, 0xed,
-	0x8b, 0x82, 0x21, 0xf7, 0xbf, 0x5b, 0xa4, 0x55, 0xde, 0xc2, 0xf5, 0x7a, 0xeb, 0x7a, 0x3d, 0x65,
-	0xa4, 0x53, 0xec, 0xc0, 0x5f, 0x59, 0xbf, 0xf2, 0x6b, 0x0e, 0xdc, 0xca, 0xf9, 0x14, 0xc3, 0x2d,
-	0x7b, 0x7b, 0x74, 0x7e, 0x05, 0x1b, 0x1d, 0x9e, 0x2e, 0x6c, 0xeb, 0x6c, 0x61, 0x5b, 0x7f, 0x16,
-	0xb6, 0xf5, 0x6d, 0x69, 0xd7, 0xce, 0x96, 0x76, 0xed, 0xe7, 0xd2, 0xae, 0x7d, 0x7c, 0x16, 0x73,
-	0x39, 0x99, 0x05, 0x4e, 0x28, 0xa6, 0x2e, 0x02, 0xdf, 0x3f, 0x77, 0x52, 0x17, 0x65, 0xe5, 0x1e,
-	0x9b, 0x6f, 0xde, 0x95, 0x27, 0x19, 0x60, 0xd0, 0x50, 0x94, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0xe9, 0x57, 0x70, 0x5a, 0x04, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0x14, 0x41,
+	0x10, 0xdd, 0x01, 0x5c, 0xa1, 0x91, 0x65, 0x69, 0x09, 0x99, 0xac, 0x61, 0x20, 0x18, 0x13, 0x22,
+	0x61, 0x46, 0x30, 0x31, 0xf1, 0xc8, 0xe2, 0x47, 0xc2, 0x89, 0x2c, 0xc6, 0x83, 0x31, 0x99, 0xf4,
+	0xf4, 0x16, 0xb3, 0x1d, 0x86, 0xe9, 0xb1, 0xab, 0x77, 0x03, 0x27, 0xaf, 0x1e, 0xfd, 0x09, 0x9e,
+	0xfd, 0x25, 0x1c, 0x39, 0x7a, 0x52, 0x03, 0x7f, 0xc2, 0xa3, 0x99, 0xee, 0x06, 0x19, 0x3e, 0x26,
+	0xf1, 0xb4, 0xd3, 0xaf, 0xde, 0xab, 0xd7, 0xaf, 0x52, 0xbd, 0x64, 0x5e, 0x2a, 0xc6, 0x33, 0x88,
+	0x52, 0xc8, 0x01, 0x05, 0x86, 0x85, 0x92, 0x5a, 0xd2, 0x47, 0x08, 0xc2, 0x7c, 0x71, 0x99, 0x85,
+	0x08, 0x82, 0x0f, 0x98, 0xc8, 0x43, 0x4b, 0xed, 0xcc, 0xa7, 0x32, 0x95, 0xa6, 0x1a, 0x95, 0x5f,
+	0x56, 0xd2, 0x79, 0xe8, 0x1a, 0xd9, 0x1f, 0x07, 0x06, 0x5c, 0xe2, 0xa1, 0xc4, 0x28, 0x61, 0x08,
+	0xd1, 0x68, 0x23, 0x01, 0xcd, 0x36, 0x22, 0x2e, 0x45, 0x6e, 0xeb, 0x2b, 0x7f, 0x9a, 0xe4, 0xc1,
+	0x5b, 0xeb, 0xbc, 0xa7, 0x99, 0x06, 0xba, 0x45, 0x9a, 0x05, 0x53, 0xec, 0x10, 0x7d, 0x6f, 0xd9,
+	0x5b, 0x9d, 0xde, 0x7c, 0x1c, 0xd6, 0xdc, 0x24, 0xdc, 0x35, 0xd4, 0xee, 0xc4, 0xc9, 0xcf, 0xa5,
+	0x46, 0xcf, 0x09, 0x69, 0x42, 0xe8, 0x3e, 0x40, 0x1f, 0x54, 0xdc, 0x87, 0x0c, 0x52, 0xa6, 0x85,
+	0xcc, 0xd1, 0x1f, 0x5b, 0x1e, 0x5f, 0x9d, 0xde, 0x5c, 0xaf, 0x6d, 0xf7, 0xc6, 0xc8, 0x5e, 0x5d,
+	0xaa, 0x5c, 0xe3, 0xb9, 0xfd, 0x6b, 0x38, 0xd2, 0x4f, 0xa4, 0x05, 0x47, 0x7c, 0xc0, 0xf2, 0x14,
+	0x62, 0xc5, 0x34, 0xa0, 0x3f, 0x6e, 0xfa, 0x87, 0xb5, 0xfd, 0x5f, 0x3b, 0x49, 0x8f, 0x69, 0x78,
+	0x37, 0x2c, 0x32, 0xe8, 0x76, 0x4a, 0x83, 0xef, 0xbf, 0x96, 0xe8, 0x8d, 0x12, 0xf6, 0x66, 0xe0,
+	0x0a, 0x86, 0xf4, 0x23, 0x69, 0x17, 0x90, 0xb3, 0x4c, 0x1f, 0xc7, 0x5c, 0x0e, 0x73, 0x0d, 0x0a,
+	0xfd, 0x09, 0x63, 0xba, 0x56, 0x3f, 0x23, 0x2b, 0xda, 0xb6, 0x1a, 0x17, 0x69, 0xb6, 0xa8, 0xa0,
+	0x48, 0x3f, 0x93, 0x45, 0x96, 0xa6, 0xaa, 0x0c, 0x08, 0x71, 0x25, 0x5a, 0x3c, 0x92, 0x65, 0xbe,
+	0xa6, 0xb1, 0x7a, 0x51, 0x6b, 0xb5, 0x75, 0xd1, 0xe1, 0x6a, 0x9a, 0xf7, 0x52, 0x83, 0x73, 0xed,
+	0xb0, 0xbb, 0x08, 0x48, 0x0f, 0xc8, 0x6c, 0xa1, 0x04, 0x87, 0x18, 0x73, 0x56, 0xe0, 0x40, 0x6a,
+	0xf4, 0xef, 0x1b, 0xcb, 0xa7, 0xf5, 0xe9, 0x4a, 0xcd, 0x9e, 0x93, 0x74, 0x17, 0xdc, 0x38, 0x5b,
+	0x15, 0x18, 0x7b, 0xad, 0xa2, 0x72, 0xa6, 0x5f, 0x3c, 0xb2, 0x7c, 0x57, 0xdc, 0x42, 0x81, 0x4d,
+	0x3c, 0x69, 0xec, 0x5f, 0xfe, 0x7f, 0xe2, 0x5d, 0xdb, 0xc1, 0x85, 0x5e, 0x64, 0x35, 0x9c, 0x72,
+	0x93, 0x7c, 0x2e, 0x14, 0x1f, 0x0a, 0x1d, 0x27, 0x0a, 0xd8, 0x01, 0xa8, 0x18, 0x35, 0xd3, 0x43,
+	0x04, 0xf4, 0xa7, 0xcc, 0x0d, 0x36, 0x6a, 0x6f, 0xb0, 0x6d, 0xc5, 0x5d, 0xab, 0xdd, 0x33, 0x52,
+	0xe7, 0xbc, 0xc0, 0x6f, 0xa9, 0x01, 0xee, 0x4c, 0x4c, 0xde, 0x6b, 0x37, 0x57, 0xf6, 0x49, 0xfb,
+	0xfa, 0xbe, 0xd3, 0x27, 0xa4, 0xe5, 0x9e, 0x0e, 0xeb, 0xf7, 0x15, 0xa0, 0x7d, 0x85, 0x53, 0xbd,
+	0x19, 0x8b, 0x6e, 0x59, 0x90, 0xae, 0x91, 0xb9, 0x11, 0xcb, 0x44, 0x9f, 0x69, 0xf9, 0x8f, 0x39,
+	0x66, 0x98, 0xed, 0xcb, 0x82, 0x23, 0xaf, 0x7c, 0xf3, 0x48, 0xab, 0xba, 0x83, 0xb7, 0xeb, 0xbd,
+	0xdb, 0xf5, 0x94, 0x91, 0xf9, 0x72, 0x52, 0xf1, 0xb5, 0xe5, 0x37, 0x7e, 0xd3, 0x9b, 0x51, 0xed,
+	0x70, 0xca, 0xd5, 0xaa, 0x7a, 0xf7, 0xe8, 0xe8, 0x06, 0xd6, 0xdd, 0x39, 0x39, 0x0b, 0xbc, 0xd3,
+	0xb3, 0xc0, 0xfb, 0x7d, 0x16, 0x78, 0x5f, 0xcf, 0x83, 0xc6, 0xe9, 0x79, 0xd0, 0xf8, 0x71, 0x1e,
+	0x34, 0x3e, 0x3c, 0x4b, 0x85, 0x1e, 0x0c, 0x93, 0x90, 0xcb, 0xc3, 0x08, 0x41, 0xac, 0x5f, 0x38,
+	0x99, 0x83, 0xb1, 0x8a, 0x8e, 0xdc, 0x3f, 0x5e, 0xa4, 0x8f, 0x0b, 0xc0, 0xa4, 0x69, 0x28, 0xcf,
+	0xff, 0x0e, 0x00, 0x90, 0x4a, 0x9e, 0xc0, 0x58, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +297,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CircuitBreakerStatuses) > 0 {
+		for iNdEx := len(m.CircuitBreakerStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PriceSnapshots) > 0 {
 		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -488,6 +536,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerStatuses) > 0 {
+		for _, e := range m.CircuitBreakerStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -763,6 +823,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerStatuses = append(m.CircuitBreakerStatuses, CircuitBreakerStatus{})
+			if err := m.CircuitBreakerStatuses[len(m.CircuitBreakerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AggregateVoteHash is the hash value that hides the exchange rates of a vote until it is revealed,
+// computed as the truncated SHA256("{salt}:{exchange rate}{denom},...,{exchange rate}{denom}:{voter}")
+type AggregateVoteHash []byte
+
+// GetAggregateVoteHash computes the hash value of an aggregate vote. Exchange rates are
+// formatted with full decimal precision so that the hash does not depend on how the vote was written.
+func GetAggregateVoteHash(salt string, exchangeRateTuples ExchangeRateTuples, voter sdk.ValAddress) AggregateVoteHash {
+	exchangeRates := make([]string, len(exchangeRateTuples))
+	for i, tuple := range exchangeRateTuples {
+		exchangeRates[i] = tuple.ExchangeRate.String() + tuple.Denom
+	}
+	sourceStr := fmt.Sprintf("%s:%s:%s", salt, strings.Join(exchangeRates, ","), voter.String())
+	return tmhash.Sum([]byte(sourceStr))[:tmhash.TruncatedSize]
+}
+
+// AggregateVoteHashFromHexString converts a hex string to AggregateVoteHash
+func AggregateVoteHashFromHexString(s string) (AggregateVoteHash, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// String implements fmt.Stringer interface
+func (h AggregateVoteHash) String() string {
+	return hex.EncodeToString(h)
+}
+
+// Equal does bytes equal check
+func (h AggregateVoteHash) Equal(h2 AggregateVoteHash) bool {
+	return bytes.Equal(h, h2)
+}
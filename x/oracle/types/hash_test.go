@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAggregateVoteHash(t *testing.T) {
+	addr := sdk.ValAddress([]byte("addr1_______________"))
+	exchangeRates, err := ParseExchangeRateTuples("1000.23foo,0.29bar")
+	require.NoError(t, err)
+
+	hash := GetAggregateVoteHash("salt", exchangeRates, addr)
+	require.Equal(t, 20, len(hash))
+
+	// the hash round trips through its hex representation
+	hashFromHex, err := AggregateVoteHashFromHexString(hash.String())
+	require.NoError(t, err)
+	require.True(t, hash.Equal(hashFromHex))
+
+	// the same exchange rates written differently hash the same
+	sameExchangeRates, err := ParseExchangeRateTuples("1000.230foo,0.2900bar")
+	require.NoError(t, err)
+	require.True(t, hash.Equal(GetAggregateVoteHash("salt", sameExchangeRates, addr)))
+
+	// a different salt changes the hash
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt2", exchangeRates, addr)))
+
+	_, err = AggregateVoteHashFromHexString("invalid")
+	require.Error(t, err)
+}
@@ -35,6 +35,12 @@ const (
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<timestamp_Bytes>: PriceSnapshot
+//
+// - 0x08<valAddress_Bytes><period_Bytes>: AggregateExchangeRatePrevote
+//
+// - 0x09<denom_Bytes>: CircuitBreakerStatus
 var (
 	// Keys for store prefixes
 	ExchangeRateKey       = []byte{0x01} // prefix for each key to a rate
@@ -44,6 +50,10 @@ var (
 	AggregateExchangeRateVoteKey = []byte{0x05} // prefix for each key to a aggregate vote
 	VoteTargetKey                = []byte{0x06} // prefix for each key to a vote target
 	PriceSnapshotKey             = []byte{0x07} // key for price snapshots history
+	// prevotes are keyed by vote period, so that a prevote for the current period does not overwrite
+	// the prevote of the previous period before it is revealed and tallied
+	AggregateExchangeRatePrevoteKey = []byte{0x08} // prefix for each key to a aggregate prevote
+	CircuitBreakerStatusKey         = []byte{0x09} // prefix for each key to a circuit breaker status
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -66,6 +76,21 @@ func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(v)...)
 }
 
+// GetAggregateExchangeRatePrevoteKey - stored by *Validator* address and vote period
+func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress, period uint64) []byte {
+	return append(GetAggregateExchangeRatePrevoteValidatorPrefix(v), sdk.Uint64ToBigEndian(period)...)
+}
+
+// GetAggregateExchangeRatePrevoteValidatorPrefix - prefix of all the prevotes of a *Validator*
+func GetAggregateExchangeRatePrevoteValidatorPrefix(v sdk.ValAddress) []byte {
+	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
+}
+
+// GetCircuitBreakerStatusKey - stored by *denom*
+func GetCircuitBreakerStatusKey(denom string) []byte {
+	return append(CircuitBreakerStatusKey, []byte(denom)...)
+}
+
 func GetVoteTargetKey(d string) []byte {
 	return append(VoteTargetKey, []byte(d)...)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -66,3 +67,42 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(1)}, {Denom: "bar", ExchangeRate: sdk.NewDec(1232)}}
+	hash := GetAggregateVoteHash("1", exchangeRates, sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		hash       AggregateVoteHash
+		voter      sdk.AccAddress
+		expectPass bool
+	}{
+		{hash, addrs[0], true},
+		{hash[:10], addrs[0], false},
+		{AggregateVoteHash{}, addrs[0], false},
+		{hash, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAggregateExchangeRatePrevote(tc.hash, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAggregateExchangeRateReveal(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	msg := NewMsgAggregateExchangeRateReveal("1", "1.0foo", addr, sdk.ValAddress(addr))
+	require.Nil(t, msg.ValidateBasic())
+
+	msg = NewMsgAggregateExchangeRateReveal(strings.Repeat("a", MaxSaltLength+1), "1.0foo", addr, sdk.ValAddress(addr))
+	require.NotNil(t, msg.ValidateBasic())
+}
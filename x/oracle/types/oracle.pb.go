@@ -37,6 +37,12 @@ type Params struct {
 	// The minimum percentage of voting windows for which a validator must have `success`es in order to not be penalized at the end of the slash window.
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
 	LookbackDuration  uint64                                 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// The number of blocks over which the released share of the oracle module account balance is paid out to ballot winners.
+	RewardDistributionWindow uint64 `protobuf:"varint,10,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
+	// The fraction of the oracle module account balance that is released over each reward distribution window. Zero disables reward distribution.
+	RewardPoolFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=reward_pool_fraction,json=rewardPoolFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_pool_fraction" yaml:"reward_pool_fraction"`
+	// Whether exchange rates must be committed with a salted hash prevote in the vote period before they are revealed with a vote.
+	CommitRevealEnabled bool `protobuf:"varint,12,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty" yaml:"commit_reveal_enabled"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -99,8 +105,36 @@ func (m *Params) GetLookbackDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardDistributionWindow() uint64 {
+	if m != nil {
+		return m.RewardDistributionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetCommitRevealEnabled() bool {
+	if m != nil {
+		return m.CommitRevealEnabled
+	}
+	return false
+}
+
 type Denom struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// minimum number of distinct non-abstaining voters for a ballot to pass, 0 for no minimum
+	MinVoters uint64 `protobuf:"varint,2,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
+	// vote threshold overriding the global VoteThreshold param, unset to use the global one
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// reward band overriding the global RewardBand param, unset to use the global one
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// seconds after its last update beyond which the exchange rate is stale, 0 for no limit
+	MaxAge uint64 `protobuf:"varint,5,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty" yaml:"max_age,omitempty"`
+	// maximum relative change of a tallied exchange rate against the previous rate, unset for no limit
+	MaxRateChange *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_rate_change,json=maxRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate_change,omitempty" yaml:"max_rate_change,omitempty"`
+	// maximum relative deviation of a tallied exchange rate from the twap over the LookbackDuration param, unset for no limit
+	MaxTwapDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_twap_deviation,json=maxTwapDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_twap_deviation,omitempty" yaml:"max_twap_deviation,omitempty"`
+	// number of consecutive breaching vote periods that confirm a held exchange rate, 0 to halt the denom on a breach instead
+	ConfirmationPeriods uint64 `protobuf:"varint,8,opt,name=confirmation_periods,json=confirmationPeriods,proto3" json:"confirmation_periods,omitempty" yaml:"confirmation_periods,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -138,6 +172,8 @@ var xxx_messageInfo_Denom proto.InternalMessageInfo
 type AggregateExchangeRateVote struct {
 	ExchangeRateTuples ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rate_tuples,json=exchangeRateTuples,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rate_tuples" yaml:"exchange_rate_tuples"`
 	Voter              string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	// salt used to commit the exchange rates in the prevote, only set when commit-reveal is enabled
+	Salt string `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
 }
 
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
@@ -172,6 +208,44 @@ func (m *AggregateExchangeRateVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
 
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+}
+
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{3}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
 type ExchangeRateTuple struct {
 	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
@@ -180,7 +254,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{3}
+	return fileDescriptor_dc470b50b143d488, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +292,7 @@ type OracleExchangeRate struct {
 func (m *OracleExchangeRate) Reset()      { *m = OracleExchangeRate{} }
 func (*OracleExchangeRate) ProtoMessage() {}
 func (*OracleExchangeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{4}
+	return fileDescriptor_dc470b50b143d488, []int{5}
 }
 func (m *OracleExchangeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +330,7 @@ func (m *PriceSnapshotItem) Reset()         { *m = PriceSnapshotItem{} }
 func (m *PriceSnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshotItem) ProtoMessage()    {}
 func (*PriceSnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{5}
+	return fileDescriptor_dc470b50b143d488, []int{6}
 }
 func (m *PriceSnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +382,7 @@ func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
 func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshot) ProtoMessage()    {}
 func (*PriceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{6}
+	return fileDescriptor_dc470b50b143d488, []int{7}
 }
 func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +435,7 @@ func (m *OracleTwap) Reset()         { *m = OracleTwap{} }
 func (m *OracleTwap) String() string { return proto.CompactTextString(m) }
 func (*OracleTwap) ProtoMessage()    {}
 func (*OracleTwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{7}
+	return fileDescriptor_dc470b50b143d488, []int{8}
 }
 func (m *OracleTwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,6 +478,139 @@ func (m *OracleTwap) GetLookbackSeconds() int64 {
 	return 0
 }
 
+// OraclePriceStats are statistics of the price snapshots of a denom over a lookback window
+type OraclePriceStats struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exponential moving average of the snapshot prices, each weighted by half for every half-life of age
+	Ema github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ema,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema" yaml:"ema"`
+	// square root of the sum of the squared returns between consecutive snapshot prices
+	RealizedVolatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=realized_volatility,json=realizedVolatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_volatility" yaml:"realized_volatility"`
+	High               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high" yaml:"high"`
+	Low                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low" yaml:"low"`
+	SampleCount        uint64                                 `protobuf:"varint,6,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty" yaml:"sample_count"`
+	// seconds between the oldest snapshot of the denom in the lookback window and the block time
+	LookbackSeconds int64 `protobuf:"varint,7,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty" yaml:"lookback_seconds"`
+}
+
+func (m *OraclePriceStats) Reset()         { *m = OraclePriceStats{} }
+func (m *OraclePriceStats) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceStats) ProtoMessage()    {}
+func (*OraclePriceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{9}
+}
+func (m *OraclePriceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceStats.Merge(m, src)
+}
+func (m *OraclePriceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceStats proto.InternalMessageInfo
+
+func (m *OraclePriceStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OraclePriceStats) GetSampleCount() uint64 {
+	if m != nil {
+		return m.SampleCount
+	}
+	return 0
+}
+
+func (m *OraclePriceStats) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
+// CircuitBreakerStatus is the state of a denom whose tallied exchange rate breached its circuit breaker limits
+type CircuitBreakerStatus struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// whether the exchange rate of the denom is halted until a tally lands within its limits again
+	Halted bool `protobuf:"varint,2,opt,name=halted,proto3" json:"halted,omitempty" yaml:"halted"`
+	// the latest breaching exchange rate, not applied to the denom
+	HeldExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=held_exchange_rate,json=heldExchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"held_exchange_rate" yaml:"held_exchange_rate"`
+	// number of consecutive breaching vote periods after the first one
+	Confirmations uint64 `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty" yaml:"confirmations"`
+}
+
+func (m *CircuitBreakerStatus) Reset()         { *m = CircuitBreakerStatus{} }
+func (m *CircuitBreakerStatus) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerStatus) ProtoMessage()    {}
+func (*CircuitBreakerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{10}
+}
+func (m *CircuitBreakerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerStatus.Merge(m, src)
+}
+func (m *CircuitBreakerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerStatus proto.InternalMessageInfo
+
+func (m *CircuitBreakerStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CircuitBreakerStatus) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *CircuitBreakerStatus) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
 type VotePenaltyCounter struct {
 	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
 	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
@@ -414,7 +621,7 @@ func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
 func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
 func (*VotePenaltyCounter) ProtoMessage()    {}
 func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{8}
+	return fileDescriptor_dc470b50b143d488, []int{11}
 }
 func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,80 +675,120 @@ func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.oracle.Params")
 	proto.RegisterType((*Denom)(nil), "seiprotocol.seichain.oracle.Denom")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRateVote")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRatePrevote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "seiprotocol.seichain.oracle.ExchangeRateTuple")
 	proto.RegisterType((*OracleExchangeRate)(nil), "seiprotocol.seichain.oracle.OracleExchangeRate")
 	proto.RegisterType((*PriceSnapshotItem)(nil), "seiprotocol.seichain.oracle.PriceSnapshotItem")
 	proto.RegisterType((*PriceSnapshot)(nil), "seiprotocol.seichain.oracle.PriceSnapshot")
 	proto.RegisterType((*OracleTwap)(nil), "seiprotocol.seichain.oracle.OracleTwap")
+	proto.RegisterType((*OraclePriceStats)(nil), "seiprotocol.seichain.oracle.OraclePriceStats")
+	proto.RegisterType((*CircuitBreakerStatus)(nil), "seiprotocol.seichain.oracle.CircuitBreakerStatus")
 	proto.RegisterType((*VotePenaltyCounter)(nil), "seiprotocol.seichain.oracle.VotePenaltyCounter")
 }
 
 func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
 
 var fileDescriptor_dc470b50b143d488 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbd, 0x6f, 0x23, 0x45,
-	0x14, 0xf7, 0xe6, 0x0b, 0x3c, 0xb6, 0xb9, 0x78, 0xe2, 0x03, 0xdf, 0x97, 0x37, 0x9a, 0x13, 0xa7,
-	0x50, 0x9c, 0xcd, 0x1d, 0x05, 0xc2, 0x12, 0x05, 0x26, 0x1c, 0x0a, 0x3a, 0x44, 0x98, 0x33, 0x41,
-	0xa2, 0x59, 0x8d, 0x77, 0x07, 0x7b, 0x94, 0xdd, 0x9d, 0xd5, 0xce, 0x38, 0xbe, 0x14, 0x50, 0x50,
-	0x51, 0x22, 0x2a, 0x24, 0x9a, 0xd4, 0xfc, 0x01, 0xfc, 0x0d, 0x57, 0x50, 0x5c, 0x89, 0x28, 0x16,
-	0x94, 0x34, 0x74, 0x48, 0x6e, 0x69, 0xd0, 0x7c, 0xd8, 0xde, 0x64, 0x43, 0x84, 0x85, 0xae, 0xda,
-	0x7d, 0xbf, 0xf7, 0xe6, 0x37, 0x6f, 0x7e, 0xef, 0xbd, 0xd1, 0x80, 0x2d, 0x9e, 0x12, 0x3f, 0xa4,
-	0x1d, 0xf3, 0x69, 0x27, 0x29, 0x97, 0x1c, 0xde, 0x12, 0x94, 0xe9, 0x3f, 0x9f, 0x87, 0x6d, 0x41,
-	0x99, 0x3f, 0x22, 0x2c, 0x6e, 0x9b, 0x90, 0x9b, 0x8d, 0x21, 0x1f, 0x72, 0xed, 0xed, 0xa8, 0x3f,
-	0xb3, 0xe4, 0x66, 0xcb, 0xe7, 0x22, 0xe2, 0xa2, 0x33, 0x20, 0x82, 0x76, 0x8e, 0x1e, 0x0c, 0xa8,
-	0x24, 0x0f, 0x3a, 0x3e, 0x67, 0xb1, 0xf1, 0xa3, 0x6f, 0x36, 0xc0, 0xc6, 0x3e, 0x49, 0x49, 0x24,
-	0xe0, 0xdb, 0xa0, 0x72, 0xc4, 0x25, 0xf5, 0x12, 0x9a, 0x32, 0x1e, 0x34, 0x9d, 0x6d, 0x67, 0x67,
-	0xad, 0xf7, 0xea, 0x34, 0x73, 0xe1, 0x31, 0x89, 0xc2, 0x2e, 0xca, 0x39, 0x11, 0x06, 0xca, 0xda,
-	0xd7, 0x06, 0x8c, 0xc1, 0x2b, 0xda, 0x27, 0x47, 0x29, 0x15, 0x23, 0x1e, 0x06, 0xcd, 0x95, 0x6d,
-	0x67, 0xa7, 0xdc, 0xfb, 0xf0, 0x59, 0xe6, 0x96, 0x7e, 0xcb, 0xdc, 0x7b, 0x43, 0x26, 0x47, 0xe3,
-	0x41, 0xdb, 0xe7, 0x51, 0xc7, 0xa6, 0x63, 0x3e, 0xf7, 0x45, 0x70, 0xd8, 0x91, 0xc7, 0x09, 0x15,
-	0xed, 0x5d, 0xea, 0x4f, 0x33, 0xf7, 0x7a, 0x6e, 0xa7, 0x39, 0x1b, 0xc2, 0x35, 0x05, 0xf4, 0x67,
-	0x36, 0xa4, 0xa0, 0x92, 0xd2, 0x09, 0x49, 0x03, 0x6f, 0x40, 0xe2, 0xa0, 0xb9, 0xaa, 0x37, 0xdb,
-	0x5d, 0x7a, 0x33, 0x7b, 0xac, 0x1c, 0x15, 0xc2, 0xc0, 0x58, 0x3d, 0x12, 0x07, 0x70, 0x08, 0xca,
-	0x93, 0x11, 0x93, 0x34, 0x64, 0x42, 0x36, 0xd7, 0xb6, 0x57, 0x77, 0x2a, 0x0f, 0x51, 0xfb, 0x8a,
-	0x0a, 0xb4, 0x77, 0x69, 0xcc, 0xa3, 0xde, 0xeb, 0x2a, 0x91, 0x69, 0xe6, 0x6e, 0x1a, 0xfa, 0x39,
-	0x05, 0xfa, 0xe9, 0x77, 0xb7, 0xac, 0x43, 0x1e, 0x33, 0x21, 0xf1, 0x82, 0x5b, 0xe9, 0x27, 0x42,
-	0x22, 0x46, 0xde, 0x97, 0x29, 0xf1, 0x25, 0xe3, 0x71, 0x73, 0xfd, 0xff, 0xe9, 0x77, 0x9e, 0x0d,
-	0xe1, 0x9a, 0x06, 0x1e, 0x59, 0x1b, 0x76, 0x41, 0xd5, 0x44, 0x4c, 0x58, 0x1c, 0xf0, 0x49, 0x73,
-	0x43, 0x57, 0xfa, 0xb5, 0x69, 0xe6, 0x6e, 0xe5, 0xd7, 0x1b, 0x2f, 0xc2, 0x15, 0x6d, 0x7e, 0xae,
-	0x2d, 0xf8, 0x35, 0x68, 0x44, 0x2c, 0xf6, 0x8e, 0x48, 0xc8, 0x02, 0xd5, 0x0c, 0x33, 0x8e, 0x97,
-	0x74, 0xc6, 0x1f, 0x2f, 0x9d, 0xf1, 0x2d, 0xb3, 0xe3, 0x65, 0x9c, 0x08, 0xd7, 0x23, 0x16, 0x1f,
-	0x28, 0x74, 0x9f, 0xa6, 0x76, 0xff, 0x3d, 0x50, 0x0f, 0x39, 0x3f, 0x1c, 0x10, 0xff, 0xd0, 0x0b,
-	0xc6, 0x29, 0xd1, 0x72, 0x95, 0xf5, 0x01, 0x6e, 0x4f, 0x33, 0xb7, 0x69, 0xe8, 0x0a, 0x21, 0x08,
-	0x6f, 0xce, 0xb0, 0x5d, 0x0b, 0x75, 0x5f, 0xfe, 0xe1, 0xc4, 0x2d, 0xfd, 0x79, 0xe2, 0x3a, 0xa8,
-	0x0b, 0xd6, 0x75, 0x61, 0xe0, 0x5d, 0xb0, 0x16, 0x93, 0x88, 0xea, 0xde, 0x2f, 0xf7, 0xae, 0x4d,
-	0x33, 0xb7, 0x62, 0x08, 0x15, 0x8a, 0xb0, 0x76, 0x76, 0xab, 0xdf, 0x9e, 0xb8, 0x25, 0xbb, 0xb6,
-	0x84, 0xfe, 0x72, 0xc0, 0x8d, 0xf7, 0x86, 0xc3, 0x94, 0x0e, 0x89, 0xa4, 0x1f, 0x3c, 0xf5, 0x47,
-	0x24, 0x1e, 0x52, 0x4c, 0x24, 0x3d, 0xe0, 0x92, 0xc2, 0x1f, 0x1d, 0xd0, 0xa0, 0x16, 0xf4, 0x52,
-	0xa2, 0xda, 0x7a, 0x9c, 0x84, 0x54, 0x34, 0x1d, 0xdd, 0x4f, 0xed, 0x2b, 0xfb, 0x29, 0xcf, 0xd6,
-	0x57, 0xcb, 0x7a, 0xef, 0xd8, 0xde, 0xb2, 0xaa, 0x5d, 0xc6, 0xac, 0xda, 0x0c, 0x16, 0x56, 0x0a,
-	0x0c, 0x69, 0x01, 0x83, 0xf7, 0xc0, 0xba, 0x9a, 0xac, 0xd4, 0xce, 0xeb, 0xe6, 0x34, 0x73, 0xab,
-	0x8b, 0x09, 0x4c, 0x11, 0x36, 0xee, 0x0b, 0x27, 0xfe, 0xd9, 0x01, 0xf5, 0xc2, 0x06, 0x8a, 0x2b,
-	0x50, 0x1a, 0x5a, 0xed, 0x72, 0x5c, 0x1a, 0x46, 0xd8, 0xb8, 0xe1, 0x21, 0xa8, 0x9d, 0x4b, 0xdb,
-	0xee, 0xfd, 0x68, 0xe9, 0xce, 0x69, 0x5c, 0xa2, 0x01, 0xc2, 0xd5, 0xfc, 0x31, 0x2f, 0x24, 0xfe,
-	0xcb, 0x0a, 0x80, 0x9f, 0x68, 0x69, 0xf3, 0xe9, 0x17, 0x33, 0x72, 0x5e, 0x5c, 0x46, 0xea, 0xee,
-	0x0a, 0x89, 0x90, 0xde, 0x38, 0x09, 0x16, 0x87, 0x5f, 0xe6, 0xee, 0xda, 0x8b, 0xe5, 0xe2, 0xee,
-	0xca, 0x51, 0x21, 0x0c, 0x94, 0xf5, 0x99, 0x36, 0x60, 0x1f, 0x5c, 0xcf, 0xf9, 0x3c, 0xc9, 0x22,
-	0x2a, 0x24, 0x89, 0x12, 0x7d, 0x59, 0xae, 0xf6, 0xb6, 0xa7, 0x99, 0x7b, 0xbb, 0x40, 0xb1, 0x08,
-	0x43, 0x78, 0x6b, 0x41, 0xd6, 0x9f, 0xa1, 0x17, 0xe4, 0xfc, 0xde, 0x01, 0xf5, 0xfd, 0x94, 0xf9,
-	0xf4, 0x49, 0x4c, 0x12, 0x31, 0xe2, 0x72, 0x4f, 0xd2, 0x08, 0x36, 0xce, 0xf5, 0xc1, 0xac, 0xea,
-	0x43, 0xd0, 0x30, 0x4d, 0xed, 0x15, 0x8b, 0x5f, 0x79, 0xd8, 0xb9, 0x72, 0x0c, 0x8a, 0x25, 0xeb,
-	0xad, 0x29, 0xc1, 0x30, 0xe4, 0x05, 0x0f, 0xfa, 0xdb, 0x01, 0xb5, 0x73, 0x49, 0xc1, 0xc7, 0x00,
-	0x0a, 0xfb, 0x9f, 0xd3, 0xc1, 0xd1, 0x3a, 0xdc, 0x99, 0x66, 0xee, 0x0d, 0x7b, 0xe7, 0x15, 0x62,
-	0x10, 0xae, 0xcf, 0xc0, 0xb9, 0x04, 0x7a, 0xa0, 0x13, 0xc5, 0xef, 0xcd, 0x17, 0x30, 0x49, 0x23,
-	0xd1, 0x5c, 0xf9, 0x0f, 0x03, 0x5d, 0x50, 0xeb, 0xe2, 0x40, 0x5f, 0xc6, 0xac, 0x07, 0xba, 0xb0,
-	0x52, 0x60, 0x98, 0x14, 0x30, 0x74, 0xe2, 0x00, 0x60, 0xe4, 0xea, 0x4f, 0x48, 0xf2, 0x2f, 0xb5,
-	0xf8, 0x14, 0xac, 0xc9, 0x09, 0x49, 0x6c, 0xef, 0xbd, 0xbb, 0x74, 0x9b, 0xdb, 0x2b, 0x51, 0x71,
-	0x20, 0xac, 0xa9, 0xe0, 0x1b, 0x60, 0x7e, 0xbd, 0x7a, 0x82, 0xfa, 0x3c, 0x0e, 0x84, 0xe9, 0x34,
-	0x7c, 0x6d, 0x86, 0x3f, 0x31, 0x30, 0xfa, 0x0a, 0xc0, 0x03, 0xfd, 0x74, 0x88, 0x49, 0x28, 0x8f,
-	0xdf, 0xe7, 0xe3, 0x58, 0xd2, 0x14, 0xde, 0x01, 0x20, 0x62, 0x42, 0x78, 0xbe, 0xb2, 0xcd, 0xd3,
-	0x03, 0x97, 0x15, 0xa2, 0x03, 0xe0, 0x5d, 0x50, 0x23, 0x03, 0x21, 0x09, 0x8b, 0x6d, 0xc4, 0x8a,
-	0x8e, 0xa8, 0x5a, 0x70, 0x1e, 0x24, 0xc6, 0xbe, 0x4f, 0xe7, 0x34, 0xab, 0x26, 0xc8, 0x82, 0x3a,
-	0xa8, 0xf7, 0xd1, 0xb3, 0xd3, 0x96, 0xf3, 0xfc, 0xb4, 0xe5, 0xfc, 0x71, 0xda, 0x72, 0xbe, 0x3b,
-	0x6b, 0x95, 0x9e, 0x9f, 0xb5, 0x4a, 0xbf, 0x9e, 0xb5, 0x4a, 0x5f, 0xbc, 0x99, 0x13, 0x40, 0x50,
-	0x76, 0x7f, 0x56, 0x45, 0x6d, 0xe8, 0x32, 0x76, 0x9e, 0xda, 0xe7, 0x98, 0x91, 0x63, 0xb0, 0xa1,
-	0x43, 0xde, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x10, 0x21, 0xd7, 0xac, 0x09, 0x00, 0x00,
+	// 1582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x3d, 0x6c, 0x1b, 0x47,
+	0x16, 0xd6, 0x4a, 0x94, 0x2c, 0x8d, 0xa4, 0xb3, 0x34, 0xa2, 0xcf, 0x6b, 0x59, 0xd6, 0xea, 0xc6,
+	0xb0, 0x21, 0x03, 0x67, 0xf2, 0xec, 0xc3, 0xe1, 0x70, 0xc2, 0x9d, 0x01, 0xd3, 0xb2, 0x0f, 0xbe,
+	0xf3, 0xf9, 0xe4, 0xb1, 0xec, 0x03, 0x0e, 0x01, 0x16, 0xc3, 0xdd, 0x31, 0x39, 0xd0, 0xee, 0x0e,
+	0xb1, 0x33, 0xd4, 0x8f, 0x01, 0x27, 0x45, 0x9a, 0x94, 0x41, 0x90, 0x22, 0x40, 0x80, 0x40, 0x45,
+	0xaa, 0xf4, 0x49, 0x99, 0xda, 0x45, 0x0a, 0x97, 0x41, 0x8a, 0x4d, 0x60, 0x37, 0xa9, 0xd9, 0x26,
+	0x45, 0x30, 0x3f, 0x24, 0x97, 0x5c, 0xda, 0x31, 0x21, 0xa4, 0x22, 0xdf, 0xf7, 0xde, 0x7c, 0xf3,
+	0xf6, 0xbd, 0x37, 0xef, 0xcd, 0x2e, 0x58, 0xe1, 0x29, 0x09, 0x22, 0x5a, 0x35, 0x3f, 0x95, 0x56,
+	0xca, 0x25, 0x87, 0xe7, 0x05, 0x65, 0xfa, 0x5f, 0xc0, 0xa3, 0x8a, 0xa0, 0x2c, 0x68, 0x12, 0x96,
+	0x54, 0x8c, 0xc9, 0x6a, 0xb9, 0xc1, 0x1b, 0x5c, 0x6b, 0xab, 0xea, 0x9f, 0x59, 0xb2, 0xba, 0x1e,
+	0x70, 0x11, 0x73, 0x51, 0xad, 0x13, 0x41, 0xab, 0xfb, 0xd7, 0xea, 0x54, 0x92, 0x6b, 0xd5, 0x80,
+	0xb3, 0xc4, 0xe8, 0xd1, 0xd7, 0xb3, 0x60, 0x66, 0x87, 0xa4, 0x24, 0x16, 0xf0, 0xaf, 0x60, 0x7e,
+	0x9f, 0x4b, 0xea, 0xb7, 0x68, 0xca, 0x78, 0xe8, 0x3a, 0x1b, 0xce, 0x66, 0xa9, 0xf6, 0xfb, 0x4e,
+	0xe6, 0xc1, 0x23, 0x12, 0x47, 0x5b, 0x28, 0xa7, 0x44, 0x18, 0x28, 0x69, 0x47, 0x0b, 0x30, 0x01,
+	0xbf, 0xd3, 0x3a, 0xd9, 0x4c, 0xa9, 0x68, 0xf2, 0x28, 0x74, 0x27, 0x37, 0x9c, 0xcd, 0xb9, 0xda,
+	0x3f, 0x9f, 0x67, 0xde, 0xc4, 0x77, 0x99, 0x77, 0xb9, 0xc1, 0x64, 0xb3, 0x5d, 0xaf, 0x04, 0x3c,
+	0xae, 0x5a, 0x77, 0xcc, 0xcf, 0x55, 0x11, 0xee, 0x55, 0xe5, 0x51, 0x8b, 0x8a, 0xca, 0x36, 0x0d,
+	0x3a, 0x99, 0x77, 0x26, 0xb7, 0x53, 0x8f, 0x0d, 0xe1, 0x45, 0x05, 0xec, 0x76, 0x65, 0x48, 0xc1,
+	0x7c, 0x4a, 0x0f, 0x48, 0x1a, 0xfa, 0x75, 0x92, 0x84, 0xee, 0x94, 0xde, 0x6c, 0x7b, 0xec, 0xcd,
+	0xec, 0x63, 0xe5, 0xa8, 0x10, 0x06, 0x46, 0xaa, 0x91, 0x24, 0x84, 0x0d, 0x30, 0x77, 0xd0, 0x64,
+	0x92, 0x46, 0x4c, 0x48, 0xb7, 0xb4, 0x31, 0xb5, 0x39, 0x7f, 0x1d, 0x55, 0xde, 0x90, 0x81, 0xca,
+	0x36, 0x4d, 0x78, 0x5c, 0xbb, 0xa4, 0x1c, 0xe9, 0x64, 0xde, 0x92, 0xa1, 0xef, 0x51, 0xa0, 0x2f,
+	0xbe, 0xf7, 0xe6, 0xb4, 0xc9, 0x3d, 0x26, 0x24, 0xee, 0x73, 0xab, 0xf8, 0x89, 0x88, 0x88, 0xa6,
+	0xff, 0x24, 0x25, 0x81, 0x64, 0x3c, 0x71, 0xa7, 0x4f, 0x16, 0xbf, 0x41, 0x36, 0x84, 0x17, 0x35,
+	0x70, 0xc7, 0xca, 0x70, 0x0b, 0x2c, 0x18, 0x8b, 0x03, 0x96, 0x84, 0xfc, 0xc0, 0x9d, 0xd1, 0x99,
+	0x3e, 0xdb, 0xc9, 0xbc, 0x95, 0xfc, 0x7a, 0xa3, 0x45, 0x78, 0x5e, 0x8b, 0xff, 0xd3, 0x12, 0x7c,
+	0x17, 0x94, 0x63, 0x96, 0xf8, 0xfb, 0x24, 0x62, 0xa1, 0x2a, 0x86, 0x2e, 0xc7, 0x29, 0xed, 0xf1,
+	0x7f, 0xc6, 0xf6, 0xf8, 0xbc, 0xd9, 0x71, 0x14, 0x27, 0xc2, 0xcb, 0x31, 0x4b, 0x1e, 0x2b, 0x74,
+	0x87, 0xa6, 0x76, 0xff, 0xbb, 0x60, 0x39, 0xe2, 0x7c, 0xaf, 0x4e, 0x82, 0x3d, 0x3f, 0x6c, 0xa7,
+	0x44, 0x87, 0x6b, 0x4e, 0x3f, 0xc0, 0x5a, 0x27, 0xf3, 0x5c, 0x43, 0x57, 0x30, 0x41, 0x78, 0xa9,
+	0x8b, 0x6d, 0x5b, 0x08, 0x06, 0x60, 0xd5, 0xe6, 0x3e, 0x64, 0x42, 0xa6, 0xac, 0xde, 0x56, 0x70,
+	0xf7, 0x81, 0x80, 0xe6, 0xbc, 0xd4, 0xc9, 0xbc, 0x3f, 0x0c, 0xd4, 0xc9, 0x08, 0x5b, 0x84, 0x5d,
+	0xa3, 0xdc, 0xce, 0xe9, 0xac, 0xbf, 0xef, 0x81, 0xb2, 0x5d, 0xd8, 0xe2, 0x3c, 0xea, 0x67, 0x78,
+	0xfe, 0x64, 0xf1, 0x1a, 0xc5, 0x89, 0x30, 0x34, 0xf0, 0x0e, 0xe7, 0x51, 0x2f, 0xd9, 0xbb, 0xe0,
+	0x4c, 0xc0, 0xe3, 0x98, 0x49, 0x3f, 0xa5, 0xfb, 0x94, 0x44, 0x3e, 0x4d, 0x48, 0x3d, 0xa2, 0xa1,
+	0xbb, 0xb0, 0xe1, 0x6c, 0xce, 0xd6, 0x36, 0x3a, 0x99, 0xb7, 0x66, 0x38, 0x47, 0x9a, 0x21, 0xbc,
+	0x62, 0x70, 0xac, 0xe1, 0xdb, 0x06, 0xdd, 0x9a, 0xfd, 0xe4, 0xd8, 0x9b, 0xf8, 0xf1, 0xd8, 0x73,
+	0xd0, 0xcf, 0xd3, 0x60, 0x5a, 0x57, 0x35, 0xbc, 0x08, 0x4a, 0x09, 0x89, 0xa9, 0x6e, 0x1c, 0x73,
+	0xb5, 0xd3, 0x9d, 0xcc, 0x9b, 0x37, 0xc4, 0x0a, 0x45, 0x58, 0x2b, 0xe1, 0x0d, 0x00, 0x74, 0xae,
+	0xb9, 0xa4, 0xa9, 0xd0, 0x7d, 0xa2, 0x54, 0xf3, 0x86, 0xea, 0x40, 0xeb, 0xfe, 0xc8, 0x63, 0x26,
+	0x69, 0xdc, 0x92, 0x47, 0x08, 0xcf, 0xa9, 0x3a, 0xd0, 0x28, 0x3c, 0x2c, 0xf4, 0x1a, 0x73, 0xfc,
+	0x1f, 0x3c, 0xcf, 0x3c, 0x67, 0xac, 0x48, 0x7a, 0xa3, 0x7a, 0x4d, 0x7e, 0xd7, 0xa1, 0xae, 0xc3,
+	0x07, 0xbb, 0x4e, 0x49, 0x6f, 0x7b, 0x7f, 0xec, 0x6d, 0xd7, 0x0a, 0x5d, 0x27, 0xbf, 0x67, 0xbe,
+	0xff, 0xfc, 0x05, 0x9c, 0x8a, 0xc9, 0xa1, 0x4f, 0x1a, 0xd4, 0x9d, 0x1e, 0x2e, 0x70, 0xab, 0xc8,
+	0x2f, 0x9d, 0x89, 0xc9, 0xe1, 0xcd, 0x06, 0x85, 0x4f, 0xc1, 0x69, 0xa5, 0x4d, 0x89, 0xa4, 0x7e,
+	0xd0, 0x24, 0x49, 0x83, 0xea, 0x03, 0x3e, 0x57, 0xc3, 0x63, 0xfb, 0xba, 0xd1, 0xdf, 0x2c, 0x47,
+	0x37, 0x10, 0xa3, 0x98, 0x1c, 0x62, 0x22, 0xe9, 0x2d, 0xad, 0x81, 0xef, 0x3b, 0x00, 0x2a, 0x6b,
+	0x79, 0x40, 0x5a, 0x7e, 0x48, 0xf7, 0x99, 0x39, 0x9f, 0xa6, 0x39, 0x3c, 0x1a, 0x7b, 0xff, 0x8b,
+	0xfd, 0xfd, 0x07, 0x19, 0xf3, 0x2e, 0x2c, 0xc5, 0xe4, 0x70, 0xf7, 0x80, 0xb4, 0xb6, 0xbb, 0x4a,
+	0xf8, 0x0e, 0x28, 0x07, 0x3c, 0x79, 0xc2, 0xd2, 0x58, 0xcb, 0x76, 0x66, 0x09, 0x77, 0x56, 0x47,
+	0xf1, 0x4a, 0x27, 0xf3, 0x2e, 0x75, 0x2b, 0xbe, 0x68, 0x95, 0xa7, 0x5e, 0xc9, 0x1b, 0x98, 0x61,
+	0x27, 0xb6, 0x16, 0x3e, 0x38, 0xf6, 0x26, 0x6c, 0xf9, 0x4f, 0xa0, 0x8f, 0x27, 0xc1, 0xb9, 0x9b,
+	0x8d, 0x46, 0x4a, 0x1b, 0x44, 0xd2, 0xdb, 0x87, 0x26, 0x42, 0x2a, 0x22, 0xaa, 0x5e, 0xe1, 0xa7,
+	0x0e, 0x28, 0x53, 0x0b, 0x9a, 0x10, 0xca, 0x76, 0x2b, 0xa2, 0xc2, 0x75, 0xf4, 0x38, 0xa9, 0xbc,
+	0x71, 0x9c, 0xe4, 0xd9, 0x76, 0xd5, 0xb2, 0xda, 0xdf, 0xec, 0x68, 0xb1, 0x87, 0x65, 0x14, 0xb3,
+	0x9a, 0x32, 0xb0, 0xb0, 0x52, 0x60, 0x48, 0x0b, 0x18, 0xbc, 0x0c, 0xa6, 0xf5, 0x59, 0xb3, 0xe3,
+	0x7a, 0xa9, 0x93, 0x79, 0x0b, 0xfd, 0x43, 0x91, 0x22, 0x6c, 0xd4, 0xea, 0x60, 0x0b, 0x12, 0x49,
+	0x77, 0x6a, 0xf8, 0x60, 0x2b, 0x14, 0x61, 0xad, 0x1c, 0x0a, 0xcb, 0x97, 0x0e, 0x58, 0x1b, 0x19,
+	0x96, 0x9d, 0x94, 0x2a, 0x52, 0xc5, 0xd9, 0x24, 0xa2, 0x59, 0x6c, 0x16, 0x0a, 0x45, 0x58, 0x2b,
+	0xdf, 0xda, 0x41, 0x35, 0xd0, 0xda, 0x75, 0xd5, 0xbc, 0xea, 0x11, 0x0f, 0xf6, 0xdc, 0xa9, 0xc2,
+	0x40, 0xcb, 0x69, 0xd5, 0x40, 0xd3, 0x62, 0x4d, 0x49, 0x43, 0x7e, 0x7f, 0xe5, 0x80, 0xe5, 0x42,
+	0xf4, 0x94, 0x1f, 0xa1, 0x6a, 0x71, 0xae, 0x33, 0xec, 0x87, 0x86, 0x11, 0x36, 0x6a, 0xb8, 0x07,
+	0x16, 0x07, 0x72, 0x62, 0xfd, 0xbe, 0x33, 0x76, 0x97, 0x2f, 0x8f, 0x48, 0x30, 0xc2, 0x0b, 0xf9,
+	0x1c, 0x0e, 0x39, 0xfe, 0xcd, 0x24, 0x80, 0xff, 0xd5, 0x75, 0x93, 0x77, 0xbf, 0xe8, 0x91, 0xf3,
+	0xdb, 0x79, 0xa4, 0xee, 0x65, 0x11, 0x11, 0xd2, 0x6f, 0xb7, 0xc2, 0xfe, 0xc3, 0x8f, 0x73, 0x2f,
+	0xbb, 0x9b, 0xc8, 0xfe, 0xbd, 0x2c, 0x47, 0x85, 0x30, 0x50, 0xd2, 0x23, 0x2d, 0xa8, 0x89, 0x96,
+	0xd3, 0xf9, 0x92, 0xc5, 0x54, 0x48, 0x12, 0xb7, 0x74, 0xda, 0xa7, 0xf2, 0x13, 0x6d, 0xa4, 0x19,
+	0xc2, 0x2b, 0x7d, 0xb2, 0xdd, 0x2e, 0x3a, 0x14, 0xce, 0x8f, 0x1c, 0xb0, 0xbc, 0x93, 0xb2, 0x80,
+	0x3e, 0x4c, 0x48, 0x4b, 0x34, 0xb9, 0xbc, 0x2b, 0x69, 0x0c, 0xcb, 0x03, 0x75, 0xd0, 0xcd, 0x7a,
+	0x03, 0x94, 0xcd, 0x89, 0xf5, 0x8b, 0xc9, 0x9f, 0xbf, 0x5e, 0x7d, 0xe3, 0x19, 0x2f, 0xa6, 0xac,
+	0x56, 0x52, 0x01, 0xc3, 0x90, 0x17, 0x34, 0xe8, 0x27, 0x07, 0x2c, 0x0e, 0x38, 0x05, 0xef, 0x01,
+	0x28, 0xec, 0xff, 0x5c, 0x1c, 0x1c, 0x1d, 0x87, 0x0b, 0x9d, 0xcc, 0x3b, 0x67, 0xcb, 0xbf, 0x60,
+	0x83, 0xf0, 0x72, 0x17, 0xec, 0x85, 0x40, 0x77, 0xab, 0x96, 0xe2, 0xf7, 0x7b, 0x0b, 0x54, 0x2b,
+	0x54, 0x63, 0xfa, 0xd7, 0xbb, 0x55, 0x21, 0x5a, 0xc3, 0xdd, 0x6a, 0x14, 0xb3, 0xee, 0x56, 0x85,
+	0x95, 0x02, 0xc3, 0x56, 0x01, 0x43, 0xc7, 0x0e, 0x00, 0x26, 0x5c, 0xaa, 0xdb, 0xbf, 0x26, 0x17,
+	0x0f, 0x40, 0x49, 0x4d, 0x0a, 0x5b, 0x7b, 0xff, 0x18, 0xbb, 0xcc, 0x6d, 0x13, 0x52, 0x1c, 0x08,
+	0x6b, 0x2a, 0x78, 0x05, 0xf4, 0xae, 0x8e, 0xbe, 0xa0, 0x01, 0x4f, 0x42, 0x61, 0x2a, 0x0d, 0x9f,
+	0xee, 0xe2, 0x0f, 0x0d, 0x8c, 0x3e, 0x2b, 0x81, 0x25, 0xe3, 0xa2, 0x79, 0x26, 0x49, 0xa4, 0x78,
+	0x8d, 0xa3, 0xf7, 0xc1, 0x14, 0x8d, 0x89, 0xf5, 0xf3, 0xef, 0x63, 0xfb, 0x09, 0xec, 0x71, 0x8c,
+	0x09, 0xc2, 0x8a, 0x08, 0x3e, 0x03, 0x2b, 0x29, 0x25, 0x11, 0x7b, 0x4a, 0x43, 0x7f, 0x9f, 0x47,
+	0x44, 0xb2, 0x88, 0xc9, 0x23, 0xdb, 0xb2, 0xef, 0x8d, 0xcd, 0xbf, 0xda, 0xbd, 0xa5, 0x14, 0x28,
+	0xf5, 0x2d, 0xd3, 0xa0, 0x8f, 0x7b, 0xa0, 0x8a, 0x7b, 0x93, 0x35, 0x9a, 0x6e, 0xe9, 0x64, 0x71,
+	0x57, 0x1c, 0xaa, 0xf9, 0xb3, 0x46, 0x53, 0x45, 0x28, 0xe2, 0x07, 0xee, 0xf4, 0xc9, 0x22, 0x14,
+	0xa9, 0xeb, 0xb9, 0x22, 0xd2, 0x43, 0x82, 0xc4, 0xad, 0x88, 0xfa, 0x01, 0x6f, 0x27, 0x72, 0xc4,
+	0x5b, 0x4f, 0x4e, 0xab, 0x86, 0x84, 0x16, 0x6f, 0x29, 0x09, 0xde, 0x19, 0x51, 0x03, 0xa7, 0xf4,
+	0x29, 0x3b, 0xdf, 0xc9, 0xbc, 0xb3, 0x43, 0x2f, 0x1d, 0xd6, 0x02, 0x15, 0x0b, 0xe4, 0xf3, 0x49,
+	0x50, 0xbe, 0xc5, 0xd2, 0xa0, 0xcd, 0x64, 0x2d, 0xa5, 0x64, 0x8f, 0xa6, 0xaa, 0x46, 0xda, 0xe2,
+	0xad, 0x27, 0xcc, 0x15, 0x30, 0xd3, 0x24, 0x91, 0xa4, 0xe6, 0x15, 0x7b, 0xb6, 0xb6, 0xdc, 0xc9,
+	0xbc, 0xc5, 0xee, 0xe0, 0x54, 0x38, 0xc2, 0xd6, 0x00, 0x1e, 0x01, 0xd8, 0xa4, 0x51, 0x38, 0xd4,
+	0x94, 0x4c, 0x41, 0xfc, 0x7b, 0xec, 0x70, 0xda, 0x4e, 0x52, 0x64, 0x44, 0x78, 0x49, 0x81, 0x03,
+	0x53, 0xe7, 0x06, 0x58, 0xcc, 0xdf, 0x9c, 0x84, 0x2e, 0x8b, 0x52, 0xcd, 0xed, 0xcf, 0x91, 0x01,
+	0x35, 0xc2, 0x83, 0xe6, 0xe8, 0x19, 0x80, 0x8f, 0xf5, 0xe7, 0x85, 0x84, 0x44, 0xf2, 0x48, 0xa7,
+	0x80, 0xa6, 0xf0, 0x82, 0x7a, 0x75, 0x10, 0xc2, 0xa6, 0x4f, 0x7f, 0x9e, 0x50, 0x6f, 0x06, 0x42,
+	0x98, 0x1c, 0x5d, 0x04, 0x8b, 0xa4, 0x2e, 0x24, 0x61, 0x89, 0xb5, 0xd0, 0x2f, 0x17, 0x78, 0xc1,
+	0x82, 0x3d, 0x23, 0xd1, 0x0e, 0x02, 0xda, 0xa3, 0x99, 0x32, 0x46, 0x16, 0xd4, 0x46, 0xb5, 0x7f,
+	0x3d, 0x7f, 0xb9, 0xee, 0xbc, 0x78, 0xb9, 0xee, 0xfc, 0xf0, 0x72, 0xdd, 0xf9, 0xf0, 0xd5, 0xfa,
+	0xc4, 0x8b, 0x57, 0xeb, 0x13, 0xdf, 0xbe, 0x5a, 0x9f, 0xf8, 0xff, 0x9f, 0x72, 0xf1, 0x12, 0x94,
+	0x5d, 0xed, 0x76, 0x43, 0x2d, 0xe8, 0x76, 0x58, 0x3d, 0xb4, 0x9f, 0x6c, 0x4c, 0xf4, 0xea, 0x33,
+	0xda, 0xe4, 0xcf, 0xbf, 0x0c, 0x00, 0xa5, 0x7f, 0xbf, 0x68, 0xd0, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -592,6 +839,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.LookbackDuration != that1.LookbackDuration {
 		return false
 	}
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
+	}
+	if !this.RewardPoolFraction.Equal(that1.RewardPoolFraction) {
+		return false
+	}
+	if this.CommitRevealEnabled != that1.CommitRevealEnabled {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +870,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitRevealEnabled {
+		i--
+		if m.CommitRevealEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.RewardPoolFraction.Size()
+		i -= size
+		if _, err := m.RewardPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.LookbackDuration != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackDuration))
 		i--
@@ -706,6 +987,69 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConfirmationPeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ConfirmationPeriods))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxTwapDeviation != nil {
+		{
+			size := m.MaxTwapDeviation.Size()
+			i -= size
+			if _, err := m.MaxTwapDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxRateChange != nil {
+		{
+			size := m.MaxRateChange.Size()
+			i -= size
+			if _, err := m.MaxRateChange.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -736,6 +1080,13 @@ func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Voter) > 0 {
 		i -= len(m.Voter)
 		copy(dAtA[i:], m.Voter)
@@ -760,6 +1111,48 @@ func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -975,7 +1368,7 @@ func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
+func (m *OraclePriceStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -985,45 +1378,180 @@ func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotePenaltyCounter) MarshalTo(dAtA []byte) (int, error) {
+func (m *OraclePriceStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OraclePriceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SuccessCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessCount))
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x38
 	}
-	if m.AbstainCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
+	if m.SampleCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SampleCount))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x30
 	}
-	if m.MissCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RealizedVolatility.Size()
+		i -= size
+		if _, err := m.RealizedVolatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Ema.Size()
+		i -= size
+		if _, err := m.Ema.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *CircuitBreakerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.HeldExchangeRate.Size()
+		i -= size
+		if _, err := m.HeldExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePenaltyCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuccessCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AbstainCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1053,6 +1581,14 @@ func (m *Params) Size() (n int) {
 	if m.LookbackDuration != 0 {
 		n += 1 + sovOracle(uint64(m.LookbackDuration))
 	}
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	l = m.RewardPoolFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.CommitRevealEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1066,6 +1602,31 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovOracle(uint64(m.MaxAge))
+	}
+	if m.MaxRateChange != nil {
+		l = m.MaxRateChange.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxTwapDeviation != nil {
+		l = m.MaxTwapDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ConfirmationPeriods != 0 {
+		n += 1 + sovOracle(uint64(m.ConfirmationPeriods))
+	}
 	return n
 }
 
@@ -1085,6 +1646,30 @@ func (m *AggregateExchangeRateVote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
 	return n
 }
 
@@ -1170,6 +1755,54 @@ func (m *OracleTwap) Size() (n int) {
 	return n
 }
 
+func (m *OraclePriceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Ema.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RealizedVolatility.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SampleCount != 0 {
+		n += 1 + sovOracle(uint64(m.SampleCount))
+	}
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *CircuitBreakerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	l = m.HeldExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Confirmations != 0 {
+		n += 1 + sovOracle(uint64(m.Confirmations))
+	}
+	return n
+}
+
 func (m *VotePenaltyCounter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1450,59 +2083,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Denom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1530,8 +2132,30 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1553,7 +2177,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *Denom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1576,17 +2200,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1596,12 +2220,295 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRateChange = &v
+			if err := m.MaxRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTwapDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxTwapDeviation = &v
+			if err := m.MaxTwapDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPeriods", wireType)
+			}
+			m.ConfirmationPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
 			postIndex := iNdEx + msglen
@@ -1611,16 +2518,548 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			}
+			m.LastUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1630,23 +3069,24 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1669,7 +3109,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,17 +3132,17 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.SnapshotTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1712,29 +3152,16 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1744,23 +3171,23 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
+			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1785,7 +3212,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,15 +3235,15 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,13 +3271,11 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1878,15 +3303,15 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			m.LookbackSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1896,7 +3321,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				m.LookbackSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1922,7 +3347,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+func (m *OraclePriceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,10 +3370,10 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: OraclePriceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OraclePriceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1985,9 +3410,9 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ema", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1997,80 +3422,65 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedVolatility", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RealizedVolatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			m.SnapshotTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2080,16 +3490,31 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2099,26 +3524,64 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
-			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleCount", wireType)
+			}
+			m.SampleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2140,7 +3603,7 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2163,10 +3626,10 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2202,8 +3665,28 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2231,15 +3714,15 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeldExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
 			}
-			m.LookbackSeconds = 0
+			m.Confirmations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2249,7 +3732,7 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= int64(b&0x7F) << shift
+				m.Confirmations |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21,12 +21,19 @@ var (
 	KeySlashWindow       = []byte("SlashWindow")
 	KeyMinValidPerWindow = []byte("MinValidPerWindow")
 	KeyLookbackDuration  = []byte("LookbackDuration")
+
+	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
+	KeyRewardPoolFraction       = []byte("RewardPoolFraction")
+	KeyCommitRevealEnabled      = []byte("CommitRevealEnabled")
 )
 
 // Default parameter values
 const (
 	DefaultVotePeriod  = 2                      // Voting every other block
 	DefaultSlashWindow = utils.BlocksPerDay * 2 // 2 days for oracle slashing
+
+	DefaultRewardDistributionWindow = utils.BlocksPerWeek // 1 week for oracle reward distribution
+	DefaultCommitRevealEnabled      = false               // single phase voting
 )
 
 // Default parameter values
@@ -39,9 +46,10 @@ var (
 		// 		{Name: utils.MicroSeiDenom},
 		{Name: utils.MicroEthDenom},
 	}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
-	DefaultLookbackDuration  = uint64(3600)             // in seconds
+	DefaultSlashFraction      = sdk.NewDecWithPrec(0, 4) // 0.00%
+	DefaultMinValidPerWindow  = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultLookbackDuration   = uint64(3600)             // in seconds
+	DefaultRewardPoolFraction = sdk.ZeroDec()            // reward distribution disabled
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -57,6 +65,10 @@ func DefaultParams() Params {
 		SlashWindow:       DefaultSlashWindow,
 		MinValidPerWindow: DefaultMinValidPerWindow,
 		LookbackDuration:  DefaultLookbackDuration,
+
+		RewardDistributionWindow: DefaultRewardDistributionWindow,
+		RewardPoolFraction:       DefaultRewardPoolFraction,
+		CommitRevealEnabled:      DefaultCommitRevealEnabled,
 	}
 }
 
@@ -77,6 +89,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyRewardDistributionWindow, &p.RewardDistributionWindow, validateRewardDistributionWindow),
+		paramstypes.NewParamSetPair(KeyRewardPoolFraction, &p.RewardPoolFraction, validateRewardPoolFraction),
+		paramstypes.NewParamSetPair(KeyCommitRevealEnabled, &p.CommitRevealEnabled, validateCommitRevealEnabled),
 	}
 }
 
@@ -115,12 +130,15 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
-	for _, denom := range p.Whitelist {
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
-		}
+	if p.RewardDistributionWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter RewardDistributionWindow must be greater than or equal with VotePeriod")
 	}
-	return nil
+
+	if p.RewardPoolFraction.IsNil() || p.RewardPoolFraction.GT(sdk.OneDec()) || p.RewardPoolFraction.IsNegative() {
+		return fmt.Errorf("oracle parameter RewardPoolFraction must be between [0, 1]")
+	}
+
+	return validateWhitelist(p.Whitelist)
 }
 
 func validateVotePeriod(i interface{}) error {
@@ -180,6 +198,18 @@ func validateWhitelist(i interface{}) error {
 		if len(d.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if d.VoteThreshold != nil && (d.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) || d.VoteThreshold.GT(sdk.OneDec())) {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s VoteThreshold must be greater than 33 percent and at most 1", d.Name)
+		}
+		if d.RewardBand != nil && (d.RewardBand.GT(sdk.OneDec()) || d.RewardBand.IsNegative()) {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s RewardBand must be between [0, 1]", d.Name)
+		}
+		if d.MaxRateChange != nil && !d.MaxRateChange.IsPositive() {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s MaxRateChange must be positive", d.Name)
+		}
+		if d.MaxTwapDeviation != nil && !d.MaxTwapDeviation.IsPositive() {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s MaxTwapDeviation must be positive", d.Name)
+		}
 	}
 
 	return nil
@@ -240,3 +270,46 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDistributionWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward distribution window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardPoolFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reward pool fraction must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward pool fraction must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward pool fraction is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateCommitRevealEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -61,7 +61,49 @@ func TestParamsValid(t *testing.T) {
 	err = p8.Validate()
 	require.Error(t, err)
 
+	// whitelist denom policy out of range
+	p12 := DefaultParams()
+	voteThreshold := sdk.NewDecWithPrec(3, 1)
+	p12.Whitelist = DenomList{{Name: "uatom", VoteThreshold: &voteThreshold}}
+	err = p12.Validate()
+	require.Error(t, err)
+	voteThreshold = sdk.NewDecWithPrec(5, 1)
+	rewardBand := sdk.NewDec(-1)
+	p12.Whitelist[0].RewardBand = &rewardBand
+	err = p12.Validate()
+	require.Error(t, err)
+	rewardBand = sdk.NewDecWithPrec(5, 2)
+	err = p12.Validate()
+	require.NoError(t, err)
+	maxRateChange := sdk.ZeroDec()
+	p12.Whitelist[0].MaxRateChange = &maxRateChange
+	err = p12.Validate()
+	require.Error(t, err)
+	maxRateChange = sdk.NewDecWithPrec(1, 1)
+	maxTwapDeviation := sdk.NewDec(-1)
+	p12.Whitelist[0].MaxTwapDeviation = &maxTwapDeviation
+	err = p12.Validate()
+	require.Error(t, err)
+	maxTwapDeviation = sdk.NewDecWithPrec(2, 1)
+	err = p12.Validate()
+	require.NoError(t, err)
+
 	p9 := DefaultParams()
 	require.NotNil(t, p9.ParamSetPairs())
 	require.NotNil(t, p9.String())
+
+	// reward distribution window shorter than vote period
+	p10 := DefaultParams()
+	p10.RewardDistributionWindow = p10.VotePeriod - 1
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// reward pool fraction out of range
+	p11 := DefaultParams()
+	p11.RewardPoolFraction = sdk.NewDecWithPrec(11, 1)
+	err = p11.Validate()
+	require.Error(t, err)
+	p11.RewardPoolFraction = sdk.NewDec(-1)
+	err = p11.Validate()
+	require.Error(t, err)
 }
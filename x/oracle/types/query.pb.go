@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -590,6 +592,104 @@ func (m *QueryTwapsResponse) GetOracleTwaps() OracleTwaps {
 	return nil
 }
 
+// request type for price stats RPC method
+type QueryPriceStatsRequest struct {
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+	// seconds after which the weight of a snapshot price in the ema halves
+	HalfLifeSeconds uint64 `protobuf:"varint,2,opt,name=half_life_seconds,json=halfLifeSeconds,proto3" json:"half_life_seconds,omitempty"`
+}
+
+func (m *QueryPriceStatsRequest) Reset()         { *m = QueryPriceStatsRequest{} }
+func (m *QueryPriceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatsRequest) ProtoMessage()    {}
+func (*QueryPriceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{13}
+}
+func (m *QueryPriceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatsRequest.Merge(m, src)
+}
+func (m *QueryPriceStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPriceStatsRequest) GetLookbackSeconds() uint64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
+func (m *QueryPriceStatsRequest) GetHalfLifeSeconds() uint64 {
+	if m != nil {
+		return m.HalfLifeSeconds
+	}
+	return 0
+}
+
+type QueryPriceStatsResponse struct {
+	PriceStats OraclePriceStatsList `protobuf:"bytes,1,rep,name=price_stats,json=priceStats,proto3,castrepeated=OraclePriceStatsList" json:"price_stats"`
+}
+
+func (m *QueryPriceStatsResponse) Reset()         { *m = QueryPriceStatsResponse{} }
+func (m *QueryPriceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatsResponse) ProtoMessage()    {}
+func (*QueryPriceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{14}
+}
+func (m *QueryPriceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatsResponse.Merge(m, src)
+}
+func (m *QueryPriceStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceStatsResponse) GetPriceStats() OraclePriceStatsList {
+	if m != nil {
+		return m.PriceStats
+	}
+	return nil
+}
+
 // QueryFeederDelegationRequest is the request type for the Query/FeederDelegation RPC method.
 type QueryFeederDelegationRequest struct {
 	// validator defines the validator address to query for.
@@ -600,7 +700,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{13}
+	return fileDescriptor_562b782cb9ac197e, []int{15}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +740,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{14}
+	return fileDescriptor_562b782cb9ac197e, []int{16}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +786,7 @@ func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenalty
 func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
 func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{15}
+	return fileDescriptor_562b782cb9ac197e, []int{17}
 }
 func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +825,7 @@ func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenalt
 func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
 func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{16}
+	return fileDescriptor_562b782cb9ac197e, []int{18}
 }
 func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +870,7 @@ func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest
 func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowRequest) ProtoMessage()    {}
 func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{17}
+	return fileDescriptor_562b782cb9ac197e, []int{19}
 }
 func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +911,7 @@ func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowRespon
 func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowResponse) ProtoMessage()    {}
 func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{18}
+	return fileDescriptor_562b782cb9ac197e, []int{20}
 }
 func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,6 +947,285 @@ func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
 	return 0
 }
 
+// QueryProjectedRewardsRequest is the request type for the
+// Query/ProjectedRewards RPC method.
+type QueryProjectedRewardsRequest struct {
+	// validator_addr optionally defines the validator to project rewards for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryProjectedRewardsRequest) Reset()         { *m = QueryProjectedRewardsRequest{} }
+func (m *QueryProjectedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardsRequest) ProtoMessage()    {}
+func (*QueryProjectedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{21}
+}
+func (m *QueryProjectedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardsRequest.Merge(m, src)
+}
+func (m *QueryProjectedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRewardsRequest proto.InternalMessageInfo
+
+// QueryProjectedRewardsResponse is response type for the
+// Query/ProjectedRewards RPC method.
+type QueryProjectedRewardsResponse struct {
+	// reward_pool defines the current balance of the oracle module account.
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	// period_rewards defines the rewards split among ballot winners at the end of the vote period.
+	PeriodRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=period_rewards,json=periodRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"period_rewards"`
+	// validator_rewards defines the share of period_rewards the requested validator
+	// receives if every bonded validator votes within the reward band.
+	ValidatorRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=validator_rewards,json=validatorRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"validator_rewards"`
+}
+
+func (m *QueryProjectedRewardsResponse) Reset()         { *m = QueryProjectedRewardsResponse{} }
+func (m *QueryProjectedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardsResponse) ProtoMessage()    {}
+func (*QueryProjectedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{22}
+}
+func (m *QueryProjectedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardsResponse.Merge(m, src)
+}
+func (m *QueryProjectedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedRewardsResponse) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *QueryProjectedRewardsResponse) GetPeriodRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PeriodRewards
+	}
+	return nil
+}
+
+func (m *QueryProjectedRewardsResponse) GetValidatorRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+// QueryCircuitBreakerStatusRequest is the request type for the
+// Query/CircuitBreakerStatus RPC method.
+type QueryCircuitBreakerStatusRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryCircuitBreakerStatusRequest) Reset()         { *m = QueryCircuitBreakerStatusRequest{} }
+func (m *QueryCircuitBreakerStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerStatusRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{23}
+}
+func (m *QueryCircuitBreakerStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerStatusRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerStatusRequest proto.InternalMessageInfo
+
+// QueryCircuitBreakerStatusResponse is response type for the
+// Query/CircuitBreakerStatus RPC method.
+type QueryCircuitBreakerStatusResponse struct {
+	// circuit_breaker_status defines the status of the denom, neither halted nor held
+	// when its latest tally was within its limits.
+	CircuitBreakerStatus CircuitBreakerStatus `protobuf:"bytes,1,opt,name=circuit_breaker_status,json=circuitBreakerStatus,proto3" json:"circuit_breaker_status"`
+}
+
+func (m *QueryCircuitBreakerStatusResponse) Reset()         { *m = QueryCircuitBreakerStatusResponse{} }
+func (m *QueryCircuitBreakerStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerStatusResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{24}
+}
+func (m *QueryCircuitBreakerStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerStatusResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerStatusResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerStatusResponse) GetCircuitBreakerStatus() CircuitBreakerStatus {
+	if m != nil {
+		return m.CircuitBreakerStatus
+	}
+	return CircuitBreakerStatus{}
+}
+
+// QueryCircuitBreakerStatusesRequest is the request type for the
+// Query/CircuitBreakerStatuses RPC method.
+type QueryCircuitBreakerStatusesRequest struct {
+}
+
+func (m *QueryCircuitBreakerStatusesRequest) Reset()         { *m = QueryCircuitBreakerStatusesRequest{} }
+func (m *QueryCircuitBreakerStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerStatusesRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{25}
+}
+func (m *QueryCircuitBreakerStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerStatusesRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerStatusesRequest proto.InternalMessageInfo
+
+// QueryCircuitBreakerStatusesResponse is response type for the
+// Query/CircuitBreakerStatuses RPC method.
+type QueryCircuitBreakerStatusesResponse struct {
+	// circuit_breaker_statuses defines the statuses of the held or halted denoms.
+	CircuitBreakerStatuses []CircuitBreakerStatus `protobuf:"bytes,1,rep,name=circuit_breaker_statuses,json=circuitBreakerStatuses,proto3" json:"circuit_breaker_statuses"`
+}
+
+func (m *QueryCircuitBreakerStatusesResponse) Reset()         { *m = QueryCircuitBreakerStatusesResponse{} }
+func (m *QueryCircuitBreakerStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerStatusesResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{26}
+}
+func (m *QueryCircuitBreakerStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerStatusesResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerStatusesResponse) GetCircuitBreakerStatuses() []CircuitBreakerStatus {
+	if m != nil {
+		return m.CircuitBreakerStatuses
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -855,7 +1234,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{19}
+	return fileDescriptor_562b782cb9ac197e, []int{27}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1273,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{20}
+	return fileDescriptor_562b782cb9ac197e, []int{28}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,12 +1323,20 @@ func init() {
 	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryResponse")
 	proto.RegisterType((*QueryTwapsRequest)(nil), "seiprotocol.seichain.oracle.QueryTwapsRequest")
 	proto.RegisterType((*QueryTwapsResponse)(nil), "seiprotocol.seichain.oracle.QueryTwapsResponse")
+	proto.RegisterType((*QueryPriceStatsRequest)(nil), "seiprotocol.seichain.oracle.QueryPriceStatsRequest")
+	proto.RegisterType((*QueryPriceStatsResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceStatsResponse")
 	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationRequest")
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterRequest")
 	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterResponse")
 	proto.RegisterType((*QuerySlashWindowRequest)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowRequest")
 	proto.RegisterType((*QuerySlashWindowResponse)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryProjectedRewardsRequest)(nil), "seiprotocol.seichain.oracle.QueryProjectedRewardsRequest")
+	proto.RegisterType((*QueryProjectedRewardsResponse)(nil), "seiprotocol.seichain.oracle.QueryProjectedRewardsResponse")
+	proto.RegisterType((*QueryCircuitBreakerStatusRequest)(nil), "seiprotocol.seichain.oracle.QueryCircuitBreakerStatusRequest")
+	proto.RegisterType((*QueryCircuitBreakerStatusResponse)(nil), "seiprotocol.seichain.oracle.QueryCircuitBreakerStatusResponse")
+	proto.RegisterType((*QueryCircuitBreakerStatusesRequest)(nil), "seiprotocol.seichain.oracle.QueryCircuitBreakerStatusesRequest")
+	proto.RegisterType((*QueryCircuitBreakerStatusesResponse)(nil), "seiprotocol.seichain.oracle.QueryCircuitBreakerStatusesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.oracle.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.oracle.QueryParamsResponse")
 }
@@ -957,76 +1344,103 @@ func init() {
 func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
 
 var fileDescriptor_562b782cb9ac197e = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x94, 0x26, 0xa5, 0xcf, 0x69, 0x52, 0x26, 0x06, 0xd2, 0x4d, 0xb0, 0xd3, 0x85, 0x2a,
-	0x05, 0x14, 0x6f, 0x48, 0x93, 0x02, 0x69, 0x12, 0x35, 0x3f, 0xa8, 0x80, 0x4b, 0x12, 0xa7, 0xa2,
-	0x88, 0xcb, 0x6a, 0x62, 0x0f, 0xeb, 0x55, 0x9c, 0x9d, 0xed, 0xce, 0x26, 0x69, 0x14, 0xe5, 0x82,
-	0x7a, 0xe0, 0x18, 0x89, 0x1b, 0xe2, 0xd0, 0x0b, 0x1c, 0xb8, 0xc0, 0x89, 0x23, 0x07, 0x24, 0xa4,
-	0x1e, 0x2b, 0xc1, 0x01, 0x09, 0x09, 0x50, 0xc2, 0xa1, 0x7f, 0x06, 0xf2, 0xec, 0x5b, 0x67, 0xb7,
-	0x59, 0xaf, 0xd7, 0xa1, 0xa7, 0xf5, 0xbc, 0x37, 0xef, 0x9b, 0xef, 0x1b, 0xbf, 0x79, 0x1f, 0x50,
-	0xe1, 0xb1, 0x6a, 0x83, 0x1b, 0xf7, 0xb7, 0xb9, 0xb7, 0x57, 0x76, 0x3d, 0xe1, 0x0b, 0x3a, 0x2c,
-	0xb9, 0xad, 0x7e, 0x55, 0x45, 0xa3, 0x2c, 0xb9, 0x5d, 0xad, 0x33, 0xdb, 0x29, 0x07, 0x1b, 0xb5,
-	0x82, 0x25, 0x2c, 0xa1, 0xb2, 0x46, 0xf3, 0x57, 0x50, 0xa2, 0x8d, 0x58, 0x42, 0x58, 0x0d, 0x6e,
-	0x30, 0xd7, 0x36, 0x98, 0xe3, 0x08, 0x9f, 0xf9, 0xb6, 0x70, 0x24, 0x66, 0x07, 0xf1, 0x90, 0xe0,
-	0x13, 0x04, 0xf5, 0x19, 0x18, 0x5a, 0x6b, 0x1e, 0xfa, 0xc1, 0x83, 0x6a, 0x9d, 0x39, 0x16, 0xaf,
-	0x30, 0x9f, 0x57, 0xf8, 0xfd, 0x6d, 0x2e, 0x7d, 0x5a, 0x80, 0x9e, 0x1a, 0x77, 0xc4, 0xd6, 0x10,
-	0x19, 0x25, 0xd7, 0x2f, 0x56, 0x82, 0xc5, 0xcc, 0x8b, 0x5f, 0x3e, 0x2a, 0xe5, 0x9e, 0x3e, 0x2a,
-	0xe5, 0xf4, 0x87, 0x04, 0xae, 0x24, 0x14, 0x4b, 0x57, 0x38, 0x92, 0x53, 0x0b, 0x0a, 0xc1, 0x49,
-	0x26, 0xc7, 0xb4, 0xe9, 0x31, 0x9f, 0x2b, 0xb0, 0xfc, 0xa4, 0x51, 0x4e, 0x91, 0x57, 0x5e, 0x51,
-	0x9f, 0x28, 0xec, 0xe2, 0xf9, 0xc7, 0x7f, 0x95, 0x72, 0x15, 0xbc, 0xa8, 0x68, 0x46, 0x1f, 0x4e,
-	0x60, 0x21, 0x51, 0x83, 0xfe, 0x0d, 0x81, 0xe1, 0xe5, 0x26, 0xef, 0xd3, 0x90, 0xab, 0xcc, 0xf6,
-	0x92, 0x35, 0xb6, 0xe5, 0x7e, 0xee, 0x79, 0x73, 0xff, 0x95, 0x80, 0x96, 0x44, 0x1e, 0xef, 0xf0,
-	0x3b, 0x02, 0xa3, 0x8a, 0x91, 0x99, 0x44, 0xc7, 0x74, 0x99, 0xed, 0xc9, 0x21, 0x32, 0xfa, 0xc2,
-	0xf5, 0xfc, 0xe4, 0x7b, 0xa9, 0xa4, 0x52, 0xae, 0x60, 0xf1, 0x8d, 0x26, 0xbb, 0xef, 0xff, 0x2e,
-	0x8d, 0xa4, 0x6c, 0x92, 0x95, 0x91, 0x5a, 0x4a, 0x56, 0x7f, 0x19, 0x06, 0x95, 0x8c, 0x85, 0xaa,
-	0x6f, 0xef, 0x9c, 0xdc, 0xfe, 0x04, 0x14, 0xe2, 0x61, 0xd4, 0x35, 0x04, 0x17, 0x58, 0x10, 0x52,
-	0xec, 0x2f, 0x56, 0xc2, 0xa5, 0x7e, 0x05, 0x5e, 0x55, 0x15, 0x9f, 0x08, 0x9f, 0xdf, 0x65, 0x9e,
-	0xc5, 0xfd, 0x16, 0xd8, 0x1c, 0xb6, 0x6a, 0x2c, 0x85, 0x80, 0x57, 0xa1, 0x6f, 0x47, 0xf8, 0xdc,
-	0xf4, 0x83, 0x38, 0xa2, 0xe6, 0x77, 0x4e, 0xb6, 0xea, 0x3a, 0x8c, 0xaa, 0xf2, 0x55, 0xcf, 0xae,
-	0xf2, 0x75, 0x87, 0xb9, 0xb2, 0x2e, 0xfc, 0x0f, 0x6d, 0xe9, 0x0b, 0x6f, 0x2f, 0x3c, 0xe2, 0x90,
-	0xc0, 0xd5, 0x94, 0x4d, 0x78, 0xd8, 0x26, 0x0c, 0xb8, 0xcd, 0xbc, 0x29, 0x71, 0x43, 0xf8, 0x1f,
-	0xbc, 0x95, 0xfa, 0x1f, 0xc4, 0x30, 0x17, 0x5f, 0xc1, 0x5b, 0xef, 0x8f, 0x85, 0x65, 0xa5, 0xdf,
-	0x8d, 0xad, 0xf5, 0x79, 0x78, 0x49, 0x31, 0xba, 0xbb, 0xcb, 0xdc, 0xf0, 0x2a, 0xe8, 0x9b, 0x70,
-	0xb9, 0x21, 0xc4, 0xe6, 0x06, 0xab, 0x6e, 0x9a, 0x92, 0x57, 0x85, 0x53, 0x93, 0xaa, 0x81, 0xcf,
-	0x57, 0x06, 0xc2, 0xf8, 0x7a, 0x10, 0xd6, 0xb7, 0x81, 0x46, 0xeb, 0x51, 0x82, 0x09, 0x7d, 0xd8,
-	0x51, 0x7e, 0x33, 0x8e, 0xfc, 0xc7, 0x32, 0x34, 0x76, 0x13, 0x67, 0x71, 0x10, 0xc9, 0xe7, 0x4f,
-	0x62, 0xb2, 0x92, 0x17, 0x27, 0x0b, 0x7d, 0x05, 0x46, 0xd4, 0xb1, 0x77, 0x38, 0xaf, 0x71, 0x6f,
-	0x99, 0x37, 0xb8, 0xa5, 0x86, 0x51, 0xa8, 0xe0, 0x1a, 0xf4, 0xef, 0xb0, 0x86, 0x5d, 0x63, 0xbe,
-	0xf0, 0x4c, 0x56, 0xab, 0x79, 0xf8, 0x00, 0x2f, 0xb5, 0xa2, 0x0b, 0xb5, 0x9a, 0x17, 0x19, 0x36,
-	0xb7, 0xe1, 0xb5, 0x36, 0x80, 0x28, 0xa9, 0x04, 0xf9, 0xcf, 0x55, 0x2e, 0x0a, 0x07, 0x41, 0xa8,
-	0x89, 0xa5, 0xaf, 0x41, 0xb1, 0xd5, 0x3f, 0xab, 0xdc, 0x61, 0x0d, 0x7f, 0x6f, 0x49, 0x6c, 0x3b,
-	0x3e, 0xf7, 0xce, 0x4c, 0xea, 0x21, 0x81, 0x52, 0x5b, 0x4c, 0xe4, 0xc5, 0xa0, 0xa0, 0x5a, 0xd3,
-	0x0d, 0xd2, 0x66, 0x35, 0xc8, 0x67, 0x9a, 0x83, 0x09, 0xb0, 0x74, 0xe7, 0x54, 0xac, 0xf5, 0x68,
-	0xd6, 0x1b, 0x4c, 0xd6, 0xef, 0xd9, 0x4e, 0x4d, 0xec, 0x86, 0x1d, 0xbd, 0x84, 0x8f, 0x26, 0x96,
-	0x42, 0x66, 0x63, 0x30, 0xb0, 0xab, 0x22, 0xa6, 0xeb, 0x09, 0xcb, 0xe3, 0x32, 0x6c, 0xa2, 0xfe,
-	0x20, 0xbc, 0x8a, 0x51, 0xbd, 0x80, 0x3d, 0xb4, 0xca, 0x3c, 0xb6, 0xd5, 0x7a, 0x8f, 0x9f, 0xe2,
-	0x9b, 0x0f, 0xa3, 0x88, 0xba, 0x00, 0xbd, 0xae, 0x8a, 0xa0, 0xc2, 0xd7, 0xd3, 0x1f, 0x85, 0xda,
-	0x8a, 0x13, 0x12, 0x0b, 0x27, 0x0f, 0x07, 0xa0, 0x47, 0x41, 0xd3, 0x5f, 0x08, 0xf4, 0x45, 0xa7,
-	0x0d, 0x9d, 0x4e, 0x45, 0x6b, 0x67, 0x65, 0xda, 0xcd, 0x6e, 0xcb, 0x02, 0x31, 0xfa, 0xd2, 0x17,
-	0xbf, 0xfd, 0xfb, 0xd5, 0xb9, 0x39, 0x7a, 0xcb, 0x90, 0xdc, 0x1e, 0x0f, 0x01, 0xd4, 0x42, 0x21,
-	0xa0, 0x99, 0x1a, 0x6a, 0x38, 0x4a, 0x63, 0x5f, 0x7d, 0x0f, 0x8c, 0xd8, 0x98, 0xa6, 0x3f, 0x13,
-	0xb8, 0x14, 0x9b, 0xef, 0xb4, 0x4b, 0x3a, 0xe1, 0x95, 0x6b, 0xef, 0x76, 0x5d, 0x87, 0x3a, 0x66,
-	0x95, 0x8e, 0x9b, 0x74, 0x2a, 0x9b, 0x8e, 0x18, 0x7f, 0x49, 0xbf, 0x25, 0x70, 0x01, 0x47, 0x38,
-	0x9d, 0xe8, 0x4c, 0x21, 0x6e, 0x02, 0xda, 0x3b, 0x5d, 0x54, 0x20, 0xdd, 0x69, 0x45, 0xd7, 0xa0,
-	0xe3, 0xd9, 0xe8, 0xa2, 0x79, 0xd0, 0x9f, 0x08, 0xe4, 0x23, 0xee, 0x40, 0xa7, 0x3a, 0x9f, 0x7c,
-	0xda, 0x67, 0xb4, 0xe9, 0x2e, 0xab, 0x90, 0xf3, 0x8c, 0xe2, 0x3c, 0x45, 0x27, 0xb3, 0x71, 0x8e,
-	0xda, 0x15, 0xfd, 0x93, 0x40, 0x21, 0xc9, 0x72, 0xe8, 0x5c, 0x67, 0x2e, 0x29, 0x7e, 0xa6, 0xcd,
-	0x9f, 0xb5, 0x1c, 0x35, 0x2d, 0x2b, 0x4d, 0xf3, 0x74, 0x36, 0x9b, 0xa6, 0xb8, 0x2b, 0x9a, 0x75,
-	0x14, 0xf1, 0x23, 0x81, 0x1e, 0xe5, 0x0a, 0xb4, 0xdc, 0x99, 0x4f, 0xd4, 0xe7, 0x34, 0x23, 0xf3,
-	0x7e, 0x24, 0x7c, 0x47, 0x11, 0xbe, 0x4d, 0xe7, 0xb3, 0x11, 0x56, 0xe6, 0x67, 0xec, 0x3f, 0xeb,
-	0xa5, 0x07, 0xf4, 0x77, 0x02, 0x97, 0x9f, 0x75, 0x1a, 0xfa, 0x7e, 0x67, 0x36, 0x6d, 0xec, 0x4e,
-	0x9b, 0x39, 0x4b, 0x29, 0x6a, 0xfa, 0x48, 0x69, 0x5a, 0xa2, 0x0b, 0x1d, 0x34, 0xb5, 0x4c, 0x4a,
-	0x1a, 0xfb, 0x71, 0x1b, 0x3b, 0x30, 0x02, 0x1b, 0xa4, 0x4f, 0x09, 0xd0, 0xd3, 0x9e, 0x42, 0x6f,
-	0x65, 0xeb, 0xf8, 0x44, 0xd3, 0xd4, 0x66, 0xcf, 0x56, 0x8c, 0xe2, 0xee, 0x29, 0x71, 0x6b, 0x74,
-	0xe5, 0x7f, 0x88, 0x4b, 0xb2, 0x57, 0xfa, 0x03, 0x81, 0x7c, 0xc4, 0xf4, 0xb2, 0xcc, 0x82, 0xd3,
-	0xf6, 0x99, 0x65, 0x16, 0x24, 0x38, 0xab, 0x7e, 0x43, 0xa9, 0x1a, 0xa7, 0x6f, 0x77, 0x50, 0x25,
-	0x9b, 0xb5, 0x66, 0xe0, 0xb6, 0xf4, 0x6b, 0x02, 0xbd, 0x81, 0x1d, 0xd2, 0x0c, 0x7d, 0x1f, 0xf3,
-	0x62, 0x6d, 0x22, 0x7b, 0x01, 0x52, 0x1c, 0x57, 0x14, 0xc7, 0xe8, 0xb5, 0x0e, 0x14, 0x03, 0x4b,
-	0x5e, 0xfc, 0xf8, 0xf1, 0x51, 0x91, 0x3c, 0x39, 0x2a, 0x92, 0x7f, 0x8e, 0x8a, 0xe4, 0xf0, 0xb8,
-	0x98, 0x7b, 0x72, 0x5c, 0xcc, 0xfd, 0x71, 0x5c, 0xcc, 0x7d, 0x36, 0x61, 0xd9, 0x7e, 0x7d, 0x7b,
-	0xa3, 0x5c, 0x15, 0x5b, 0xed, 0xa0, 0x1e, 0x84, 0x60, 0xfe, 0x9e, 0xcb, 0xe5, 0x46, 0xaf, 0xda,
-	0x72, 0xe3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x07, 0x8e, 0x14, 0xf6, 0x0e, 0x00, 0x00,
+	// 1531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xd4, 0x56,
+	0x17, 0x8e, 0xf9, 0x7c, 0x39, 0x03, 0x21, 0xdc, 0x0c, 0x21, 0x98, 0x30, 0x09, 0x06, 0x04, 0x2f,
+	0x28, 0xe3, 0x7c, 0xf2, 0xf2, 0x06, 0x48, 0xc9, 0x24, 0x45, 0x6d, 0x85, 0x44, 0x18, 0x50, 0x41,
+	0x6c, 0xdc, 0x1b, 0xfb, 0x66, 0xc6, 0xcd, 0xc4, 0xd7, 0xf8, 0x7a, 0x12, 0xa2, 0x28, 0x5d, 0x54,
+	0x48, 0x74, 0x89, 0xda, 0x4d, 0x55, 0x75, 0xc1, 0xa6, 0x5d, 0x74, 0xd3, 0xae, 0xba, 0xec, 0xa2,
+	0x55, 0x25, 0x96, 0x48, 0xed, 0xa2, 0x55, 0xa5, 0xb6, 0x22, 0x5d, 0xf0, 0x2b, 0xaa, 0xca, 0xd7,
+	0xc7, 0xf3, 0x91, 0xf1, 0xcc, 0x78, 0xa6, 0xed, 0xca, 0xe3, 0x73, 0xee, 0x79, 0xce, 0xf3, 0x5c,
+	0xdf, 0x8f, 0x67, 0x80, 0x70, 0x8f, 0x9a, 0x25, 0xa6, 0x3f, 0x2c, 0x33, 0x6f, 0x23, 0xeb, 0x7a,
+	0xdc, 0xe7, 0xe4, 0x84, 0x60, 0xb6, 0xfc, 0x65, 0xf2, 0x52, 0x56, 0x30, 0xdb, 0x2c, 0x52, 0xdb,
+	0xc9, 0x86, 0x03, 0xd5, 0x74, 0x81, 0x17, 0xb8, 0xcc, 0xea, 0xc1, 0xaf, 0xb0, 0x44, 0x1d, 0x2a,
+	0x70, 0x5e, 0x28, 0x31, 0x9d, 0xba, 0xb6, 0x4e, 0x1d, 0x87, 0xfb, 0xd4, 0xb7, 0xb9, 0x23, 0x30,
+	0xdb, 0x8f, 0x4d, 0xc2, 0x07, 0x06, 0x33, 0x26, 0x17, 0xab, 0x5c, 0xe8, 0x4b, 0x54, 0x30, 0x7d,
+	0x6d, 0x7c, 0x89, 0xf9, 0x74, 0x5c, 0x37, 0xb9, 0xed, 0x84, 0x79, 0x6d, 0x06, 0x06, 0x6f, 0x07,
+	0xa4, 0x5e, 0x7f, 0x64, 0x16, 0xa9, 0x53, 0x60, 0x79, 0xea, 0xb3, 0x3c, 0x7b, 0x58, 0x66, 0xc2,
+	0x27, 0x69, 0xd8, 0x6b, 0x31, 0x87, 0xaf, 0x0e, 0x2a, 0x23, 0xca, 0xf9, 0x03, 0xf9, 0xf0, 0x65,
+	0xe6, 0x3f, 0x1f, 0x3c, 0x1b, 0xee, 0x79, 0xf5, 0x6c, 0xb8, 0x47, 0x7b, 0xac, 0xc0, 0xf1, 0x98,
+	0x62, 0xe1, 0x72, 0x47, 0x30, 0x52, 0x80, 0x74, 0xc8, 0xc4, 0x60, 0x98, 0x36, 0x3c, 0xea, 0x33,
+	0x09, 0x96, 0x9a, 0xd0, 0xb3, 0x2d, 0xe4, 0x67, 0x6f, 0xc9, 0x47, 0x2d, 0x6c, 0x6e, 0xcf, 0xf3,
+	0x5f, 0x87, 0x7b, 0xf2, 0x84, 0x37, 0x64, 0xb4, 0x13, 0x31, 0x2c, 0x04, 0x6a, 0xd0, 0x3e, 0x55,
+	0xe0, 0xc4, 0x42, 0xc0, 0xbb, 0x11, 0x72, 0x91, 0xda, 0x5e, 0xbc, 0xc6, 0xa6, 0xdc, 0x77, 0xfd,
+	0xd3, 0xdc, 0xbf, 0x57, 0x40, 0x8d, 0x23, 0x8f, 0x73, 0xf8, 0xb9, 0x02, 0x23, 0x92, 0x91, 0x11,
+	0x47, 0xc7, 0x70, 0xa9, 0xed, 0x89, 0x41, 0x65, 0x64, 0xf7, 0xf9, 0xd4, 0xc4, 0xe5, 0x96, 0xa4,
+	0x5a, 0x4c, 0x41, 0xee, 0x4c, 0xc0, 0xee, 0x8b, 0xdf, 0x86, 0x87, 0x5a, 0x0c, 0x12, 0xf9, 0x21,
+	0xab, 0x45, 0x56, 0x3b, 0x0a, 0xfd, 0x52, 0xc6, 0x9c, 0xe9, 0xdb, 0x6b, 0xd5, 0xd9, 0x1f, 0x83,
+	0x74, 0x7d, 0x18, 0x75, 0x0d, 0xc2, 0x7e, 0x1a, 0x86, 0x24, 0xfb, 0x03, 0xf9, 0xe8, 0x55, 0x3b,
+	0x0e, 0xc7, 0x64, 0xc5, 0xdb, 0xdc, 0x67, 0x77, 0xa9, 0x57, 0x60, 0x7e, 0x05, 0xec, 0x1a, 0x0c,
+	0x36, 0xa6, 0x10, 0xf0, 0x14, 0x1c, 0x5c, 0xe3, 0x3e, 0x33, 0xfc, 0x30, 0x8e, 0xa8, 0xa9, 0xb5,
+	0xea, 0x50, 0x4d, 0x83, 0x11, 0x59, 0xbe, 0xe8, 0xd9, 0x26, 0xbb, 0xe3, 0x50, 0x57, 0x14, 0xb9,
+	0xff, 0x86, 0x2d, 0x7c, 0xee, 0x6d, 0x44, 0x2d, 0x9e, 0x2a, 0x70, 0xaa, 0xc5, 0x20, 0x6c, 0xb6,
+	0x02, 0x87, 0xdd, 0x20, 0x6f, 0x08, 0x1c, 0x10, 0x7d, 0x83, 0x0b, 0x2d, 0xbf, 0x41, 0x1d, 0x66,
+	0x6e, 0x00, 0x67, 0xbd, 0xb7, 0x2e, 0x2c, 0xf2, 0xbd, 0x6e, 0xdd, 0xbb, 0x36, 0x0b, 0x47, 0x24,
+	0xa3, 0xbb, 0xeb, 0xd4, 0x8d, 0xa6, 0x82, 0xfc, 0x17, 0xfa, 0x4a, 0x9c, 0xaf, 0x2c, 0x51, 0x73,
+	0xc5, 0x10, 0xcc, 0xe4, 0x8e, 0x25, 0xe4, 0x02, 0xde, 0x93, 0x3f, 0x1c, 0xc5, 0xef, 0x84, 0x61,
+	0xad, 0x0c, 0xa4, 0xb6, 0x1e, 0x25, 0x18, 0x70, 0x10, 0x57, 0x94, 0x1f, 0xc4, 0x91, 0xff, 0xb9,
+	0x04, 0x0b, 0x3b, 0xc0, 0xc9, 0xf5, 0x23, 0xf9, 0x54, 0x35, 0x26, 0xf2, 0x29, 0x5e, 0x7d, 0xd1,
+	0x38, 0x0c, 0xd4, 0x4c, 0xa4, 0x4f, 0xfd, 0x2e, 0xb8, 0x93, 0x0b, 0x70, 0xa4, 0x48, 0x4b, 0xcb,
+	0x46, 0xc9, 0x5e, 0x66, 0x95, 0xb1, 0xbb, 0xc2, 0xb1, 0x41, 0xe2, 0xa6, 0xbd, 0xcc, 0x22, 0x9d,
+	0x4f, 0x14, 0x38, 0xd6, 0xd0, 0x11, 0xd5, 0x96, 0x20, 0x85, 0x1f, 0x2c, 0x08, 0xa3, 0xd8, 0xd1,
+	0x04, 0x62, 0xab, 0x58, 0xb9, 0x21, 0x94, 0x9c, 0xde, 0x99, 0xb9, 0x69, 0x0b, 0x3f, 0x0f, 0x6e,
+	0xe5, 0x5d, 0xbb, 0x05, 0x43, 0x92, 0xc8, 0x0d, 0xc6, 0x2c, 0xe6, 0x2d, 0xb0, 0x12, 0x2b, 0xc8,
+	0x73, 0x3a, 0x9a, 0x80, 0xb3, 0xd0, 0xbb, 0x46, 0x4b, 0xb6, 0x45, 0x7d, 0xee, 0x19, 0xd4, 0xb2,
+	0x3c, 0x3c, 0x7b, 0x0e, 0x55, 0xa2, 0x73, 0x96, 0xe5, 0xd5, 0x9c, 0xb3, 0xd7, 0xe1, 0x64, 0x13,
+	0x40, 0xd4, 0x37, 0x0c, 0xa9, 0x65, 0x99, 0xab, 0x85, 0x83, 0x30, 0x14, 0x60, 0x69, 0xb7, 0x21,
+	0x53, 0xd9, 0x3a, 0x8b, 0xcc, 0xa1, 0x25, 0x7f, 0x63, 0x9e, 0x97, 0x1d, 0x9f, 0x79, 0x5d, 0x93,
+	0x7a, 0xac, 0xc0, 0x70, 0x53, 0x4c, 0xe4, 0x45, 0x21, 0x2d, 0x77, 0xa5, 0x1b, 0xa6, 0x0d, 0x33,
+	0xcc, 0x27, 0xba, 0x02, 0x62, 0x60, 0xc9, 0x5a, 0x43, 0xac, 0x72, 0x5e, 0xdc, 0x29, 0x51, 0x51,
+	0xbc, 0x67, 0x3b, 0x16, 0x5f, 0x8f, 0x36, 0xf3, 0x3c, 0x0c, 0x36, 0xa6, 0x90, 0xd9, 0x39, 0x38,
+	0xbc, 0x2e, 0x23, 0x86, 0xeb, 0xf1, 0x82, 0xc7, 0x44, 0xb4, 0x06, 0x7b, 0xc3, 0xf0, 0x22, 0x46,
+	0x2b, 0x1f, 0x73, 0xd1, 0xe3, 0xef, 0x32, 0xd3, 0x67, 0x56, 0x9e, 0xad, 0x53, 0xcf, 0x12, 0x5d,
+	0xcf, 0xdb, 0x93, 0xdd, 0x70, 0xb2, 0x09, 0x62, 0x75, 0xb5, 0x7a, 0x32, 0x64, 0xb8, 0x9c, 0x97,
+	0x70, 0xb5, 0x1e, 0xcf, 0x86, 0x17, 0x79, 0x36, 0xb8, 0xc8, 0xb3, 0x78, 0x91, 0x67, 0xe7, 0xb9,
+	0xed, 0xe4, 0xc6, 0x70, 0x65, 0x9e, 0x2f, 0xd8, 0x7e, 0xb1, 0xbc, 0x94, 0x35, 0xf9, 0xaa, 0x8e,
+	0xb7, 0x7e, 0xf8, 0x18, 0x15, 0xd6, 0x8a, 0xee, 0x6f, 0xb8, 0x4c, 0xc8, 0x02, 0x91, 0x87, 0x10,
+	0x7f, 0x91, 0xf3, 0x12, 0x79, 0x04, 0xbd, 0x2e, 0xf3, 0x6c, 0x6e, 0x19, 0x61, 0x30, 0xd8, 0x60,
+	0x41, 0xc3, 0xa1, 0xd8, 0x86, 0x0b, 0xcc, 0x94, 0x3d, 0x27, 0xb1, 0xe7, 0xc5, 0x04, 0x3d, 0xb1,
+	0x46, 0xe4, 0x0f, 0x85, 0x8d, 0x50, 0x2f, 0x79, 0x0f, 0x8e, 0x54, 0xa7, 0x2e, 0x6a, 0xbe, 0xfb,
+	0xdf, 0x6a, 0xde, 0x57, 0xe9, 0x85, 0xfd, 0xb5, 0x1c, 0x5e, 0x08, 0xf3, 0xb6, 0x67, 0x96, 0x6d,
+	0x3f, 0xe7, 0x31, 0xba, 0xc2, 0xbc, 0x60, 0x0f, 0x97, 0x45, 0x52, 0x0b, 0xf4, 0x61, 0x74, 0x61,
+	0xc4, 0x83, 0xe0, 0x17, 0x5d, 0x85, 0x01, 0x33, 0xcc, 0x1b, 0x4b, 0xe1, 0x00, 0x79, 0x12, 0x95,
+	0x05, 0xee, 0x84, 0xf1, 0x96, 0x3b, 0x21, 0x0e, 0x1a, 0x2d, 0x45, 0xda, 0x8c, 0xc9, 0x69, 0x67,
+	0x40, 0x6b, 0xca, 0xa9, 0x7a, 0x37, 0x7f, 0xac, 0xc0, 0xe9, 0x96, 0xc3, 0x90, 0xfc, 0x43, 0x18,
+	0x8c, 0x27, 0xcf, 0xa2, 0x93, 0xb4, 0x6b, 0xfa, 0x03, 0x66, 0x6c, 0x6b, 0x2d, 0x8d, 0x77, 0xd6,
+	0x22, 0xf5, 0xe8, 0x6a, 0x85, 0xf0, 0x7d, 0xe8, 0xaf, 0x8b, 0x22, 0xbf, 0x39, 0xd8, 0xe7, 0xca,
+	0x08, 0x4e, 0xe6, 0xe9, 0xd6, 0x97, 0xb0, 0x1c, 0x8a, 0xfd, 0xb1, 0x70, 0xe2, 0xcf, 0xa3, 0xb0,
+	0x57, 0x42, 0x93, 0x6f, 0x15, 0x38, 0x58, 0xeb, 0x6e, 0xc8, 0x74, 0x4b, 0xb4, 0x66, 0xd6, 0x59,
+	0xbd, 0xd4, 0x69, 0x59, 0x28, 0x46, 0x9b, 0x7f, 0xff, 0x87, 0x3f, 0x3e, 0xda, 0x75, 0x8d, 0x5c,
+	0xd1, 0x05, 0xb3, 0x47, 0x23, 0x00, 0xf9, 0x22, 0x11, 0xd0, 0xdc, 0xeb, 0x72, 0x1d, 0x0a, 0x7d,
+	0x53, 0x3e, 0xb7, 0xf4, 0x3a, 0x5b, 0x48, 0xbe, 0x51, 0xe0, 0x50, 0x2d, 0xba, 0x20, 0x1d, 0xd2,
+	0x89, 0xa6, 0x5c, 0xfd, 0x5f, 0xc7, 0x75, 0xa8, 0xe3, 0xaa, 0xd4, 0x71, 0x89, 0x4c, 0x25, 0xd3,
+	0x51, 0xc7, 0x5f, 0x90, 0xcf, 0x14, 0xd8, 0x8f, 0x96, 0x91, 0x8c, 0xb5, 0xa7, 0x50, 0x6f, 0x3a,
+	0xd5, 0xf1, 0x0e, 0x2a, 0x90, 0xee, 0xb4, 0xa4, 0xab, 0x93, 0xd1, 0x64, 0x74, 0xd1, 0xac, 0x92,
+	0xaf, 0x15, 0x48, 0xd5, 0xb8, 0x51, 0x32, 0xd5, 0xbe, 0x73, 0xa3, 0xaf, 0x55, 0xa7, 0x3b, 0xac,
+	0x42, 0xce, 0x33, 0x92, 0xf3, 0x14, 0x99, 0x48, 0xc6, 0xb9, 0xd6, 0x1e, 0x93, 0x5f, 0x14, 0x48,
+	0xc7, 0x59, 0x5c, 0x72, 0xad, 0x3d, 0x97, 0x16, 0xfe, 0x59, 0x9d, 0xed, 0xb6, 0x1c, 0x35, 0x2d,
+	0x48, 0x4d, 0xb3, 0xe4, 0x6a, 0x32, 0x4d, 0xf5, 0x2e, 0xdc, 0x28, 0xa2, 0x88, 0xaf, 0x14, 0xd8,
+	0x2b, 0x5d, 0x28, 0xc9, 0xb6, 0xe7, 0x53, 0xeb, 0xab, 0x55, 0x3d, 0xf1, 0x78, 0x24, 0x7c, 0x43,
+	0x12, 0xbe, 0x4e, 0x66, 0x93, 0x11, 0x96, 0x66, 0x5b, 0xdf, 0xdc, 0xe9, 0x7f, 0xb7, 0xc8, 0xcf,
+	0x0a, 0x40, 0xd5, 0x52, 0x92, 0xc9, 0xa4, 0xf3, 0x58, 0x63, 0xac, 0xd5, 0xa9, 0xce, 0x8a, 0x50,
+	0xc1, 0x3b, 0x52, 0xc1, 0x03, 0x72, 0xbf, 0xa3, 0x29, 0x0f, 0x20, 0x62, 0x74, 0xe8, 0x9b, 0x0d,
+	0x7e, 0x7d, 0x8b, 0xfc, 0xa8, 0x40, 0xdf, 0x4e, 0xeb, 0x4a, 0xfe, 0xdf, 0x9e, 0x6c, 0x13, 0xff,
+	0xac, 0xce, 0x74, 0x53, 0x8a, 0x6a, 0xdf, 0x94, 0x6a, 0xe7, 0xc9, 0x5c, 0x1b, 0xb5, 0x15, 0xb3,
+	0x20, 0xf4, 0xcd, 0x7a, 0x7f, 0xb7, 0xa5, 0x87, 0xbe, 0x9a, 0xbc, 0x52, 0x80, 0x34, 0x9a, 0x54,
+	0x72, 0x25, 0xd9, 0x6e, 0x8e, 0x75, 0xe1, 0xea, 0xd5, 0xee, 0x8a, 0x51, 0xdc, 0x3d, 0x29, 0xee,
+	0x36, 0xb9, 0xf5, 0x37, 0xc4, 0xc5, 0xf9, 0x75, 0xf2, 0xa5, 0x02, 0xa9, 0x1a, 0x17, 0x9d, 0xe4,
+	0x9c, 0x6b, 0xf4, 0xe3, 0xea, 0x74, 0x87, 0x55, 0xa8, 0x6a, 0x52, 0xaa, 0x1a, 0x25, 0x17, 0xdb,
+	0xa8, 0x12, 0x41, 0xad, 0x11, 0xda, 0x77, 0xf2, 0x9d, 0x02, 0x7d, 0x3b, 0x0d, 0x76, 0x92, 0x35,
+	0xd7, 0xc4, 0xe6, 0xab, 0x33, 0xdd, 0x94, 0xa2, 0x80, 0xcb, 0x52, 0xc0, 0x04, 0x19, 0x6b, 0x23,
+	0xc0, 0x8d, 0x00, 0x22, 0x33, 0x4c, 0xb6, 0x15, 0x48, 0xc7, 0xd9, 0xa7, 0x24, 0xc7, 0x74, 0x0b,
+	0x57, 0xab, 0xce, 0x76, 0x5b, 0x8e, 0x8a, 0x6e, 0x4a, 0x45, 0x37, 0xc8, 0x42, 0x67, 0x2e, 0x25,
+	0xde, 0x46, 0x06, 0x2a, 0x07, 0xe2, 0x3d, 0x28, 0x79, 0xad, 0x3b, 0xa2, 0x55, 0x2f, 0x70, 0xbd,
+	0x7b, 0x80, 0xee, 0x4e, 0xf8, 0x66, 0x56, 0x99, 0x7c, 0xa2, 0xc0, 0xbe, 0xd0, 0x7c, 0x92, 0x04,
+	0xb7, 0x4c, 0x9d, 0xf3, 0x55, 0xc7, 0x92, 0x17, 0x20, 0xeb, 0x51, 0xc9, 0xfa, 0x1c, 0x39, 0xdb,
+	0x6e, 0xcd, 0x85, 0x76, 0xf8, 0xad, 0xe7, 0x2f, 0x33, 0xca, 0x8b, 0x97, 0x19, 0xe5, 0xf7, 0x97,
+	0x19, 0xe5, 0xe9, 0x76, 0xa6, 0xe7, 0xc5, 0x76, 0xa6, 0xe7, 0xa7, 0xed, 0x4c, 0xcf, 0x83, 0xb1,
+	0x9a, 0xff, 0x58, 0x4d, 0xa0, 0x1e, 0x45, 0x60, 0xf2, 0x1f, 0xd7, 0xd2, 0x3e, 0x39, 0x64, 0xf2,
+	0xaf, 0x01, 0x00, 0x92, 0xf8, 0xe9, 0x18, 0xf4, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1043,7 +1457,7 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// ExchangeRate returns exchange rate of a denom
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms
+	// ExchangeRates returns exchange rates of all denoms, except stale ones
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active denoms
 	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
@@ -1052,12 +1466,20 @@ type QueryClient interface {
 	// PriceSnapshotHistory returns the history of price snapshots for all assets
 	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
 	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
+	// PriceStats returns the ema, realized volatility, high, low and sample count of the price snapshots of all denoms over a lookback window
+	PriceStats(ctx context.Context, in *QueryPriceStatsRequest, opts ...grpc.CallOption) (*QueryPriceStatsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// ProjectedRewards returns the oracle rewards that will be paid out at the end of the current vote period
+	ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error)
+	// CircuitBreakerStatus returns whether the exchange rate of a denom is held or halted by its circuit breaker
+	CircuitBreakerStatus(ctx context.Context, in *QueryCircuitBreakerStatusRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerStatusResponse, error)
+	// CircuitBreakerStatuses returns all the denoms whose exchange rate is held or halted by their circuit breaker
+	CircuitBreakerStatuses(ctx context.Context, in *QueryCircuitBreakerStatusesRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerStatusesResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1124,6 +1546,15 @@ func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) PriceStats(ctx context.Context, in *QueryPriceStatsRequest, opts ...grpc.CallOption) (*QueryPriceStatsResponse, error) {
+	out := new(QueryPriceStatsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/PriceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
 	out := new(QueryFeederDelegationResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FeederDelegation", in, out, opts...)
@@ -1151,6 +1582,33 @@ func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowReque
 	return out, nil
 }
 
+func (c *queryClient) ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error) {
+	out := new(QueryProjectedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ProjectedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakerStatus(ctx context.Context, in *QueryCircuitBreakerStatusRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerStatusResponse, error) {
+	out := new(QueryCircuitBreakerStatusResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/CircuitBreakerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakerStatuses(ctx context.Context, in *QueryCircuitBreakerStatusesRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerStatusesResponse, error) {
+	out := new(QueryCircuitBreakerStatusesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/CircuitBreakerStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Params", in, out, opts...)
@@ -1164,7 +1622,7 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// ExchangeRate returns exchange rate of a denom
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms
+	// ExchangeRates returns exchange rates of all denoms, except stale ones
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active denoms
 	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
@@ -1173,12 +1631,20 @@ type QueryServer interface {
 	// PriceSnapshotHistory returns the history of price snapshots for all assets
 	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
 	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
+	// PriceStats returns the ema, realized volatility, high, low and sample count of the price snapshots of all denoms over a lookback window
+	PriceStats(context.Context, *QueryPriceStatsRequest) (*QueryPriceStatsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
+	// ProjectedRewards returns the oracle rewards that will be paid out at the end of the current vote period
+	ProjectedRewards(context.Context, *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error)
+	// CircuitBreakerStatus returns whether the exchange rate of a denom is held or halted by its circuit breaker
+	CircuitBreakerStatus(context.Context, *QueryCircuitBreakerStatusRequest) (*QueryCircuitBreakerStatusResponse, error)
+	// CircuitBreakerStatuses returns all the denoms whose exchange rate is held or halted by their circuit breaker
+	CircuitBreakerStatuses(context.Context, *QueryCircuitBreakerStatusesRequest) (*QueryCircuitBreakerStatusesResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1205,6 +1671,9 @@ func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *
 func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
 }
+func (*UnimplementedQueryServer) PriceStats(ctx context.Context, req *QueryPriceStatsRequest) (*QueryPriceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceStats not implemented")
+}
 func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
 }
@@ -1214,6 +1683,15 @@ func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedRewards(ctx context.Context, req *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRewards not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakerStatus(ctx context.Context, req *QueryCircuitBreakerStatusRequest) (*QueryCircuitBreakerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakerStatus not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakerStatuses(ctx context.Context, req *QueryCircuitBreakerStatusesRequest) (*QueryCircuitBreakerStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakerStatuses not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1330,6 +1808,24 @@ func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/PriceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceStats(ctx, req.(*QueryPriceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeederDelegationRequest)
 	if err := dec(in); err != nil {
@@ -1384,6 +1880,60 @@ func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/ProjectedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRewards(ctx, req.(*QueryProjectedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/CircuitBreakerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakerStatus(ctx, req.(*QueryCircuitBreakerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakerStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakerStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/CircuitBreakerStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakerStatuses(ctx, req.(*QueryCircuitBreakerStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1430,6 +1980,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Twaps",
 			Handler:    _Query_Twaps_Handler,
 		},
+		{
+			MethodName: "PriceStats",
+			Handler:    _Query_PriceStats_Handler,
+		},
 		{
 			MethodName: "FeederDelegation",
 			Handler:    _Query_FeederDelegation_Handler,
@@ -1442,6 +1996,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
 		},
+		{
+			MethodName: "ProjectedRewards",
+			Handler:    _Query_ProjectedRewards_Handler,
+		},
+		{
+			MethodName: "CircuitBreakerStatus",
+			Handler:    _Query_CircuitBreakerStatus_Handler,
+		},
+		{
+			MethodName: "CircuitBreakerStatuses",
+			Handler:    _Query_CircuitBreakerStatuses_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1849,6 +2415,76 @@ func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HalfLifeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HalfLifeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStats) > 0 {
+		for iNdEx := len(m.PriceStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2025,7 +2661,7 @@ func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProjectedRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2035,20 +2671,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProjectedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProjectedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryProjectedRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2058,98 +2701,309 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProjectedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProjectedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodRewards) > 0 {
+		for iNdEx := len(m.PeriodRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCircuitBreakerStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryCircuitBreakerStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCircuitBreakerStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakerStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DenomOracleExchangeRatePair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCircuitBreakerStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCircuitBreakerStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DenomOracleExchangeRatePairs) > 0 {
-		for _, e := range m.DenomOracleExchangeRatePairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CircuitBreakerStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakerStatuses) > 0 {
+		for iNdEx := len(m.CircuitBreakerStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomOracleExchangeRatePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomOracleExchangeRatePairs) > 0 {
+		for _, e := range m.DenomOracleExchangeRatePairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
@@ -2254,6 +3108,36 @@ func (m *QueryTwapsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	if m.HalfLifeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.HalfLifeSeconds))
+	}
+	return n
+}
+
+func (m *QueryPriceStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStats) > 0 {
+		for _, e := range m.PriceStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryFeederDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2327,33 +3211,717 @@ func (m *QuerySlashWindowResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryProjectedRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryProjectedRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PeriodRewards) > 0 {
+		for _, e := range m.PeriodRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreakerStatus.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryCircuitBreakerStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryCircuitBreakerStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakerStatuses) > 0 {
+		for _, e := range m.CircuitBreakerStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomOracleExchangeRatePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomOracleExchangeRatePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOracleExchangeRatePairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomOracleExchangeRatePairs = append(m.DenomOracleExchangeRatePairs, DenomOracleExchangeRatePair{})
+			if err := m.DenomOracleExchangeRatePairs[len(m.DenomOracleExchangeRatePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,15 +3944,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2412,7 +3980,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2435,7 +4003,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2458,15 +4026,65 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2493,7 +4111,8 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2518,7 +4137,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,12 +4160,31 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2568,7 +4206,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,47 +4229,15 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomOracleExchangeRatePair: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomOracleExchangeRatePair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2658,7 +4264,8 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleTwaps = append(m.OracleTwaps, OracleTwap{})
+			if err := m.OracleTwaps[len(m.OracleTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2683,7 +4290,7 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,17 +4313,17 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOracleExchangeRatePairs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
 			}
-			var msglen int
+			m.LookbackSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2726,26 +4333,30 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLifeSeconds", wireType)
 			}
-			m.DenomOracleExchangeRatePairs = append(m.DenomOracleExchangeRatePairs, DenomOracleExchangeRatePair{})
-			if err := m.DenomOracleExchangeRatePairs[len(m.DenomOracleExchangeRatePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HalfLifeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfLifeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2767,7 +4378,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2790,12 +4401,46 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceStats = append(m.PriceStats, OraclePriceStats{})
+			if err := m.PriceStats[len(m.PriceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2817,7 +4462,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2840,15 +4485,15 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2876,58 +4521,8 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2949,7 +4544,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,15 +4567,15 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3008,7 +4603,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3031,7 +4626,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3054,12 +4649,44 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3081,7 +4708,7 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3104,15 +4731,15 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3139,8 +4766,10 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
-			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VotePenaltyCounter == nil {
+				m.VotePenaltyCounter = &VotePenaltyCounter{}
+			}
+			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3165,7 +4794,7 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3188,31 +4817,12 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashWindowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
-			}
-			m.LookbackSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LookbackSeconds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3234,7 +4844,7 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3257,17 +4867,17 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwaps", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
 			}
-			var msglen int
+			m.WindowProgress = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3277,26 +4887,11 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WindowProgress |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OracleTwaps = append(m.OracleTwaps, OracleTwap{})
-			if err := m.OracleTwaps[len(m.OracleTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3318,7 +4913,7 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3341,10 +4936,10 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3400,7 +4995,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3423,17 +5018,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3443,23 +5038,93 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodRewards = append(m.PeriodRewards, types.DecCoin{})
+			if err := m.PeriodRewards[len(m.PeriodRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewards = append(m.ValidatorRewards, types.DecCoin{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3482,7 +5147,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3505,15 +5170,15 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3541,7 +5206,7 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3564,7 +5229,7 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3587,15 +5252,15 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3622,10 +5287,7 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotePenaltyCounter == nil {
-				m.VotePenaltyCounter = &VotePenaltyCounter{}
-			}
-			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreakerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3650,7 +5312,7 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3673,10 +5335,10 @@ func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3700,7 +5362,7 @@ func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3723,17 +5385,17 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerStatuses", wireType)
 			}
-			m.WindowProgress = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3743,11 +5405,26 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProgress |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerStatuses = append(m.CircuitBreakerStatuses, CircuitBreakerStatus{})
+			if err := m.CircuitBreakerStatuses[len(m.CircuitBreakerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])